@@ -6,8 +6,6 @@ import (
 
 // BeginBlocker runs at the start of every block.
 func BeginBlocker(ctx sdk.Context, k Keeper) {
-	err := k.CloseExpiredAuctions(ctx)
-	if err != nil {
-		panic(err)
-	}
+	k.CloseExpiredAuctions(ctx)
+	k.PruneArchive(ctx)
 }
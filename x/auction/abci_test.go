@@ -37,7 +37,7 @@ func TestKeeper_BeginBlocker(t *testing.T) {
 	keeper := tApp.GetAuctionKeeper()
 
 	// Start an auction and place a bid
-	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40), sdk.ZeroDec())
 	require.NoError(t, err)
 	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 30)))
 
@@ -26,9 +26,28 @@ const (
 	CodeLotTooLarge                       = types.CodeLotTooLarge
 	CodeCollateralAuctionIsInReversePhase = types.CodeCollateralAuctionIsInReversePhase
 	CodeCollateralAuctionIsInForwardPhase = types.CodeCollateralAuctionIsInForwardPhase
+	CodeInvalidPrice                      = types.CodeInvalidPrice
+	CodePartialBidNotSupported            = types.CodePartialBidNotSupported
+	CodeAuctionNotStuck                   = types.CodeAuctionNotStuck
+	CodeInvalidStuckAuctionAction         = types.CodeInvalidStuckAuctionAction
+	CodeNotSealedBidAuction               = types.CodeNotSealedBidAuction
+	CodeSealedBidsOnly                    = types.CodeSealedBidsOnly
+	CodeNotCommitPhase                    = types.CodeNotCommitPhase
+	CodeNotRevealPhase                    = types.CodeNotRevealPhase
+	CodeCommitmentNotFound                = types.CodeCommitmentNotFound
+	CodeInvalidReveal                     = types.CodeInvalidReveal
+	CodeBidBelowReserve                   = types.CodeBidBelowReserve
+	CodeProxyBidNotSupported              = types.CodeProxyBidNotSupported
 	EventTypeAuctionStart                 = types.EventTypeAuctionStart
 	EventTypeAuctionBid                   = types.EventTypeAuctionBid
+	EventTypeAuctionFill                  = types.EventTypeAuctionFill
+	EventTypeAuctionCommit                = types.EventTypeAuctionCommit
+	EventTypeAuctionReveal                = types.EventTypeAuctionReveal
+	EventTypeAuctionMaxBid                = types.EventTypeAuctionMaxBid
 	EventTypeAuctionClose                 = types.EventTypeAuctionClose
+	EventTypeAuctionCloseFailed           = types.EventTypeAuctionCloseFailed
+	EventTypeAuctionCancel                = types.EventTypeAuctionCancel
+	EventTypeAuctionRelist                = types.EventTypeAuctionRelist
 	AttributeValueCategory                = types.AttributeValueCategory
 	AttributeKeyAuctionID                 = types.AttributeKeyAuctionID
 	AttributeKeyAuctionType               = types.AttributeKeyAuctionType
@@ -37,7 +56,9 @@ const (
 	AttributeKeyLotDenom                  = types.AttributeKeyLotDenom
 	AttributeKeyBidAmount                 = types.AttributeKeyBidAmount
 	AttributeKeyLotAmount                 = types.AttributeKeyLotAmount
+	AttributeKeyMaxBid                    = types.AttributeKeyMaxBid
 	AttributeKeyEndTime                   = types.AttributeKeyEndTime
+	AttributeKeyError                     = types.AttributeKeyError
 	DefaultNextAuctionID                  = types.DefaultNextAuctionID
 	ModuleName                            = types.ModuleName
 	StoreKey                              = types.StoreKey
@@ -46,9 +67,37 @@ const (
 	QuerierRoute                          = types.QuerierRoute
 	DefaultMaxAuctionDuration             = types.DefaultMaxAuctionDuration
 	DefaultBidDuration                    = types.DefaultBidDuration
+	DefaultDutchDecayInterval             = types.DefaultDutchDecayInterval
+	DefaultCommitDuration                 = types.DefaultCommitDuration
+	DefaultRevealDuration                 = types.DefaultRevealDuration
+	DefaultSealedBidPricing               = types.DefaultSealedBidPricing
+	DefaultReserveRelists                 = types.DefaultReserveRelists
+	DefaultArchiveRetention               = types.DefaultArchiveRetention
+	AuctionTypeSurplus                    = types.AuctionTypeSurplus
+	AuctionTypeDebt                       = types.AuctionTypeDebt
+	AuctionTypeCollateral                 = types.AuctionTypeCollateral
+	AuctionTypeDutch                      = types.AuctionTypeDutch
+	AuctionTypeSealed                     = types.AuctionTypeSealed
+	SealedBidKindSurplus                  = types.SealedBidKindSurplus
+	SealedBidKindDebt                     = types.SealedBidKindDebt
+	SealedBidFirstPrice                   = types.SealedBidFirstPrice
+	SealedBidSecondPrice                  = types.SealedBidSecondPrice
 	QueryGetAuction                       = types.QueryGetAuction
 	QueryGetAuctions                      = types.QueryGetAuctions
 	QueryGetParams                        = types.QueryGetParams
+	QueryGetStuckAuctions                 = types.QueryGetStuckAuctions
+	QueryGetClosedAuctions                = types.QueryGetClosedAuctions
+	QueryGetBidHistory                    = types.QueryGetBidHistory
+	RestType                              = types.RestType
+	RestPhase                             = types.RestPhase
+	RestInitiator                         = types.RestInitiator
+	RestBidder                            = types.RestBidder
+	RestLotDenom                          = types.RestLotDenom
+	RestBidDenom                          = types.RestBidDenom
+	RestEndBefore                         = types.RestEndBefore
+	ProposalTypeStuckAuction              = types.ProposalTypeStuckAuction
+	StuckAuctionSettle                    = types.StuckAuctionSettle
+	StuckAuctionCancel                    = types.StuckAuctionCancel
 )
 
 var (
@@ -58,6 +107,8 @@ var (
 	NewSurplusAuction                    = types.NewSurplusAuction
 	NewDebtAuction                       = types.NewDebtAuction
 	NewCollateralAuction                 = types.NewCollateralAuction
+	NewDutchAuction                      = types.NewDutchAuction
+	NewSealedBidAuction                  = types.NewSealedBidAuction
 	NewWeightedAddresses                 = types.NewWeightedAddresses
 	RegisterCodec                        = types.RegisterCodec
 	ErrInvalidInitialAuctionID           = types.ErrInvalidInitialAuctionID
@@ -74,18 +125,45 @@ var (
 	ErrLotTooLarge                       = types.ErrLotTooLarge
 	ErrCollateralAuctionIsInReversePhase = types.ErrCollateralAuctionIsInReversePhase
 	ErrCollateralAuctionIsInForwardPhase = types.ErrCollateralAuctionIsInForwardPhase
+	ErrInvalidPrice                      = types.ErrInvalidPrice
+	ErrPartialBidNotSupported            = types.ErrPartialBidNotSupported
+	ErrAuctionNotStuck                   = types.ErrAuctionNotStuck
+	ErrInvalidStuckAuctionAction         = types.ErrInvalidStuckAuctionAction
+	ErrNotSealedBidAuction               = types.ErrNotSealedBidAuction
+	ErrSealedBidsOnly                    = types.ErrSealedBidsOnly
+	ErrNotCommitPhase                    = types.ErrNotCommitPhase
+	ErrNotRevealPhase                    = types.ErrNotRevealPhase
+	ErrCommitmentNotFound                = types.ErrCommitmentNotFound
+	ErrInvalidReveal                     = types.ErrInvalidReveal
+	ErrBidBelowReserve                   = types.ErrBidBelowReserve
+	ErrProxyBidNotSupported              = types.ErrProxyBidNotSupported
 	NewGenesisState                      = types.NewGenesisState
 	DefaultGenesisState                  = types.DefaultGenesisState
 	GetAuctionKey                        = types.GetAuctionKey
 	GetAuctionByTimeKey                  = types.GetAuctionByTimeKey
+	GetAuctionBidKey                     = types.GetAuctionBidKey
+	GetProxyBidKey                       = types.GetProxyBidKey
 	Uint64ToBytes                        = types.Uint64ToBytes
 	Uint64FromBytes                      = types.Uint64FromBytes
 	NewMsgPlaceBid                       = types.NewMsgPlaceBid
+	NewMsgPlacePartialBid                = types.NewMsgPlacePartialBid
+	NewMsgCommitBid                      = types.NewMsgCommitBid
+	NewMsgRevealBid                      = types.NewMsgRevealBid
+	NewMsgSetMaxBid                      = types.NewMsgSetMaxBid
+	SealedBidCommitment                  = types.SealedBidCommitment
 	NewParams                            = types.NewParams
 	DefaultParams                        = types.DefaultParams
 	ParamKeyTable                        = types.ParamKeyTable
+	NewClosedAuction                     = types.NewClosedAuction
+	NewAuctionBid                        = types.NewAuctionBid
+	NewProxyBid                          = types.NewProxyBid
+	NewParamOverride                     = types.NewParamOverride
+	NewMultiAuctionHooks                 = types.NewMultiAuctionHooks
 	NewQueryAllAuctionParams             = types.NewQueryAllAuctionParams
+	NewQueryArchiveParams                = types.NewQueryArchiveParams
 	NewAuctionWithPhase                  = types.NewAuctionWithPhase
+	NewStuckAuction                      = types.NewStuckAuction
+	NewStuckAuctionProposal              = types.NewStuckAuctionProposal
 
 	// variable aliases
 	DistantFuture          = types.DistantFuture
@@ -93,12 +171,36 @@ var (
 	AuctionKeyPrefix       = types.AuctionKeyPrefix
 	AuctionByTimeKeyPrefix = types.AuctionByTimeKeyPrefix
 	NextAuctionIDKey       = types.NextAuctionIDKey
+	StuckAuctionKeyPrefix  = types.StuckAuctionKeyPrefix
+	ClosedAuctionKeyPrefix = types.ClosedAuctionKeyPrefix
+	ClosedByTimeKeyPrefix  = types.ClosedByTimeKeyPrefix
+	AuctionBidKeyPrefix    = types.AuctionBidKeyPrefix
+	NextBidSequenceKey     = types.NextBidSequenceKey
+	ProxyBidKeyPrefix      = types.ProxyBidKeyPrefix
 	DefaultIncrement       = types.DefaultIncrement
+	DefaultDutchBuffer     = types.DefaultDutchBuffer
+	DefaultDutchDecay      = types.DefaultDutchDecay
+	DefaultDutchMinPrice   = types.DefaultDutchMinPrice
+	DefaultBidDeposit      = types.DefaultBidDeposit
+	DefaultOverrides       = types.DefaultOverrides
 	KeyBidDuration         = types.KeyBidDuration
 	KeyMaxAuctionDuration  = types.KeyMaxAuctionDuration
 	KeyIncrementSurplus    = types.KeyIncrementSurplus
 	KeyIncrementDebt       = types.KeyIncrementDebt
 	KeyIncrementCollateral = types.KeyIncrementCollateral
+	KeyDutchPriceBuffer    = types.KeyDutchPriceBuffer
+	KeyDutchPriceDecay     = types.KeyDutchPriceDecay
+	KeyDutchDecayInterval  = types.KeyDutchDecayInterval
+	KeyDutchMinPrice       = types.KeyDutchMinPrice
+	KeySealedBidSurplus    = types.KeySealedBidSurplus
+	KeySealedBidDebt       = types.KeySealedBidDebt
+	KeySealedBidPricing    = types.KeySealedBidPricing
+	KeyCommitDuration      = types.KeyCommitDuration
+	KeyRevealDuration      = types.KeyRevealDuration
+	KeyBidDeposit          = types.KeyBidDeposit
+	KeyReserveRelists      = types.KeyReserveRelists
+	KeyArchiveRetention    = types.KeyArchiveRetention
+	KeyOverrides           = types.KeyOverrides
 )
 
 type (
@@ -109,14 +211,37 @@ type (
 	SurplusAuction        = types.SurplusAuction
 	DebtAuction           = types.DebtAuction
 	CollateralAuction     = types.CollateralAuction
+	DutchAuction          = types.DutchAuction
+	SealedBidAuction      = types.SealedBidAuction
+	SealedBid             = types.SealedBid
+	SealedBids            = types.SealedBids
 	WeightedAddresses     = types.WeightedAddresses
 	SupplyKeeper          = types.SupplyKeeper
 	GenesisAuction        = types.GenesisAuction
 	GenesisAuctions       = types.GenesisAuctions
 	GenesisState          = types.GenesisState
 	MsgPlaceBid           = types.MsgPlaceBid
+	MsgPlacePartialBid    = types.MsgPlacePartialBid
+	MsgCommitBid          = types.MsgCommitBid
+	MsgRevealBid          = types.MsgRevealBid
+	MsgSetMaxBid          = types.MsgSetMaxBid
 	Params                = types.Params
 	QueryAuctionParams    = types.QueryAuctionParams
 	QueryAllAuctionParams = types.QueryAllAuctionParams
+	QueryArchiveParams    = types.QueryArchiveParams
 	AuctionWithPhase      = types.AuctionWithPhase
+	StuckAuction          = types.StuckAuction
+	StuckAuctions         = types.StuckAuctions
+	StuckAuctionProposal  = types.StuckAuctionProposal
+	ClosedAuction         = types.ClosedAuction
+	ClosedAuctions        = types.ClosedAuctions
+	AuctionBid            = types.AuctionBid
+	AuctionBids           = types.AuctionBids
+	ProxyBid              = types.ProxyBid
+	ProxyBids             = types.ProxyBids
+	AuctionParams         = types.AuctionParams
+	ParamOverride         = types.ParamOverride
+	ParamOverrides        = types.ParamOverrides
+	AuctionHooks          = types.AuctionHooks
+	MultiAuctionHooks     = types.MultiAuctionHooks
 )
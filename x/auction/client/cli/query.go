@@ -3,16 +3,33 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/kava-labs/kava/x/auction/types"
 )
 
+// Flags for the auctions query
+const (
+	flagType      = "type"
+	flagPhase     = "phase"
+	flagInitiator = "initiator"
+	flagBidder    = "bidder"
+	flagLotDenom  = "lot-denom"
+	flagBidDenom  = "bid-denom"
+	flagEndBefore = "end-before"
+	flagPage      = "page"
+	flagLimit     = "limit"
+	flagAuctionID = "auction-id"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	// Group nameservice queries under a subcommand
@@ -25,6 +42,9 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		QueryGetAuctionCmd(queryRoute, cdc),
 		QueryGetAuctionsCmd(queryRoute, cdc),
 		QueryParamsCmd(queryRoute, cdc),
+		QueryStuckAuctionsCmd(queryRoute, cdc),
+		QueryClosedAuctionsCmd(queryRoute, cdc),
+		QueryBidHistoryCmd(queryRoute, cdc),
 	)...)
 
 	return auctionQueryCmd
@@ -68,15 +88,30 @@ func QueryGetAuctionCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 // QueryGetAuctionsCmd queries the auctions in the store
 func QueryGetAuctionsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "auctions",
 		Short: "get a list of active auctions",
-		Args:  cobra.NoArgs,
+		Long: `Get the active auctions, optionally filtered by type, phase, initiator, latest bidder, lot and bid denom and end time.
+
+Example:
+$ kvcli query auction auctions --type collateral --phase forward --lot-denom bnb --limit 10
+`,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
+			// Prepare params for querier
+			params, err := parseAuctionsFlags()
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
 			// Query
-			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAuctions), nil)
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetAuctions), bz)
 			if err != nil {
 				return err
 			}
@@ -92,6 +127,39 @@ func QueryGetAuctionsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 			return cliCtx.PrintOutput(auctionsWithPhase)
 		},
 	}
+	cmd.Flags().String(flagType, "", "(optional) filter by auction type: surplus, debt, collateral, dutch or sealed")
+	cmd.Flags().String(flagPhase, "", "(optional) filter by auction phase, such as forward or reverse")
+	cmd.Flags().String(flagInitiator, "", "(optional) filter by the name of the module that started the auction")
+	cmd.Flags().String(flagBidder, "", "(optional) filter by the address of the latest bidder")
+	cmd.Flags().String(flagLotDenom, "", "(optional) filter by lot denom")
+	cmd.Flags().String(flagBidDenom, "", "(optional) filter by bid denom")
+	cmd.Flags().String(flagEndBefore, "", "(optional) filter by auctions ending before an RFC3339 time, such as 2020-01-02T15:04:05Z")
+	cmd.Flags().Int(flagPage, 1, "(optional) page of auctions to query")
+	cmd.Flags().Int(flagLimit, 0, "(optional) limit the number of auctions per page. Defaults to all auctions")
+	return cmd
+}
+
+// parseAuctionsFlags returns the auctions query params set by the command flags
+func parseAuctionsFlags() (types.QueryAllAuctionParams, error) {
+	var bidder sdk.AccAddress
+	if bech32Bidder := viper.GetString(flagBidder); len(bech32Bidder) != 0 {
+		bidderAddr, err := sdk.AccAddressFromBech32(bech32Bidder)
+		if err != nil {
+			return types.QueryAllAuctionParams{}, err
+		}
+		bidder = bidderAddr
+	}
+	var endBefore time.Time
+	if endBeforeStr := viper.GetString(flagEndBefore); len(endBeforeStr) != 0 {
+		t, err := time.Parse(time.RFC3339, endBeforeStr)
+		if err != nil {
+			return types.QueryAllAuctionParams{}, fmt.Errorf("end-before '%s' not a valid RFC3339 time", endBeforeStr)
+		}
+		endBefore = t
+	}
+	return types.NewQueryAllAuctionParams(viper.GetInt(flagPage), viper.GetInt(flagLimit), viper.GetString(flagType),
+		viper.GetString(flagPhase), viper.GetString(flagInitiator), bidder, viper.GetString(flagLotDenom),
+		viper.GetString(flagBidDenom), endBefore), nil
 }
 
 // QueryParamsCmd queries the auction module parameters
@@ -118,3 +186,125 @@ func QueryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// QueryStuckAuctionsCmd queries the auctions that failed to close
+func QueryStuckAuctionsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "stuck",
+		Short: "get a list of auctions that failed to close",
+		Long:  "Get the auctions that failed to close, which are retried every block until they close or are settled or cancelled by governance.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetStuckAuctions)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var out types.StuckAuctions
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// QueryClosedAuctionsCmd queries the archive of closed auctions
+func QueryClosedAuctionsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "closed-auctions",
+		Short: "get a list of closed auctions",
+		Long: `Get the archive of closed auctions, optionally filtered by auction ID and winner. Closed auctions are only kept when the archive retention param is set.
+
+Example:
+$ kvcli query auction closed-auctions --bidder kava1...
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			params, err := parseArchiveFlags()
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetClosedAuctions)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var out types.ClosedAuctions
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	cmd.Flags().Uint64(flagAuctionID, 0, "(optional) filter by auction ID")
+	cmd.Flags().String(flagBidder, "", "(optional) filter by the address of the winner")
+	return cmd
+}
+
+// QueryBidHistoryCmd queries the bid history of auctions
+func QueryBidHistoryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bids",
+		Short: "get the bid history of auctions",
+		Long: `Get the bids, fills and reveals accepted by open and closed auctions, optionally filtered by auction ID and bidder. Bids are only kept when the archive retention param is set.
+
+Example:
+$ kvcli query auction bids --auction-id 34
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			params, err := parseArchiveFlags()
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetBidHistory)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var out types.AuctionBids
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	cmd.Flags().Uint64(flagAuctionID, 0, "(optional) filter by auction ID")
+	cmd.Flags().String(flagBidder, "", "(optional) filter by the address of the bidder")
+	return cmd
+}
+
+// parseArchiveFlags returns the closed auction and bid history query params set by the command flags
+func parseArchiveFlags() (types.QueryArchiveParams, error) {
+	var bidder sdk.AccAddress
+	if bech32Bidder := viper.GetString(flagBidder); len(bech32Bidder) != 0 {
+		bidderAddr, err := sdk.AccAddressFromBech32(bech32Bidder)
+		if err != nil {
+			return types.QueryArchiveParams{}, err
+		}
+		bidder = bidderAddr
+	}
+	return types.NewQueryArchiveParams(viper.GetUint64(flagAuctionID), bidder), nil
+}
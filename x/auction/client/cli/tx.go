@@ -14,6 +14,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 
 	"github.com/kava-labs/kava/x/auction/types"
 )
@@ -27,6 +28,10 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 
 	auctionTxCmd.AddCommand(client.PostCommands(
 		GetCmdPlaceBid(cdc),
+		GetCmdPlacePartialBid(cdc),
+		GetCmdCommitBid(cdc),
+		GetCmdRevealBid(cdc),
+		GetCmdSetMaxBid(cdc),
 	)...)
 
 	return auctionTxCmd
@@ -38,7 +43,7 @@ func GetCmdPlaceBid(cdc *codec.Codec) *cobra.Command {
 		Use:   "bid [auction-id] [amount]",
 		Short: "place a bid on an auction",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Place a bid on any type of auction, updating the latest bid amount to [amount]. Collateral auctions must be bid up to their maxbid before entering reverse phase.
+			fmt.Sprintf(`Place a bid on any type of auction, updating the latest bid amount to [amount]. Collateral auctions must be bid up to their maxbid before entering reverse phase. Bids on dutch auctions are an amount of the lot to buy at the current price.
 
 Example:
 $ %s tx %s bid 34 1000usdx --from myKeyName
@@ -67,3 +72,208 @@ $ %s tx %s bid 34 1000usdx --from myKeyName
 		},
 	}
 }
+
+// GetCmdPlacePartialBid cli command for buying part of the lot of an auction
+func GetCmdPlacePartialBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bid-partial [auction-id] [lot]",
+		Short: "buy part of the lot of an auction at the current price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Buy [lot] of the lot of a collateral or dutch auction at the current price. Collateral auctions sell part of the lot at the current bid per unit of lot, or the reserve price if higher, and only in forward phase.
+
+Example:
+$ %s tx %s bid-partial 34 1000000bnb --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			lot, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlacePartialBid(id, cliCtx.GetFromAddress(), lot)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCommitBid cli command for committing to sealed bids on auctions
+func GetCmdCommitBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "commit-bid [auction-id] [amount] [salt]",
+		Short: "commit to a hidden bid on a sealed-bid auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commit to a hidden bid on a sealed-bid auction, paying the auction's deposit. Only a hash of the amount and salt is sent.
+The amount is the bid for surplus auctions, and the lot for debt auctions. Keep the salt secret until revealing the bid
+with the same amount and salt once the commit phase is over, otherwise the deposit is forfeited.
+
+Example:
+$ %s tx %s commit-bid 34 1000usdx mysecretsalt --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			amt, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			bidder := cliCtx.GetFromAddress()
+			msg := types.NewMsgCommitBid(id, bidder, types.SealedBidCommitment(id, bidder, amt, args[2]))
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRevealBid cli command for revealing sealed bids on auctions
+func GetCmdRevealBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reveal-bid [auction-id] [amount] [salt]",
+		Short: "reveal a committed bid on a sealed-bid auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reveal a committed bid on a sealed-bid auction, using the amount and salt it was committed with. The deposit is returned.
+If the bid is the best so far, the bid (surplus auctions) or the auction's bid (debt auctions) is paid in, and refunded if outbid.
+
+Example:
+$ %s tx %s reveal-bid 34 1000usdx mysecretsalt --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			amt, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevealBid(id, cliCtx.GetFromAddress(), amt, args[2])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetMaxBid cli command for setting proxy bids on auctions
+func GetCmdSetMaxBid(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "max-bid [auction-id] [max-bid]",
+		Short: "set a proxy bid that automatically outbids other bidders on an auction",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set a proxy bid on a surplus, debt or collateral auction, which outbids other bidders by the minimum increment until [max-bid] is reached.
+[max-bid] is the highest bid for surplus auctions and the lowest lot for debt auctions. For collateral auctions a max bid in the bid denom
+only bids in forward phase, while a max bid in the lot denom bids up to the auction's maxbid then down to [max-bid] in reverse phase.
+The most that could be paid is escrowed, and the rest returned when the auction closes. Setting a new max bid replaces the previous one.
+
+Example:
+$ %s tx %s max-bid 34 1000usdx --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("auction-id '%s' not a valid uint", args[0])
+			}
+
+			maxBid, err := sdk.ParseCoin(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMaxBid(id, cliCtx.GetFromAddress(), maxBid)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSubmitProposal cli command for submitting a stuck auction proposal
+func GetCmdSubmitProposal(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "stuck-auction [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to settle or cancel an auction that failed to close",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to settle or cancel an auction that failed to close, along with an initial deposit.
+The proposal details must be supplied via a JSON file. The action is either "%s", which retries closing the auction,
+or "%s", which removes the auction without paying out.
+
+Example:
+$ %s tx gov submit-proposal stuck-auction <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Cancel Auction 7",
+  "description": "Auction 7 can not be paid out",
+  "auction_id": "7",
+  "action": "cancel",
+  "deposit": [
+    {
+      "denom": "ukava",
+      "amount": "10000000"
+    }
+  ]
+}
+`,
+				types.StuckAuctionSettle, types.StuckAuctionCancel, version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseStuckAuctionProposalJSON(cdc, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewStuckAuctionProposal(proposal.Title, proposal.Description, proposal.AuctionID, proposal.Action)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// StuckAuctionProposalJSON defines a StuckAuctionProposal with a deposit
+type StuckAuctionProposalJSON struct {
+	Title       string    `json:"title" yaml:"title"`
+	Description string    `json:"description" yaml:"description"`
+	AuctionID   uint64    `json:"auction_id" yaml:"auction_id"`
+	Action      string    `json:"action" yaml:"action"`
+	Deposit     sdk.Coins `json:"deposit" yaml:"deposit"`
+}
+
+// ParseStuckAuctionProposalJSON reads and parses a StuckAuctionProposalJSON from a file.
+func ParseStuckAuctionProposalJSON(cdc *codec.Codec, proposalFile string) (StuckAuctionProposalJSON, error) {
+	proposal := StuckAuctionProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,11 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/kava-labs/kava/x/auction/client/cli"
+	"github.com/kava-labs/kava/x/auction/client/rest"
+)
+
+// ProposalHandler is the stuck auction proposal handler for the gov module client
+var ProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitProposal, rest.ProposalRESTHandler)
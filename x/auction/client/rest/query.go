@@ -3,10 +3,12 @@ package rest
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 
 	"github.com/kava-labs/kava/x/auction/types"
@@ -18,6 +20,9 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/auctions", types.ModuleName), queryAuctionsHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/auctions/{%s}", types.ModuleName, restAuctionID), queryAuctionHandlerFn(cliCtx)).Methods("GET")
 	r.HandleFunc(fmt.Sprintf("/%s/parameters", types.ModuleName), getParamsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/stuck", types.ModuleName), queryStuckAuctionsHandlerFn(cliCtx)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/closed-auctions", types.ModuleName), queryArchiveHandlerFn(cliCtx, types.QueryGetClosedAuctions)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/bids", types.ModuleName), queryArchiveHandlerFn(cliCtx, types.QueryGetBidHistory)).Methods("GET")
 }
 
 func queryAuctionHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
@@ -74,8 +79,20 @@ func queryAuctionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		// Get all auctions
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryGetAuctions), nil)
+		// Prepare params for querier
+		params, err := parseAuctionsArgs(r)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		bz, err := cliCtx.Codec.MarshalJSON(params)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Get the matching auctions
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryGetAuctions), bz)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
 			return
@@ -99,6 +116,31 @@ func queryAuctionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// parseAuctionsArgs returns the auctions query params set by the page, limit, type, phase, initiator,
+// bidder, lot-denom, bid-denom and end-before query parameters of the request
+func parseAuctionsArgs(r *http.Request) (types.QueryAllAuctionParams, error) {
+	_, page, limit, err := rest.ParseHTTPArgsWithLimit(r, 0)
+	if err != nil {
+		return types.QueryAllAuctionParams{}, err
+	}
+	var bidder sdk.AccAddress
+	if bidderBech32 := r.FormValue(types.RestBidder); len(bidderBech32) != 0 {
+		bidder, err = sdk.AccAddressFromBech32(bidderBech32)
+		if err != nil {
+			return types.QueryAllAuctionParams{}, err
+		}
+	}
+	var endBefore time.Time
+	if endBeforeStr := r.FormValue(types.RestEndBefore); len(endBeforeStr) != 0 {
+		endBefore, err = time.Parse(time.RFC3339, endBeforeStr)
+		if err != nil {
+			return types.QueryAllAuctionParams{}, err
+		}
+	}
+	return types.NewQueryAllAuctionParams(page, limit, r.FormValue(types.RestType), r.FormValue(types.RestPhase),
+		r.FormValue(types.RestInitiator), bidder, r.FormValue(types.RestLotDenom), r.FormValue(types.RestBidDenom), endBefore), nil
+}
+
 func getParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		// Parse the query height
@@ -117,3 +159,67 @@ func getParamsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
+
+func queryStuckAuctionsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Parse the query height
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+		// Get the stuck auctions
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, types.QueryGetStuckAuctions), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		// Decode and return results
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// queryArchiveHandlerFn returns a handler for the closed auction or bid history query at the input path,
+// filtered by the auction-id and bidder query parameters of the request
+func queryArchiveHandlerFn(cliCtx context.CLIContext, queryPath string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Parse the query height
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// Prepare params for querier
+		var auctionID uint64
+		if auctionIDStr := r.FormValue(restAuctionID); len(auctionIDStr) != 0 {
+			auctionID, ok = rest.ParseUint64OrReturnBadRequest(w, auctionIDStr)
+			if !ok {
+				return
+			}
+		}
+		var bidder sdk.AccAddress
+		if bidderBech32 := r.FormValue(types.RestBidder); len(bidderBech32) != 0 {
+			var err error
+			bidder, err = sdk.AccAddressFromBech32(bidderBech32)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+		bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryArchiveParams(auctionID, bidder))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Query
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.ModuleName, queryPath), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		// Decode and return results
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -10,12 +10,18 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 
 	"github.com/kava-labs/kava/x/auction/types"
 )
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/%s/auctions/{%s}/bids", types.ModuleName, restAuctionID), bidHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/auctions/{%s}/partial-bids", types.ModuleName, restAuctionID), partialBidHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/auctions/{%s}/commitments", types.ModuleName, restAuctionID), commitBidHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/auctions/{%s}/reveals", types.ModuleName, restAuctionID), revealBidHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/auctions/{%s}/max-bids", types.ModuleName, restAuctionID), maxBidHandlerFn(cliCtx)).Methods("POST")
 }
 
 type placeBidReq struct {
@@ -23,6 +29,38 @@ type placeBidReq struct {
 	Amount  sdk.Coin     `json:"amount"`
 }
 
+type placePartialBidReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Lot     sdk.Coin     `json:"lot"`
+}
+
+type commitBidReq struct {
+	BaseReq    rest.BaseReq `json:"base_req"`
+	Commitment []byte       `json:"commitment"`
+}
+
+type revealBidReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	Amount  sdk.Coin     `json:"amount"`
+	Salt    string       `json:"salt"`
+}
+
+type setMaxBidReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+	MaxBid  sdk.Coin     `json:"max_bid"`
+}
+
+type stuckAuctionProposalReq struct {
+	BaseReq rest.BaseReq `json:"base_req"`
+
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	AuctionID   uint64         `json:"auction_id"`
+	Action      string         `json:"action"`
+	Proposer    sdk.AccAddress `json:"proposer"`
+	Deposit     sdk.Coins      `json:"deposit"`
+}
+
 func bidHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 
@@ -56,3 +94,171 @@ func bidHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func partialBidHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		// Get auction ID from url
+		auctionID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)[restAuctionID])
+		if !ok {
+			return
+		}
+
+		// Get info from the http request body
+		var req placePartialBidReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		bidderAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Create and return a StdTx
+		msg := types.NewMsgPlacePartialBid(auctionID, bidderAddr, req.Lot)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func commitBidHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		// Get auction ID from url
+		auctionID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)[restAuctionID])
+		if !ok {
+			return
+		}
+
+		// Get info from the http request body
+		var req commitBidReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		bidderAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Create and return a StdTx
+		msg := types.NewMsgCommitBid(auctionID, bidderAddr, req.Commitment)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func revealBidHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		// Get auction ID from url
+		auctionID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)[restAuctionID])
+		if !ok {
+			return
+		}
+
+		// Get info from the http request body
+		var req revealBidReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		bidderAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Create and return a StdTx
+		msg := types.NewMsgRevealBid(auctionID, bidderAddr, req.Amount, req.Salt)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func maxBidHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		// Get auction ID from url
+		auctionID, ok := rest.ParseUint64OrReturnBadRequest(w, mux.Vars(r)[restAuctionID])
+		if !ok {
+			return
+		}
+
+		// Get info from the http request body
+		var req setMaxBidReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+		bidderAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// Create and return a StdTx
+		msg := types.NewMsgSetMaxBid(auctionID, bidderAddr, req.MaxBid)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+// ProposalRESTHandler returns a ProposalRESTHandler that exposes the stuck auction proposal REST handler with a given sub-route.
+func ProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "stuck_auction",
+		Handler:  postProposalHandlerFn(cliCtx),
+	}
+}
+
+func postProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req stuckAuctionProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewStuckAuctionProposal(req.Title, req.Description, req.AuctionID, req.Action)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
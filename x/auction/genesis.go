@@ -18,11 +18,30 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 
 	keeper.SetParams(ctx, gs.Params)
 
+	for _, ca := range gs.ClosedAuctions {
+		keeper.SetClosedAuction(ctx, ca)
+	}
+	nextBidSequence := uint64(0)
+	for _, b := range gs.BidHistory {
+		keeper.SetAuctionBid(ctx, b)
+		if b.Sequence >= nextBidSequence {
+			nextBidSequence = b.Sequence + 1
+		}
+	}
+	keeper.SetNextBidSequence(ctx, nextBidSequence)
+
 	totalAuctionCoins := sdk.NewCoins()
 	for _, a := range gs.Auctions {
 		keeper.SetAuction(ctx, a)
 		// find the total coins that should be present in the module account
-		totalAuctionCoins.Add(a.GetModuleAccountCoins())
+		totalAuctionCoins = totalAuctionCoins.Add(a.GetModuleAccountCoins())
+	}
+	for _, pb := range gs.ProxyBids {
+		keeper.SetProxyBid(ctx, pb)
+		totalAuctionCoins = totalAuctionCoins.Add(sdk.NewCoins(pb.Escrow))
+	}
+	for _, sa := range gs.StuckAuctions {
+		keeper.SetStuckAuction(ctx, sa)
 	}
 
 	// check if the module account exists
@@ -33,7 +52,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, supplyKeeper types.SupplyKeeper
 	// check module coins match auction coins
 	// Note: Other sdk modules do not check this, instead just using the existing module account coins, or if zero, setting them.
 	if !moduleAcc.GetCoins().IsEqual(totalAuctionCoins) {
-		panic(fmt.Sprintf("total auction coins (%s) do not equal (%s) module account (%s) ", moduleAcc.GetCoins(), ModuleName, totalAuctionCoins))
+		panic(fmt.Sprintf("total auction coins (%s) do not equal (%s) module account (%s) ", totalAuctionCoins, ModuleName, moduleAcc.GetCoins()))
 	}
 }
 
@@ -56,5 +75,25 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
 		return false
 	})
 
-	return NewGenesisState(nextAuctionID, params, genAuctions)
+	closedAuctions := ClosedAuctions{}
+	keeper.IterateClosedAuctions(ctx, func(ca ClosedAuction) bool {
+		closedAuctions = append(closedAuctions, ca)
+		return false
+	})
+
+	bidHistory := AuctionBids{}
+	keeper.IterateBidHistory(ctx, func(b AuctionBid) bool {
+		bidHistory = append(bidHistory, b)
+		return false
+	})
+
+	proxyBids := ProxyBids{}
+	keeper.IterateAllProxyBids(ctx, func(pb ProxyBid) bool {
+		proxyBids = append(proxyBids, pb)
+		return false
+	})
+
+	stuckAuctions := keeper.GetAllStuckAuctions(ctx)
+
+	return NewGenesisState(nextAuctionID, params, genAuctions, closedAuctions, bidHistory, proxyBids, stuckAuctions)
 }
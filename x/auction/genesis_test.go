@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -21,6 +22,7 @@ var testAuction = auction.NewCollateralAuction(
 	c("biddenom", 1000),
 	auction.WeightedAddresses{},
 	c("debt", 1000),
+	sdk.ZeroDec(),
 ).WithID(3).(auction.GenesisAuction)
 
 func TestInitGenesis(t *testing.T) {
@@ -34,8 +36,14 @@ func TestInitGenesis(t *testing.T) {
 			10,
 			auction.DefaultParams(),
 			auction.GenesisAuctions{testAuction},
+			auction.ClosedAuctions{},
+			auction.AuctionBids{},
+			auction.ProxyBids{},
+			auction.StuckAuctions{},
 		)
 
+		fundModuleAccount(ctx, tApp, testAuction.GetModuleAccountCoins())
+
 		// run init
 		require.NotPanics(t, func() {
 			auction.InitGenesis(ctx, keeper, tApp.GetSupplyKeeper(), gs)
@@ -59,6 +67,38 @@ func TestInitGenesis(t *testing.T) {
 			return false
 		})
 	})
+	t.Run("live auction and proxy bid", func(t *testing.T) {
+		_, addrs := app.GeneratePrivKeyAddressPairs(1)
+		proxy := auction.NewProxyBid(3, addrs[0], c("lotdenom", 5), c("biddenom", 1000))
+		gs := auction.NewGenesisState(
+			10,
+			auction.DefaultParams(),
+			auction.GenesisAuctions{testAuction},
+			auction.ClosedAuctions{},
+			auction.AuctionBids{},
+			auction.ProxyBids{proxy},
+			auction.StuckAuctions{},
+		)
+
+		// the module account must hold the auction coins and the proxy bid escrow
+		tApp := app.NewTestApp()
+		ctx := tApp.NewContext(true, abci.Header{})
+		fundModuleAccount(ctx, tApp, testAuction.GetModuleAccountCoins().Add(cs(proxy.Escrow)))
+		require.NotPanics(t, func() {
+			auction.InitGenesis(ctx, tApp.GetAuctionKeeper(), tApp.GetSupplyKeeper(), gs)
+		})
+		actualProxy, found := tApp.GetAuctionKeeper().GetProxyBid(ctx, 3, addrs[0])
+		require.True(t, found)
+		require.Equal(t, proxy, actualProxy)
+
+		// init fails if the escrow is missing
+		tApp = app.NewTestApp()
+		ctx = tApp.NewContext(true, abci.Header{})
+		fundModuleAccount(ctx, tApp, testAuction.GetModuleAccountCoins())
+		require.Panics(t, func() {
+			auction.InitGenesis(ctx, tApp.GetAuctionKeeper(), tApp.GetSupplyKeeper(), gs)
+		})
+	})
 	t.Run("invalid", func(t *testing.T) {
 		// setup keepers
 		tApp := app.NewTestApp()
@@ -69,6 +109,10 @@ func TestInitGenesis(t *testing.T) {
 			0, // next id < testAuction ID
 			auction.DefaultParams(),
 			auction.GenesisAuctions{testAuction},
+			auction.ClosedAuctions{},
+			auction.AuctionBids{},
+			auction.ProxyBids{},
+			auction.StuckAuctions{},
 		)
 
 		// check init fails
@@ -106,4 +150,35 @@ func TestExportGenesis(t *testing.T) {
 		expectedGenesisState.Auctions = append(expectedGenesisState.Auctions, testAuction)
 		require.Equal(t, expectedGenesisState, gs)
 	})
+	t.Run("archive, proxy bids and stuck auctions", func(t *testing.T) {
+		// setup state
+		tApp := app.NewTestApp()
+		ctx := tApp.NewContext(true, abci.Header{})
+		_, addrs := app.GeneratePrivKeyAddressPairs(1)
+		closed := auction.NewClosedAuction(2, "collateral", "seller", c("lotdenom", 10), c("biddenom", 500), addrs[0], 5, testTime)
+		bids := auction.AuctionBids{
+			auction.NewAuctionBid(2, 0, auction.EventTypeAuctionBid, addrs[0], c("lotdenom", 10), c("biddenom", 500), 4, testTime),
+			auction.NewAuctionBid(3, 1, auction.EventTypeAuctionBid, addrs[0], c("lotdenom", 10), c("biddenom", 100), 5, testTime),
+		}
+		proxies := auction.ProxyBids{auction.NewProxyBid(3, addrs[0], c("lotdenom", 5), c("biddenom", 1000))}
+		stuck := auction.StuckAuctions{auction.NewStuckAuction(3, "insufficient coins", testTime, 2)}
+		gs := auction.NewGenesisState(10, auction.DefaultParams(), auction.GenesisAuctions{testAuction}, auction.ClosedAuctions{closed}, bids, proxies, stuck)
+		fundModuleAccount(ctx, tApp, testAuction.GetModuleAccountCoins().Add(cs(proxies[0].Escrow)))
+		auction.InitGenesis(ctx, tApp.GetAuctionKeeper(), tApp.GetSupplyKeeper(), gs)
+
+		// new bids continue the bid history sequence
+		require.Equal(t, uint64(2), tApp.GetAuctionKeeper().GetNextBidSequence(ctx))
+
+		// export
+		require.Equal(t, gs, auction.ExportGenesis(ctx, tApp.GetAuctionKeeper()))
+	})
+}
+
+// fundModuleAccount sets the coins held by the auction module account, as the auth genesis would.
+func fundModuleAccount(ctx sdk.Context, tApp app.TestApp, coins sdk.Coins) {
+	macc := tApp.GetSupplyKeeper().GetModuleAccount(ctx, auction.ModuleName)
+	if err := macc.SetCoins(coins); err != nil {
+		panic(err)
+	}
+	tApp.GetSupplyKeeper().SetModuleAccount(ctx, macc)
 }
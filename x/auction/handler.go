@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/kava-labs/kava/x/auction/types"
 )
@@ -16,6 +17,14 @@ func NewHandler(keeper Keeper) sdk.Handler {
 		switch msg := msg.(type) {
 		case MsgPlaceBid:
 			return handleMsgPlaceBid(ctx, keeper, msg)
+		case MsgPlacePartialBid:
+			return handleMsgPlacePartialBid(ctx, keeper, msg)
+		case MsgCommitBid:
+			return handleMsgCommitBid(ctx, keeper, msg)
+		case MsgRevealBid:
+			return handleMsgRevealBid(ctx, keeper, msg)
+		case MsgSetMaxBid:
+			return handleMsgSetMaxBid(ctx, keeper, msg)
 		default:
 			return sdk.ErrUnknownRequest(fmt.Sprintf("Unrecognized auction msg type: %T", msg)).Result()
 		}
@@ -41,3 +50,106 @@ func handleMsgPlaceBid(ctx sdk.Context, keeper Keeper, msg MsgPlaceBid) sdk.Resu
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+func handleMsgPlacePartialBid(ctx sdk.Context, keeper Keeper, msg MsgPlacePartialBid) sdk.Result {
+
+	err := keeper.PlacePartialBid(ctx, msg.AuctionID, msg.Bidder, msg.Lot)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+	)
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgCommitBid(ctx sdk.Context, keeper Keeper, msg MsgCommitBid) sdk.Result {
+
+	err := keeper.CommitBid(ctx, msg.AuctionID, msg.Bidder, msg.Commitment)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+	)
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgRevealBid(ctx sdk.Context, keeper Keeper, msg MsgRevealBid) sdk.Result {
+
+	err := keeper.RevealBid(ctx, msg.AuctionID, msg.Bidder, msg.Amount, msg.Salt)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+	)
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+func handleMsgSetMaxBid(ctx sdk.Context, keeper Keeper, msg MsgSetMaxBid) sdk.Result {
+
+	err := keeper.SetMaxBid(ctx, msg.AuctionID, msg.Bidder, msg.MaxBid)
+	if err != nil {
+		return err.Result()
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Bidder.String()),
+		),
+	)
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// NewStuckAuctionProposalHandler returns a handler for governance proposals that settle or cancel auctions that failed to close.
+func NewStuckAuctionProposalHandler(keeper Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) sdk.Error {
+		switch c := content.(type) {
+		case StuckAuctionProposal:
+			return handleStuckAuctionProposal(ctx, keeper, c)
+		default:
+			return sdk.ErrUnknownRequest(fmt.Sprintf("Unrecognized auction proposal content type: %T", c))
+		}
+	}
+}
+
+func handleStuckAuctionProposal(ctx sdk.Context, keeper Keeper, p StuckAuctionProposal) sdk.Error {
+	switch p.Action {
+	case types.StuckAuctionSettle:
+		return keeper.SettleStuckAuction(ctx, p.AuctionID)
+	case types.StuckAuctionCancel:
+		return keeper.CancelStuckAuction(ctx, p.AuctionID)
+	default:
+		return types.ErrInvalidStuckAuctionAction(types.DefaultCodespace, p.Action)
+	}
+}
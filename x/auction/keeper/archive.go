@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// recordBid appends an accepted bid to the bid history of an auction, if the archive is enabled, and calls the
+// AfterBidPlaced hook of the auction's initiator.
+func (k Keeper) recordBid(ctx sdk.Context, auction types.Auction, bidType string, bidder sdk.AccAddress, lot, bid sdk.Coin) {
+	k.afterBidPlaced(ctx, auction, bidder, lot, bid)
+	if k.GetParams(ctx).ArchiveRetention == 0 {
+		return
+	}
+	sequence := k.GetNextBidSequence(ctx)
+	k.SetAuctionBid(ctx, types.NewAuctionBid(auction.GetID(), sequence, bidType, bidder, lot, bid, ctx.BlockHeight(), ctx.BlockTime()))
+	k.SetNextBidSequence(ctx, sequence+1)
+}
+
+// closedAuction returns the outcome of an auction that has closed. Sealed-bid auctions record the price paid by the winner.
+// Collateral auctions record the lot sold and bid raised by partial fills, along with the remaining lot and bid if they have a winner,
+// and dutch auctions record the lot sold and the total paid for it.
+func (k Keeper) closedAuction(ctx sdk.Context, auction types.Auction) types.ClosedAuction {
+	lot, bid := auction.GetLot(), auction.GetBid()
+	switch a := auction.(type) {
+	case types.SealedBidAuction:
+		if a.Kind == types.SealedBidKindDebt {
+			lot = a.WinningPrice()
+		} else {
+			bid = a.WinningPrice()
+		}
+	case types.CollateralAuction:
+		lot, bid = a.LotSold, a.BidRaised
+		if !a.Bidder.Empty() {
+			lot, bid = lot.Add(a.Lot), bid.Add(a.Bid)
+		}
+	case types.DutchAuction:
+		lot = a.LotSold
+	}
+	return types.NewClosedAuction(auction.GetID(), auction.GetType(), auction.GetInitiator(), lot, bid, auction.GetBidder(), ctx.BlockHeight(), ctx.BlockTime())
+}
+
+// cancelledAuction returns the outcome of an auction cancelled by governance. Its latest bid is refunded so it has no winner,
+// and only the lot sold by partial fills and dutch auction purchases is recorded.
+func (k Keeper) cancelledAuction(ctx sdk.Context, auction types.Auction) types.ClosedAuction {
+	lot, bid := sdk.NewCoin(auction.GetLot().Denom, sdk.ZeroInt()), sdk.NewCoin(auction.GetBid().Denom, sdk.ZeroInt())
+	switch a := auction.(type) {
+	case types.CollateralAuction:
+		lot, bid = a.LotSold, a.BidRaised
+	case types.DutchAuction:
+		lot, bid = a.LotSold, a.Bid
+	}
+	return types.NewClosedAuction(auction.GetID(), auction.GetType(), auction.GetInitiator(), lot, bid, nil, ctx.BlockHeight(), ctx.BlockTime())
+}
+
+// archiveAuction records the outcome of an auction that has closed, if the archive is enabled.
+// The bid history of the auction is deleted when the archive is disabled, as it would otherwise never be pruned.
+func (k Keeper) archiveAuction(ctx sdk.Context, closed types.ClosedAuction) {
+	if k.GetParams(ctx).ArchiveRetention == 0 {
+		k.deleteBidHistory(ctx, closed.ID)
+		return
+	}
+	k.SetClosedAuction(ctx, closed)
+}
+
+// SetClosedAuction sets a closed auction and its close time index in the store
+func (k Keeper) SetClosedAuction(ctx sdk.Context, closed types.ClosedAuction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedAuctionKeyPrefix)
+	store.Set(types.GetAuctionKey(closed.ID), k.cdc.MustMarshalBinaryLengthPrefixed(closed))
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedByTimeKeyPrefix)
+	timeStore.Set(types.GetAuctionByTimeKey(closed.CloseTime, closed.ID), types.Uint64ToBytes(closed.ID))
+}
+
+// GetClosedAuction returns a closed auction from the archive
+func (k Keeper) GetClosedAuction(ctx sdk.Context, auctionID uint64) (types.ClosedAuction, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedAuctionKeyPrefix)
+	bz := store.Get(types.GetAuctionKey(auctionID))
+	if bz == nil {
+		return types.ClosedAuction{}, false
+	}
+	var closed types.ClosedAuction
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &closed)
+	return closed, true
+}
+
+// IterateClosedAuctions iterates over the archive of closed auctions in ID order and performs a callback function
+func (k Keeper) IterateClosedAuctions(ctx sdk.Context, cb func(closed types.ClosedAuction) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedAuctionKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var closed types.ClosedAuction
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &closed)
+		if cb(closed) {
+			break
+		}
+	}
+}
+
+// SetAuctionBid sets an entry in the bid history of an auction
+func (k Keeper) SetAuctionBid(ctx sdk.Context, bid types.AuctionBid) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionBidKeyPrefix)
+	store.Set(types.GetAuctionBidKey(bid.AuctionID, bid.Sequence), k.cdc.MustMarshalBinaryLengthPrefixed(bid))
+}
+
+// GetBidHistory returns the bid history of an auction, ordered from oldest to newest bid.
+// The history is kept after the auction closes, until its archive entry is pruned.
+func (k Keeper) GetBidHistory(ctx sdk.Context, auctionID uint64) types.AuctionBids {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionBidKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetAuctionKey(auctionID))
+	defer iterator.Close()
+
+	bids := types.AuctionBids{}
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.AuctionBid
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &bid)
+		bids = append(bids, bid)
+	}
+	return bids
+}
+
+// IterateBidHistory iterates over the bid history of all auctions and performs a callback function
+func (k Keeper) IterateBidHistory(ctx sdk.Context, cb func(bid types.AuctionBid) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionBidKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var bid types.AuctionBid
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &bid)
+		if cb(bid) {
+			break
+		}
+	}
+}
+
+// deleteBidHistory deletes the bid history of an auction
+func (k Keeper) deleteBidHistory(ctx sdk.Context, auctionID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AuctionBidKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetAuctionKey(auctionID))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// PruneArchive deletes the closed auctions that closed longer ago than the archive retention, along with their bid history.
+// All closed auctions are deleted when the archive is disabled.
+func (k Keeper) PruneArchive(ctx sdk.Context) {
+	cutoff := ctx.BlockTime().Add(-k.GetParams(ctx).ArchiveRetention)
+	timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedByTimeKeyPrefix)
+	iterator := timeStore.Iterator(nil, sdk.FormatTimeBytes(cutoff))
+
+	var timeKeys [][]byte
+	var auctionIDs []uint64
+	for ; iterator.Valid(); iterator.Next() {
+		timeKeys = append(timeKeys, iterator.Key())
+		auctionIDs = append(auctionIDs, types.Uint64FromBytes(iterator.Value()))
+	}
+	iterator.Close()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ClosedAuctionKeyPrefix)
+	for i, id := range auctionIDs {
+		timeStore.Delete(timeKeys[i])
+		store.Delete(types.GetAuctionKey(id))
+		k.deleteBidHistory(ctx, id)
+	}
+}
+
+// GetNextBidSequence returns the sequence of the next bid history entry
+func (k Keeper) GetNextBidSequence(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.NextBidSequenceKey)
+	if bz == nil {
+		return 0
+	}
+	return types.Uint64FromBytes(bz)
+}
+
+// SetNextBidSequence sets the sequence of the next bid history entry
+func (k Keeper) SetNextBidSequence(ctx sdk.Context, sequence uint64) {
+	ctx.KVStore(k.storeKey).Set(types.NextBidSequenceKey, types.Uint64ToBytes(sequence))
+}
@@ -0,0 +1,184 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp"
+)
+
+func TestAuctionArchive(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	returnAddrs := addrs[2:]
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(addrs[0], cs(c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(addrs[1], cs(c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{Height: 1})
+	keeper := tApp.GetAuctionKeeper()
+	params := keeper.GetParams(ctx)
+	params.ArchiveRetention = 24 * time.Hour
+	params.ReserveRelists = 0
+	keeper.SetParams(ctx, params)
+
+	// Bid on a collateral auction through both phases, and leave a reserved surplus auction without bids
+	collateralID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, []sdk.Int{sdk.NewInt(1)}, c("debt", 40), sdk.ZeroDec())
+	require.NoError(t, err)
+	unsoldID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 10), "token2", sdk.OneDec())
+	require.NoError(t, err)
+	require.NoError(t, keeper.PlaceBid(ctx, collateralID, addrs[1], c("token2", 10)))
+	require.NoError(t, keeper.PlaceBid(ctx, collateralID, addrs[0], c("token2", 50)))
+	require.NoError(t, keeper.PlaceBid(ctx, collateralID, addrs[0], c("token1", 15)))
+
+	expectedBids := types.AuctionBids{
+		types.NewAuctionBid(collateralID, 0, types.EventTypeAuctionBid, addrs[1], c("token1", 20), c("token2", 10), 1, ctx.BlockTime()),
+		types.NewAuctionBid(collateralID, 1, types.EventTypeAuctionBid, addrs[0], c("token1", 20), c("token2", 50), 1, ctx.BlockTime()),
+		types.NewAuctionBid(collateralID, 2, types.EventTypeAuctionBid, addrs[0], c("token1", 15), c("token2", 50), 1, ctx.BlockTime()),
+	}
+	require.Equal(t, expectedBids, keeper.GetBidHistory(ctx, collateralID))
+	require.Equal(t, types.AuctionBids{}, keeper.GetBidHistory(ctx, unsoldID))
+
+	// Closed auctions are archived with their bid history
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxAuctionDuration))
+	keeper.CloseExpiredAuctions(ctx)
+	closed, found := keeper.GetClosedAuction(ctx, collateralID)
+	require.True(t, found)
+	require.Equal(t, types.NewClosedAuction(collateralID, "collateral", sellerModName, c("token1", 15), c("token2", 50), addrs[0], 2, ctx.BlockTime()), closed)
+	closed, found = keeper.GetClosedAuction(ctx, unsoldID)
+	require.True(t, found)
+	require.Empty(t, closed.Winner)
+	require.Equal(t, expectedBids, keeper.GetBidHistory(ctx, collateralID))
+
+	// Archive is kept for the retention period
+	closeTime := ctx.BlockTime()
+	ctx = ctx.WithBlockTime(closeTime.Add(params.ArchiveRetention))
+	keeper.PruneArchive(ctx)
+	_, found = keeper.GetClosedAuction(ctx, collateralID)
+	require.True(t, found)
+
+	// Archive and bid history are pruned once older than the retention period
+	ctx = ctx.WithBlockTime(closeTime.Add(params.ArchiveRetention + time.Second))
+	keeper.PruneArchive(ctx)
+	_, found = keeper.GetClosedAuction(ctx, collateralID)
+	require.False(t, found)
+	_, found = keeper.GetClosedAuction(ctx, unsoldID)
+	require.False(t, found)
+	require.Equal(t, types.AuctionBids{}, keeper.GetBidHistory(ctx, collateralID))
+}
+
+func TestAuctionArchiveFills(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	returnAddrs := addrs[2:]
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(addrs[0], cs(c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(addrs[1], cs(c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{Height: 1})
+	keeper := tApp.GetAuctionKeeper()
+	params := keeper.GetParams(ctx)
+	params.ArchiveRetention = 24 * time.Hour
+	params.ReserveRelists = 0
+	keeper.SetParams(ctx, params)
+
+	// Part of a collateral auction is bought before the rest is won
+	wonID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, []sdk.Int{sdk.NewInt(1)}, c("debt", 10), sdk.ZeroDec())
+	require.NoError(t, err)
+	require.NoError(t, keeper.PlaceBid(ctx, wonID, addrs[1], c("token2", 20)))
+	require.NoError(t, keeper.PlacePartialBid(ctx, wonID, addrs[0], c("token1", 5)))
+
+	// Part of a reserved collateral auction is bought, and the rest is returned without a winner
+	returnedID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, []sdk.Int{sdk.NewInt(1)}, c("debt", 10), sdk.OneDec())
+	require.NoError(t, err)
+	require.NoError(t, keeper.PlacePartialBid(ctx, returnedID, addrs[0], c("token1", 5)))
+
+	// Part of a dutch auction is bought before it ends, and all of another is bought, each at the start price of 1.2
+	unsoldID, err := keeper.StartDutchAuction(ctx, sellerModName, c("token1", 20), c("token2", 100), sdk.OneDec(), returnAddrs, []sdk.Int{sdk.NewInt(1)}, c("debt", 10))
+	require.NoError(t, err)
+	require.NoError(t, keeper.PlacePartialBid(ctx, unsoldID, addrs[1], c("token1", 10)))
+	soldOutID, err := keeper.StartDutchAuction(ctx, sellerModName, c("token1", 20), c("token2", 100), sdk.OneDec(), returnAddrs, []sdk.Int{sdk.NewInt(1)}, c("debt", 10))
+	require.NoError(t, err)
+	require.NoError(t, keeper.PlacePartialBid(ctx, soldOutID, addrs[0], c("token1", 20)))
+
+	// Auctions are archived with the total lot sold and bid raised
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxAuctionDuration))
+	keeper.CloseExpiredAuctions(ctx)
+	for _, expected := range []types.ClosedAuction{
+		types.NewClosedAuction(wonID, types.AuctionTypeCollateral, sellerModName, c("token1", 20), c("token2", 20), addrs[1], 2, ctx.BlockTime()),
+		types.NewClosedAuction(returnedID, types.AuctionTypeCollateral, sellerModName, c("token1", 5), c("token2", 5), nil, 2, ctx.BlockTime()),
+		types.NewClosedAuction(unsoldID, types.AuctionTypeDutch, sellerModName, c("token1", 10), c("token2", 12), addrs[1], 2, ctx.BlockTime()),
+		types.NewClosedAuction(soldOutID, types.AuctionTypeDutch, sellerModName, c("token1", 20), c("token2", 24), addrs[0], 2, ctx.BlockTime()),
+	} {
+		closed, found := keeper.GetClosedAuction(ctx, expected.ID)
+		require.True(t, found)
+		require.Equal(t, expected, closed)
+	}
+}
+
+func TestAuctionArchiveDisabled(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	buyer := addrs[0]
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Bids are not recorded by default
+	auctionID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 10)))
+	require.Equal(t, types.AuctionBids{}, keeper.GetBidHistory(ctx, auctionID))
+
+	params := keeper.GetParams(ctx)
+	params.ArchiveRetention = 24 * time.Hour
+	keeper.SetParams(ctx, params)
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 20)))
+	require.Len(t, keeper.GetBidHistory(ctx, auctionID), 1)
+
+	// Auctions closing while the archive is disabled are not archived, and their bid history is deleted
+	params.ArchiveRetention = 0
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	keeper.CloseExpiredAuctions(ctx)
+	_, found := keeper.GetAuction(ctx, auctionID)
+	require.False(t, found)
+	_, found = keeper.GetClosedAuction(ctx, auctionID)
+	require.False(t, found)
+	require.Equal(t, types.AuctionBids{}, keeper.GetBidHistory(ctx, auctionID))
+}
@@ -9,14 +9,19 @@ import (
 	"github.com/kava-labs/kava/x/auction/types"
 )
 
-// StartSurplusAuction starts a new surplus (forward) auction.
-func (k Keeper) StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin, bidDenom string) (uint64, sdk.Error) {
+// StartSurplusAuction starts a new surplus (forward) auction, or a sealed-bid auction if the SealedBidSurplus param is set.
+// Surplus auctions with a positive reserve price (in units of the bid denom per unit of the lot) only accept bids at or above it. Sealed-bid auctions have no reserve price.
+func (k Keeper) StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin, bidDenom string, reservePrice sdk.Dec) (uint64, sdk.Error) {
 
-	auction := types.NewSurplusAuction(
+	var auction types.Auction = types.NewSurplusAuction(
 		seller,
 		lot,
 		bidDenom,
-		types.DistantFuture)
+		k.startingEndTime(ctx, types.AuctionTypeSurplus, lot.Denom, reservePrice),
+		reservePrice)
+	if k.GetParams(ctx).SealedBidSurplus {
+		auction = k.newSealedBidAuction(ctx, seller, types.SealedBidKindSurplus, lot, sdk.NewInt64Coin(bidDenom, 0), sdk.NewInt64Coin(lot.Denom, 0))
+	}
 
 	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
 	if err != nil {
@@ -33,22 +38,26 @@ func (k Keeper) StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin
 			types.EventTypeAuctionStart,
 			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.GetID())),
 			sdk.NewAttribute(types.AttributeKeyAuctionType, auction.GetType()),
-			sdk.NewAttribute(types.AttributeKeyBidDenom, auction.Bid.Denom),
-			sdk.NewAttribute(types.AttributeKeyLotDenom, auction.Lot.Denom),
+			sdk.NewAttribute(types.AttributeKeyBidDenom, auction.GetBid().Denom),
+			sdk.NewAttribute(types.AttributeKeyLotDenom, auction.GetLot().Denom),
 		),
 	)
+	k.afterAuctionStarted(ctx, auction.WithID(auctionID))
 	return auctionID, nil
 }
 
-// StartDebtAuction starts a new debt (reverse) auction.
+// StartDebtAuction starts a new debt (reverse) auction, or a sealed-bid auction if the SealedBidDebt param is set.
 func (k Keeper) StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, initialLot sdk.Coin, debt sdk.Coin) (uint64, sdk.Error) {
 
-	auction := types.NewDebtAuction(
+	var auction types.Auction = types.NewDebtAuction(
 		buyer,
 		bid,
 		initialLot,
 		types.DistantFuture,
 		debt)
+	if k.GetParams(ctx).SealedBidDebt {
+		auction = k.newSealedBidAuction(ctx, buyer, types.SealedBidKindDebt, initialLot, bid, debt)
+	}
 
 	// This auction type mints coins at close. Need to check module account has minting privileges to avoid potential err in endblocker.
 	macc := k.supplyKeeper.GetModuleAccount(ctx, buyer)
@@ -71,15 +80,17 @@ func (k Keeper) StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, in
 			types.EventTypeAuctionStart,
 			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.GetID())),
 			sdk.NewAttribute(types.AttributeKeyAuctionType, auction.GetType()),
-			sdk.NewAttribute(types.AttributeKeyBidDenom, auction.Bid.Denom),
-			sdk.NewAttribute(types.AttributeKeyLotDenom, auction.Lot.Denom),
+			sdk.NewAttribute(types.AttributeKeyBidDenom, auction.GetBid().Denom),
+			sdk.NewAttribute(types.AttributeKeyLotDenom, auction.GetLot().Denom),
 		),
 	)
+	k.afterAuctionStarted(ctx, auction.WithID(auctionID))
 	return auctionID, nil
 }
 
 // StartCollateralAuction starts a new collateral (2-phase) auction.
-func (k Keeper) StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, sdk.Error) {
+// Collateral auctions with a positive reserve price (in units of the maxBid denom per unit of the lot) only accept bids at or above it, or bids of all of maxBid.
+func (k Keeper) StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin, reservePrice sdk.Dec) (uint64, sdk.Error) {
 
 	weightedAddresses, err := types.NewWeightedAddresses(lotReturnAddrs, lotReturnWeights)
 	if err != nil {
@@ -88,8 +99,62 @@ func (k Keeper) StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.C
 	auction := types.NewCollateralAuction(
 		seller,
 		lot,
-		types.DistantFuture,
+		k.startingEndTime(ctx, types.AuctionTypeCollateral, lot.Denom, reservePrice),
+		maxBid,
+		weightedAddresses,
+		debt,
+		reservePrice)
+
+	err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(lot))
+	if err != nil {
+		return 0, err
+	}
+	err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, seller, types.ModuleName, sdk.NewCoins(debt))
+	if err != nil {
+		return 0, err
+	}
+
+	auctionID, err := k.StoreNewAuction(ctx, auction)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionStart,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.GetID())),
+			sdk.NewAttribute(types.AttributeKeyAuctionType, auction.GetType()),
+			sdk.NewAttribute(types.AttributeKeyBidDenom, auction.Bid.Denom),
+			sdk.NewAttribute(types.AttributeKeyLotDenom, auction.Lot.Denom),
+		),
+	)
+	k.afterAuctionStarted(ctx, auction.WithID(auctionID))
+	return auctionID, nil
+}
+
+// StartDutchAuction starts a new dutch (descending price) auction.
+// The auction starts at the input market price of one unit of the lot (in units of the maxBid denom) multiplied by the DutchPriceBuffer param.
+func (k Keeper) StartDutchAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, marketPrice sdk.Dec, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, sdk.Error) {
+
+	weightedAddresses, err := types.NewWeightedAddresses(lotReturnAddrs, lotReturnWeights)
+	if err != nil {
+		return 0, err
+	}
+	if marketPrice.IsNil() || !marketPrice.IsPositive() {
+		return 0, types.ErrInvalidPrice(k.codespace, marketPrice)
+	}
+	params := k.GetParams(ctx)
+	startPrice := marketPrice.Mul(params.DutchPriceBuffer)
+	auction := types.NewDutchAuction(
+		seller,
+		lot,
+		ctx.BlockTime(),
+		ctx.BlockTime().Add(params.ForAuction(types.AuctionTypeDutch, lot.Denom).MaxAuctionDuration),
 		maxBid,
+		startPrice,
+		params.DutchPriceDecay,
+		params.DutchDecayInterval,
+		startPrice.Mul(params.DutchMinPrice),
 		weightedAddresses,
 		debt)
 
@@ -116,11 +181,51 @@ func (k Keeper) StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.C
 			sdk.NewAttribute(types.AttributeKeyLotDenom, auction.Lot.Denom),
 		),
 	)
+	k.afterAuctionStarted(ctx, auction.WithID(auctionID))
 	return auctionID, nil
 }
 
-// PlaceBid places a bid on any auction.
+// startingEndTime returns the end time of a new auction. Auctions without a reserve price only end after receiving a bid,
+// while auctions with a reserve price end after MaxAuctionDuration so they can be restarted or returned if no bids meet the reserve.
+func (k Keeper) startingEndTime(ctx sdk.Context, auctionType, lotDenom string, reservePrice sdk.Dec) time.Time {
+	if reservePrice.IsPositive() {
+		return ctx.BlockTime().Add(k.GetParams(ctx).ForAuction(auctionType, lotDenom).MaxAuctionDuration)
+	}
+	return types.DistantFuture
+}
+
+// newSealedBidAuction returns a new sealed-bid auction with the current sealed-bid params.
+// The deposit is a fraction of the side of the auction that does not change, the lot of surplus auctions and the bid of debt auctions.
+func (k Keeper) newSealedBidAuction(ctx sdk.Context, initiator, kind string, lot, bid, debt sdk.Coin) types.SealedBidAuction {
+	params := k.GetParams(ctx)
+	fixed := lot
+	if kind == types.SealedBidKindDebt {
+		fixed = bid
+	}
+	deposit := sdk.NewCoin(fixed.Denom, sdk.NewDecFromInt(fixed.Amount).Mul(params.BidDeposit).Ceil().RoundInt())
+	commitEndTime := ctx.BlockTime().Add(params.CommitDuration)
+	return types.NewSealedBidAuction(
+		initiator,
+		kind,
+		params.SealedBidPricing,
+		lot,
+		bid,
+		commitEndTime,
+		commitEndTime.Add(params.RevealDuration),
+		deposit,
+		debt)
+}
+
+// PlaceBid places a bid on any auction, followed by any proxy bids that outbid it.
 func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, newAmount sdk.Coin) sdk.Error {
+	if err := k.placeBid(ctx, auctionID, bidder, newAmount); err != nil {
+		return err
+	}
+	return k.runProxyBids(ctx, auctionID)
+}
+
+// placeBid places a bid on any auction, without placing proxy bids.
+func (k Keeper) placeBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, newAmount sdk.Coin) sdk.Error {
 
 	auction, found := k.GetAuction(ctx, auctionID)
 	if !found {
@@ -145,6 +250,9 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 			return err
 		}
 	case types.CollateralAuction:
+		if a.IsComplete() {
+			return types.ErrAuctionHasExpired(k.codespace, auctionID)
+		}
 		if !a.IsReversePhase() {
 			updatedAuction, err = k.PlaceForwardBidCollateral(ctx, a, bidder, newAmount)
 		} else {
@@ -153,6 +261,12 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 		if err != nil {
 			return err
 		}
+	case types.DutchAuction:
+		if updatedAuction, err = k.PlaceBidDutch(ctx, a, bidder, newAmount); err != nil {
+			return err
+		}
+	case types.SealedBidAuction:
+		return types.ErrSealedBidsOnly(k.codespace, auctionID)
 	default:
 		return types.ErrUnrecognizedAuctionType(k.codespace)
 	}
@@ -162,6 +276,39 @@ func (k Keeper) PlaceBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddres
 	return nil
 }
 
+// PlacePartialBid buys part of the lot of an auction at the current price. Only collateral and dutch auctions support partial bids.
+func (k Keeper) PlacePartialBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, lot sdk.Coin) sdk.Error {
+
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return types.ErrAuctionNotFound(k.codespace, auctionID)
+	}
+
+	if ctx.BlockTime().After(auction.GetEndTime()) {
+		return types.ErrAuctionHasExpired(k.codespace, auctionID)
+	}
+
+	// move coins and return updated auction
+	var err sdk.Error
+	var updatedAuction types.Auction
+	switch a := auction.(type) {
+	case types.CollateralAuction:
+		if updatedAuction, err = k.PlacePartialBidCollateral(ctx, a, bidder, lot); err != nil {
+			return err
+		}
+	case types.DutchAuction:
+		if updatedAuction, err = k.PlaceBidDutch(ctx, a, bidder, lot); err != nil {
+			return err
+		}
+	default:
+		return types.ErrPartialBidNotSupported(k.codespace, auction.GetType())
+	}
+
+	k.SetAuction(ctx, updatedAuction)
+
+	return k.runProxyBids(ctx, auctionID)
+}
+
 // PlaceBidSurplus places a forward bid on a surplus auction, moving coins and returning the updated auction.
 func (k Keeper) PlaceBidSurplus(ctx sdk.Context, a types.SurplusAuction, bidder sdk.AccAddress, bid sdk.Coin) (types.SurplusAuction, sdk.Error) {
 	// Validate new bid
@@ -171,12 +318,15 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, a types.SurplusAuction, bidder
 	minNewBidAmt := a.Bid.Amount.Add( // new bids must be some % greater than old bid, and at least 1 larger to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdk.NewInt(1),
-			sdk.NewDecFromInt(a.Bid.Amount).Mul(k.GetParams(ctx).IncrementSurplus).RoundInt(),
+			sdk.NewDecFromInt(a.Bid.Amount).Mul(k.getAuctionParams(ctx, a).Increment).RoundInt(),
 		),
 	)
 	if bid.Amount.LT(minNewBidAmt) {
 		return a, types.ErrBidTooSmall(k.codespace, bid, sdk.NewCoin(a.Bid.Denom, minNewBidAmt))
 	}
+	if minBid := a.MinReserveBid(); bid.Amount.LT(minBid.Amount) {
+		return a, types.ErrBidBelowReserve(k.codespace, bid, minBid)
+	}
 
 	// New bidder pays back old bidder
 	// Catch edge cases of a bidder replacing their own bid, or the amount being zero (sending zero coins produces meaningless send events).
@@ -185,7 +335,7 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, a types.SurplusAuction, bidder
 		if err != nil {
 			return a, err
 		}
-		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, a.Bidder, sdk.NewCoins(a.Bid))
+		err = k.refundBid(ctx, a.ID, a.Bidder, a.Bid)
 		if err != nil {
 			return a, err
 		}
@@ -204,9 +354,9 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, a types.SurplusAuction, bidder
 	a.Bidder = bidder
 	a.Bid = bid
 	if !a.HasReceivedBids {
-		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		a.MaxEndTime = ctx.BlockTime().Add(k.getAuctionParams(ctx, a).MaxAuctionDuration) // set maximum ending time on receipt of first bid
 	}
-	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
+	a.EndTime = earliestTime(ctx.BlockTime().Add(k.getAuctionParams(ctx, a).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
 	a.HasReceivedBids = true
 
 	ctx.EventManager().EmitEvent(
@@ -218,6 +368,7 @@ func (k Keeper) PlaceBidSurplus(ctx sdk.Context, a types.SurplusAuction, bidder
 			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", a.EndTime.Unix())),
 		),
 	)
+	k.recordBid(ctx, a, types.EventTypeAuctionBid, a.Bidder, a.Lot, a.Bid)
 
 	return a, nil
 }
@@ -234,7 +385,7 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, a types.CollateralAuc
 	minNewBidAmt := a.Bid.Amount.Add( // new bids must be some % greater than old bid, and at least 1 larger to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdk.NewInt(1),
-			sdk.NewDecFromInt(a.Bid.Amount).Mul(k.GetParams(ctx).IncrementCollateral).RoundInt(),
+			sdk.NewDecFromInt(a.Bid.Amount).Mul(k.getAuctionParams(ctx, a).Increment).RoundInt(),
 		),
 	)
 	minNewBidAmt = sdk.MinInt(minNewBidAmt, a.MaxBid.Amount) // allow new bids to hit MaxBid even though it may be less than the increment %
@@ -244,6 +395,9 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, a types.CollateralAuc
 	if a.MaxBid.IsLT(bid) {
 		return a, types.ErrBidTooLarge(k.codespace, bid, a.MaxBid)
 	}
+	if minBid := a.MinReserveBid(); bid.Amount.LT(minBid.Amount) {
+		return a, types.ErrBidBelowReserve(k.codespace, bid, minBid)
+	}
 
 	// New bidder pays back old bidder
 	// Catch edge cases of a bidder replacing their own bid, and the amount being zero (sending zero coins produces meaningless send events).
@@ -252,7 +406,7 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, a types.CollateralAuc
 		if err != nil {
 			return a, err
 		}
-		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, a.Bidder, sdk.NewCoins(a.Bid))
+		err = k.refundBid(ctx, a.ID, a.Bidder, a.Bid)
 		if err != nil {
 			return a, err
 		}
@@ -280,9 +434,9 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, a types.CollateralAuc
 	a.Bidder = bidder
 	a.Bid = bid
 	if !a.HasReceivedBids {
-		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		a.MaxEndTime = ctx.BlockTime().Add(k.getAuctionParams(ctx, a).MaxAuctionDuration) // set maximum ending time on receipt of first bid
 	}
-	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
+	a.EndTime = earliestTime(ctx.BlockTime().Add(k.getAuctionParams(ctx, a).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
 	a.HasReceivedBids = true
 
 	ctx.EventManager().EmitEvent(
@@ -294,6 +448,106 @@ func (k Keeper) PlaceForwardBidCollateral(ctx sdk.Context, a types.CollateralAuc
 			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", a.EndTime.Unix())),
 		),
 	)
+	k.recordBid(ctx, a, types.EventTypeAuctionBid, a.Bidder, a.Lot, a.Bid)
+
+	return a, nil
+}
+
+// PlacePartialBidCollateral buys part of the lot of a collateral auction in forward phase, moving coins and returning the updated auction.
+// The part is priced at the current bid per unit of lot, or the reserve price if that is higher, and costs at most the remaining MaxBid.
+// The latest bidder keeps their bid on the rest of the lot at the same price, and is refunded their bid on the part that was bought.
+func (k Keeper) PlacePartialBidCollateral(ctx sdk.Context, a types.CollateralAuction, bidder sdk.AccAddress, lot sdk.Coin) (types.CollateralAuction, sdk.Error) {
+	// Validate new bid
+	if lot.Denom != a.Lot.Denom {
+		return a, types.ErrInvalidLotDenom(k.codespace, lot.Denom, a.Lot.Denom)
+	}
+	if a.IsComplete() {
+		return a, types.ErrAuctionHasExpired(k.codespace, a.ID)
+	}
+	if a.IsReversePhase() {
+		return a, types.ErrCollateralAuctionIsInReversePhase(k.codespace, a.ID)
+	}
+	if !lot.IsPositive() {
+		return a, types.ErrLotTooSmall(k.codespace, lot, sdk.NewCoin(a.Lot.Denom, sdk.ZeroInt()))
+	}
+	if lot.Amount.GT(a.Lot.Amount) {
+		return a, types.ErrLotTooLarge(k.codespace, lot, a.Lot)
+	}
+	price := sdk.NewDecFromInt(a.Bid.Amount).QuoInt(a.Lot.Amount)
+	if a.HasReserve() && a.ReservePrice.GT(price) {
+		price = a.ReservePrice
+	}
+	if !price.IsPositive() {
+		return a, types.ErrInvalidPrice(k.codespace, price) // there is no bid or reserve to price the part at
+	}
+	// cost is rounded up and the refund rounded down, in favour of the auction
+	cost := sdk.MinInt(price.MulInt(lot.Amount).Ceil().RoundInt(), a.MaxBid.Amount)
+	newMaxBid := a.MaxBid.Amount.Sub(cost)
+	newBid := sdk.MinInt(a.Bid.Amount.Sub(a.Bid.Amount.Mul(lot.Amount).Quo(a.Lot.Amount)), newMaxBid)
+	refund := sdk.NewCoin(a.Bid.Denom, a.Bid.Amount.Sub(newBid))
+	proceeds := sdk.NewCoin(a.Bid.Denom, cost).Sub(refund) // refund is always ≤ cost as the price is at least the bid per unit, and the bid is below MaxBid in forward phase
+
+	// New bidder pays back old bidder for the part that was bought
+	// Catch edge cases of a bidder buying from their own bid, and the amount being zero (sending zero coins produces meaningless send events).
+	if !bidder.Equals(a.Bidder) && refund.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(refund))
+		if err != nil {
+			return a, err
+		}
+		err = k.refundBid(ctx, a.ID, a.Bidder, refund)
+		if err != nil {
+			return a, err
+		}
+	}
+	// Rest of the payment sent to auction initiator
+	if proceeds.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, a.Initiator, sdk.NewCoins(proceeds))
+		if err != nil {
+			return a, err
+		}
+	}
+	// Debt coins are sent to liquidator (until there is no CorrespondingDebt left). Amount sent is equal to the proceeds (or whatever is left if < proceeds).
+	if a.CorrespondingDebt.IsPositive() && proceeds.IsPositive() {
+
+		debtAmountToReturn := sdk.MinInt(proceeds.Amount, a.CorrespondingDebt.Amount)
+		debtToReturn := sdk.NewCoin(a.CorrespondingDebt.Denom, debtAmountToReturn)
+
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, sdk.NewCoins(debtToReturn))
+		if err != nil {
+			return a, err
+		}
+		a.CorrespondingDebt = a.CorrespondingDebt.Sub(debtToReturn) // debtToReturn will always be ≤ a.CorrespondingDebt from the MinInt above
+	}
+	// Part of the lot paid out immediately
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(lot))
+	if err != nil {
+		return a, err
+	}
+
+	// Update Auction
+	a.Lot = a.Lot.Sub(lot)
+	a.LotSold = a.LotSold.Add(lot)
+	a.BidRaised = a.BidRaised.Add(sdk.NewCoin(a.Bid.Denom, cost))
+	a.MaxBid = sdk.NewCoin(a.MaxBid.Denom, newMaxBid)
+	a.Bid = sdk.NewCoin(a.Bid.Denom, newBid)
+	if newBid.IsZero() {
+		a.Bidder = nil // the latest bidder has been refunded all of their bid
+	}
+	if a.IsComplete() {
+		a.EndTime = ctx.BlockTime() // close the auction at the start of the next block
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionFill,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", a.ID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBidAmount, cost.String()),
+			sdk.NewAttribute(types.AttributeKeyLotAmount, lot.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", a.EndTime.Unix())),
+		),
+	)
+	k.recordBid(ctx, a, types.EventTypeAuctionFill, bidder, lot, sdk.NewCoin(a.Bid.Denom, cost))
 
 	return a, nil
 }
@@ -310,7 +564,7 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, a types.CollateralAuc
 	maxNewLotAmt := a.Lot.Amount.Sub( // new lot must be some % less than old lot, and at least 1 smaller to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdk.NewInt(1),
-			sdk.NewDecFromInt(a.Lot.Amount).Mul(k.GetParams(ctx).IncrementCollateral).RoundInt(),
+			sdk.NewDecFromInt(a.Lot.Amount).Mul(k.getAuctionParams(ctx, a).Increment).RoundInt(),
 		),
 	)
 	if lot.Amount.GT(maxNewLotAmt) {
@@ -327,7 +581,7 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, a types.CollateralAuc
 		if err != nil {
 			return a, err
 		}
-		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, a.Bidder, sdk.NewCoins(a.Bid))
+		err = k.refundBid(ctx, a.ID, a.Bidder, a.Bid)
 		if err != nil {
 			return a, err
 		}
@@ -349,9 +603,9 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, a types.CollateralAuc
 	a.Bidder = bidder
 	a.Lot = lot
 	if !a.HasReceivedBids {
-		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		a.MaxEndTime = ctx.BlockTime().Add(k.getAuctionParams(ctx, a).MaxAuctionDuration) // set maximum ending time on receipt of first bid
 	}
-	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
+	a.EndTime = earliestTime(ctx.BlockTime().Add(k.getAuctionParams(ctx, a).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
 	a.HasReceivedBids = true
 
 	ctx.EventManager().EmitEvent(
@@ -363,6 +617,7 @@ func (k Keeper) PlaceReverseBidCollateral(ctx sdk.Context, a types.CollateralAuc
 			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", a.EndTime.Unix())),
 		),
 	)
+	k.recordBid(ctx, a, types.EventTypeAuctionBid, a.Bidder, a.Lot, a.Bid)
 
 	return a, nil
 }
@@ -376,7 +631,7 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, a types.DebtAuction, bidder sdk.Ac
 	maxNewLotAmt := a.Lot.Amount.Sub( // new lot must be some % less than old lot, and at least 1 smaller to avoid replacing an old bid at no cost
 		sdk.MaxInt(
 			sdk.NewInt(1),
-			sdk.NewDecFromInt(a.Lot.Amount).Mul(k.GetParams(ctx).IncrementDebt).RoundInt(),
+			sdk.NewDecFromInt(a.Lot.Amount).Mul(k.getAuctionParams(ctx, a).Increment).RoundInt(),
 		),
 	)
 	if lot.Amount.GT(maxNewLotAmt) {
@@ -393,7 +648,7 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, a types.DebtAuction, bidder sdk.Ac
 		if err != nil {
 			return a, err
 		}
-		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, a.Bidder, sdk.NewCoins(a.Bid))
+		err = k.refundBid(ctx, a.ID, a.Bidder, a.Bid)
 		if err != nil {
 			return a, err
 		}
@@ -415,9 +670,9 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, a types.DebtAuction, bidder sdk.Ac
 	a.Bidder = bidder
 	a.Lot = lot
 	if !a.HasReceivedBids {
-		a.MaxEndTime = ctx.BlockTime().Add(k.GetParams(ctx).MaxAuctionDuration) // set maximum ending time on receipt of first bid
+		a.MaxEndTime = ctx.BlockTime().Add(k.getAuctionParams(ctx, a).MaxAuctionDuration) // set maximum ending time on receipt of first bid
 	}
-	a.EndTime = earliestTime(ctx.BlockTime().Add(k.GetParams(ctx).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
+	a.EndTime = earliestTime(ctx.BlockTime().Add(k.getAuctionParams(ctx, a).BidDuration), a.MaxEndTime) // increment timeout, up to MaxEndTime
 	a.HasReceivedBids = true
 
 	ctx.EventManager().EmitEvent(
@@ -429,11 +684,232 @@ func (k Keeper) PlaceBidDebt(ctx sdk.Context, a types.DebtAuction, bidder sdk.Ac
 			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", a.EndTime.Unix())),
 		),
 	)
+	k.recordBid(ctx, a, types.EventTypeAuctionBid, a.Bidder, a.Lot, a.Bid)
+
+	return a, nil
+}
+
+// PlaceBidDutch buys some of the lot of a dutch auction at the current price, moving coins and returning the updated auction.
+// Bids are for an amount of the lot. Bids for more than is left buy the rest of the lot, and bids costing more than is left to raise
+// only buy enough of the lot to raise the rest of MaxBid.
+func (k Keeper) PlaceBidDutch(ctx sdk.Context, a types.DutchAuction, bidder sdk.AccAddress, lot sdk.Coin) (types.DutchAuction, sdk.Error) {
+	// Validate new bid
+	if lot.Denom != a.Lot.Denom {
+		return a, types.ErrInvalidLotDenom(k.codespace, lot.Denom, a.Lot.Denom)
+	}
+	if a.IsComplete() {
+		return a, types.ErrAuctionHasExpired(k.codespace, a.ID)
+	}
+	if !lot.IsPositive() {
+		return a, types.ErrLotTooSmall(k.codespace, lot, sdk.NewCoin(a.Lot.Denom, sdk.ZeroInt()))
+	}
+	price := a.CurrentPrice(ctx.BlockTime())
+	amount := sdk.MinInt(lot.Amount, a.Lot.Amount)
+	cost := sdk.NewDecFromInt(amount).Mul(price).Ceil().TruncateInt() // round in favour of the auction
+	remaining := a.MaxBid.Amount.Sub(a.Bid.Amount)
+	if cost.GT(remaining) {
+		cost = remaining
+		amount = sdk.MinInt(sdk.NewDecFromInt(remaining).Quo(price).Ceil().TruncateInt(), amount)
+	}
+	if !cost.IsPositive() {
+		return a, types.ErrBidTooSmall(k.codespace, sdk.NewCoin(a.Bid.Denom, cost), sdk.NewCoin(a.Bid.Denom, sdk.OneInt()))
+	}
+	payment := sdk.NewCoin(a.Bid.Denom, cost)
+	purchase := sdk.NewCoin(a.Lot.Denom, amount)
+
+	// Payment sent to auction initiator
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, a.Initiator, sdk.NewCoins(payment))
+	if err != nil {
+		return a, err
+	}
+	// Debt coins are sent to liquidator (until there is no CorrespondingDebt left). Amount sent is equal to the payment (or whatever is left if < payment).
+	if a.CorrespondingDebt.IsPositive() {
+
+		debtAmountToReturn := sdk.MinInt(cost, a.CorrespondingDebt.Amount)
+		debtToReturn := sdk.NewCoin(a.CorrespondingDebt.Denom, debtAmountToReturn)
+
+		err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, sdk.NewCoins(debtToReturn))
+		if err != nil {
+			return a, err
+		}
+		a.CorrespondingDebt = a.CorrespondingDebt.Sub(debtToReturn) // debtToReturn will always be ≤ a.CorrespondingDebt from the MinInt above
+	}
+	// Purchased lot sent to bidder
+	err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(purchase))
+	if err != nil {
+		return a, err
+	}
+
+	// Update Auction
+	a.Bidder = bidder
+	a.Bid = a.Bid.Add(payment)
+	a.Lot = a.Lot.Sub(purchase)
+	a.LotSold = a.LotSold.Add(purchase)
+	a.HasReceivedBids = true
+	if a.IsComplete() {
+		a.EndTime = ctx.BlockTime() // close the auction at the start of the next block
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", a.ID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, a.Bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyBidAmount, payment.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyLotAmount, purchase.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", a.EndTime.Unix())),
+		),
+	)
+	k.recordBid(ctx, a, types.EventTypeAuctionBid, bidder, purchase, payment)
 
 	return a, nil
 }
 
+// CommitBid commits to a hidden bid on a sealed-bid auction, taking the deposit from the bidder.
+// A bidder that has already committed replaces their commitment without paying another deposit.
+func (k Keeper) CommitBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, commitment []byte) sdk.Error {
+
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return types.ErrAuctionNotFound(k.codespace, auctionID)
+	}
+	a, ok := auction.(types.SealedBidAuction)
+	if !ok {
+		return types.ErrNotSealedBidAuction(k.codespace, auctionID)
+	}
+	if a.IsRevealPhase(ctx.BlockTime()) {
+		return types.ErrNotCommitPhase(k.codespace, auctionID, a.CommitEndTime)
+	}
+
+	if i, found := a.Commitments.Find(bidder); found {
+		a.Commitments[i].Commitment = commitment
+	} else {
+		if a.Deposit.IsPositive() {
+			err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(a.Deposit))
+			if err != nil {
+				return err
+			}
+		}
+		a.Commitments = append(a.Commitments, types.SealedBid{Bidder: bidder, Commitment: commitment})
+	}
+	a.HasReceivedBids = true
+
+	k.SetAuction(ctx, a)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionCommit,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", a.ID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", a.EndTime.Unix())),
+		),
+	)
+	return nil
+}
+
+// RevealBid reveals a committed bid on a sealed-bid auction, returning the deposit to the bidder.
+// The amount is a bid for surplus auctions and a lot for debt auctions. If the revealed bid is the best so far the bidder pays
+// what they would owe on winning, the amount of the bid (surplus) or the auction's bid (debt), and the previous best bidder is refunded.
+func (k Keeper) RevealBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, amount sdk.Coin, salt string) sdk.Error {
+
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return types.ErrAuctionNotFound(k.codespace, auctionID)
+	}
+	a, ok := auction.(types.SealedBidAuction)
+	if !ok {
+		return types.ErrNotSealedBidAuction(k.codespace, auctionID)
+	}
+	if !a.IsRevealPhase(ctx.BlockTime()) {
+		return types.ErrNotRevealPhase(k.codespace, auctionID, a.CommitEndTime)
+	}
+	if ctx.BlockTime().After(a.EndTime) {
+		return types.ErrAuctionHasExpired(k.codespace, auctionID)
+	}
+
+	i, found := a.Commitments.Find(bidder)
+	if !found || a.Commitments[i].Revealed {
+		return types.ErrCommitmentNotFound(k.codespace, auctionID, bidder)
+	}
+	if !a.Commitments[i].MatchesCommitment(auctionID, amount, salt) {
+		return types.ErrInvalidReveal(k.codespace, auctionID)
+	}
+
+	// Validate bid
+	if a.Kind == types.SealedBidKindDebt {
+		if amount.Denom != a.Lot.Denom {
+			return types.ErrInvalidLotDenom(k.codespace, amount.Denom, a.Lot.Denom)
+		}
+		if amount.Amount.GT(a.Lot.Amount) {
+			return types.ErrLotTooLarge(k.codespace, amount, a.Lot)
+		}
+		if !amount.IsPositive() {
+			return types.ErrLotTooSmall(k.codespace, amount, sdk.NewCoin(a.Lot.Denom, sdk.ZeroInt()))
+		}
+	} else {
+		if amount.Denom != a.Bid.Denom {
+			return types.ErrInvalidBidDenom(k.codespace, amount.Denom, a.Bid.Denom)
+		}
+		if !amount.IsPositive() {
+			return types.ErrBidTooSmall(k.codespace, amount, sdk.NewCoin(a.Bid.Denom, sdk.ZeroInt()))
+		}
+	}
+
+	// Return deposit
+	a.Commitments[i].Revealed = true
+	if a.Deposit.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(a.Deposit))
+		if err != nil {
+			return err
+		}
+	}
+
+	if a.IsBetterBid(amount, a.BestBid) {
+		// New best bidder pays in, and the previous best bidder is refunded
+		escrow, previousEscrow := amount, a.BestBid
+		if a.Kind == types.SealedBidKindDebt {
+			escrow, previousEscrow = a.Bid, a.Bid
+		}
+		err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(escrow))
+		if err != nil {
+			return err
+		}
+		if !a.Bidder.Empty() {
+			err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, a.Bidder, sdk.NewCoins(previousEscrow))
+			if err != nil {
+				return err
+			}
+			a.SecondBid = a.BestBid
+		}
+		a.Bidder = bidder
+		a.BestBid = amount
+	} else if a.IsBetterBid(amount, a.SecondBid) {
+		a.SecondBid = amount
+	}
+
+	k.SetAuction(ctx, a)
+
+	amountKey := types.AttributeKeyBidAmount
+	lot, bid := a.Lot, amount
+	if a.Kind == types.SealedBidKindDebt {
+		amountKey = types.AttributeKeyLotAmount
+		lot, bid = amount, a.Bid
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionReveal,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", a.ID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(amountKey, amount.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", a.EndTime.Unix())),
+		),
+	)
+	k.recordBid(ctx, a, types.EventTypeAuctionReveal, bidder, lot, bid)
+	return nil
+}
+
 // CloseAuction closes an auction and distributes funds to the highest bidder.
+// Auctions that end without a bid at their reserve price are restarted instead, up to ReserveRelists times.
 func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) sdk.Error {
 
 	auction, found := k.GetAuction(ctx, auctionID)
@@ -445,6 +921,10 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) sdk.Error {
 		return types.ErrAuctionHasNotExpired(k.codespace, ctx.BlockTime(), auction.GetEndTime())
 	}
 
+	if k.relistAuction(ctx, auction) {
+		return nil
+	}
+
 	// payout to the last bidder
 	switch auc := auction.(type) {
 	case types.SurplusAuction:
@@ -459,11 +939,82 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) sdk.Error {
 		if err := k.PayoutCollateralAuction(ctx, auc); err != nil {
 			return err
 		}
+	case types.DutchAuction:
+		if err := k.PayoutDutchAuction(ctx, auc); err != nil {
+			return err
+		}
+	case types.SealedBidAuction:
+		if err := k.PayoutSealedBidAuction(ctx, auc); err != nil {
+			return err
+		}
 	default:
 		return types.ErrUnrecognizedAuctionType(k.codespace)
 	}
 
-	k.DeleteAuction(ctx, auctionID)
+	return k.finishAuction(ctx, auction)
+}
+
+// SettleAuction closes an auction immediately, without waiting for it to end or restarting it below its reserve.
+// Auctions with a bid pay out to the latest bidder, who has already paid for the lot. The lot and debt of auctions
+// without a bid are returned to the initiator rather than to the lot return addresses, as are the unsold lot and debt of dutch auctions.
+// Sealed-bid auctions refund the deposits of unrevealed commitments instead of forfeiting them.
+func (k Keeper) SettleAuction(ctx sdk.Context, auctionID uint64) sdk.Error {
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return types.ErrAuctionNotFound(k.codespace, auctionID)
+	}
+
+	var err sdk.Error
+	switch auc := auction.(type) {
+	case types.SurplusAuction:
+		err = k.PayoutSurplusAuction(ctx, auc)
+	case types.DebtAuction:
+		if auc.Bidder.Empty() || auc.Bidder.Equals(supply.NewModuleAddress(auc.Initiator)) {
+			err = k.returnToInitiator(ctx, auc.Initiator, auc.CorrespondingDebt)
+		} else {
+			err = k.PayoutDebtAuction(ctx, auc)
+		}
+	case types.CollateralAuction:
+		if auc.Bidder.Empty() {
+			err = k.returnToInitiator(ctx, auc.Initiator, auc.Lot, auc.CorrespondingDebt)
+		} else {
+			err = k.PayoutCollateralAuction(ctx, auc)
+		}
+	case types.DutchAuction:
+		err = k.returnToInitiator(ctx, auc.Initiator, auc.Lot, auc.CorrespondingDebt)
+	case types.SealedBidAuction:
+		for i, sb := range auc.Commitments {
+			if sb.Revealed {
+				continue
+			}
+			if auc.Deposit.IsPositive() {
+				if err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sb.Bidder, sdk.NewCoins(auc.Deposit)); err != nil {
+					return err
+				}
+			}
+			auc.Commitments[i].Revealed = true
+		}
+		err = k.PayoutSealedBidAuction(ctx, auc)
+	default:
+		err = types.ErrUnrecognizedAuctionType(k.codespace)
+	}
+	if err != nil {
+		return err
+	}
+
+	return k.finishAuction(ctx, auction)
+}
+
+// finishAuction returns the proxy bid escrows of an auction that has paid out, and archives it.
+func (k Keeper) finishAuction(ctx sdk.Context, auction types.Auction) sdk.Error {
+	if err := k.returnProxyBids(ctx, auction.GetID()); err != nil {
+		return err
+	}
+
+	closed := k.closedAuction(ctx, auction)
+	k.DeleteAuction(ctx, auction.GetID())
+	k.DeleteStuckAuction(ctx, auction.GetID())
+	k.archiveAuction(ctx, closed)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -471,9 +1022,67 @@ func (k Keeper) CloseAuction(ctx sdk.Context, auctionID uint64) sdk.Error {
 			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auction.GetID())),
 		),
 	)
+	k.afterAuctionClosed(ctx, closed)
 	return nil
 }
 
+// returnToInitiator sends the positive amounts of the input coins from the auction module account to the initiator of an auction.
+func (k Keeper) returnToInitiator(ctx sdk.Context, initiator string, coins ...sdk.Coin) sdk.Error {
+	returned := sdk.NewCoins()
+	for _, coin := range coins {
+		if coin.IsValid() && coin.IsPositive() {
+			returned = returned.Add(sdk.NewCoins(coin))
+		}
+	}
+	if returned.Empty() {
+		return nil
+	}
+	return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, initiator, returned)
+}
+
+// relistAuction restarts an auction with a reserve price that ended without a bid, returning whether it was restarted.
+// The reserve price is updated to the current market price from the reserve pricer of the initiator, if it has one.
+// Auctions that have been restarted ReserveRelists times are not restarted again, and pay out their lot as returned.
+func (k Keeper) relistAuction(ctx sdk.Context, auction types.Auction) bool {
+	if !auction.GetBidder().Empty() {
+		return false
+	}
+	params := k.GetParams(ctx)
+	endTime := ctx.BlockTime().Add(params.ForAuction(auction.GetType(), auction.GetLot().Denom).MaxAuctionDuration)
+
+	var relisted types.Auction
+	switch a := auction.(type) {
+	case types.SurplusAuction:
+		if !a.HasReserve() || a.Relists >= params.ReserveRelists {
+			return false
+		}
+		a.EndTime, a.MaxEndTime = endTime, endTime
+		a.ReservePrice = k.relistReservePrice(ctx, a, a.ReservePrice)
+		a.Relists++
+		relisted = a
+	case types.CollateralAuction:
+		if !a.HasReserve() || a.IsComplete() || a.Relists >= params.ReserveRelists {
+			return false
+		}
+		a.EndTime, a.MaxEndTime = endTime, endTime
+		a.ReservePrice = k.relistReservePrice(ctx, a, a.ReservePrice)
+		a.Relists++
+		relisted = a
+	default:
+		return false
+	}
+	k.SetAuction(ctx, relisted)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionRelist,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", relisted.GetID())),
+			sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", endTime.Unix())),
+		),
+	)
+	return true
+}
+
 // PayoutDebtAuction pays out the proceeds for a debt auction, first minting the coins.
 func (k Keeper) PayoutDebtAuction(ctx sdk.Context, a types.DebtAuction) sdk.Error {
 	err := k.supplyKeeper.MintCoins(ctx, a.Initiator, sdk.NewCoins(a.Lot))
@@ -494,7 +1103,11 @@ func (k Keeper) PayoutDebtAuction(ctx sdk.Context, a types.DebtAuction) sdk.Erro
 }
 
 // PayoutSurplusAuction pays out the proceeds for a surplus auction.
+// The lot of an auction that ended without a bid at its reserve price is returned to the initiator.
 func (k Keeper) PayoutSurplusAuction(ctx sdk.Context, a types.SurplusAuction) sdk.Error {
+	if a.Bidder.Empty() {
+		return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, sdk.NewCoins(a.Lot))
+	}
 	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, a.Bidder, sdk.NewCoins(a.Lot))
 	if err != nil {
 		return err
@@ -502,14 +1115,108 @@ func (k Keeper) PayoutSurplusAuction(ctx sdk.Context, a types.SurplusAuction) sd
 	return nil
 }
 
-// PayoutCollateralAuction pays out the proceeds for a collateral auction.
-func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, a types.CollateralAuction) sdk.Error {
-	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, a.Bidder, sdk.NewCoins(a.Lot))
+// PayoutSealedBidAuction pays out the proceeds for a sealed-bid auction, and forfeits the deposits of unrevealed commitments to the initiator.
+// The winner of a surplus auction is refunded any of their bid above the winning price, and the rest is burned.
+// The winner of a debt auction is minted the winning price, and their bid is sent to the initiator.
+// If no bids were revealed the lot (surplus) or debt (debt) is returned to the initiator.
+func (k Keeper) PayoutSealedBidAuction(ctx sdk.Context, a types.SealedBidAuction) sdk.Error {
+	forfeited := sdk.NewCoins()
+	for _, sb := range a.Commitments {
+		if !sb.Revealed {
+			forfeited = forfeited.Add(sdk.NewCoins(a.Deposit))
+		}
+	}
+	if !forfeited.Empty() {
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, forfeited)
+		if err != nil {
+			return err
+		}
+	}
+	if a.CorrespondingDebt.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, sdk.NewCoins(a.CorrespondingDebt))
+		if err != nil {
+			return err
+		}
+	}
+
+	if a.Bidder.Empty() {
+		if a.Kind == types.SealedBidKindSurplus {
+			return k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, sdk.NewCoins(a.Lot))
+		}
+		return nil
+	}
+
+	price := a.WinningPrice()
+	if a.Kind == types.SealedBidKindDebt {
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, sdk.NewCoins(a.Bid))
+		if err != nil {
+			return err
+		}
+		err = k.supplyKeeper.MintCoins(ctx, a.Initiator, sdk.NewCoins(price))
+		if err != nil {
+			return err
+		}
+		return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, a.Initiator, a.Bidder, sdk.NewCoins(price))
+	}
+
+	if refund := a.BestBid.Sub(price); refund.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, a.Bidder, sdk.NewCoins(refund))
+		if err != nil {
+			return err
+		}
+	}
+	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, sdk.NewCoins(price))
 	if err != nil {
 		return err
 	}
+	err = k.supplyKeeper.BurnCoins(ctx, a.Initiator, sdk.NewCoins(price))
+	if err != nil {
+		return err
+	}
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, a.Bidder, sdk.NewCoins(a.Lot))
+}
+
+// PayoutCollateralAuction pays out the proceeds for a collateral auction.
+// If there is no latest bidder, the unsold lot of an auction with a reserve price that has not raised its MaxBid, which has been relisted
+// ReserveRelists times without selling at its reserve, is returned to the initiator. The unsold lot of other auctions is returned to the
+// lot return addresses.
+func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, a types.CollateralAuction) sdk.Error {
+	if a.Lot.IsPositive() {
+		switch {
+		case !a.Bidder.Empty():
+			err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, a.Bidder, sdk.NewCoins(a.Lot))
+			if err != nil {
+				return err
+			}
+		case a.HasReserve() && !a.IsComplete():
+			if err := k.returnToInitiator(ctx, a.Initiator, a.Lot); err != nil {
+				return err
+			}
+		default:
+			if err := k.returnLot(ctx, a.Lot, a.LotReturns); err != nil {
+				return err
+			}
+		}
+	}
 	if a.CorrespondingDebt.IsPositive() {
-		err = k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, sdk.NewCoins(a.CorrespondingDebt))
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, sdk.NewCoins(a.CorrespondingDebt))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PayoutDutchAuction returns the unsold lot of a dutch auction to the lot return addresses, and any remaining debt to the initiator.
+// Bidders are paid out as they bid.
+func (k Keeper) PayoutDutchAuction(ctx sdk.Context, a types.DutchAuction) sdk.Error {
+	if a.Lot.IsPositive() {
+		if err := k.returnLot(ctx, a.Lot, a.LotReturns); err != nil {
+			return err
+		}
+	}
+	if a.CorrespondingDebt.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, a.Initiator, sdk.NewCoins(a.CorrespondingDebt))
 		if err != nil {
 			return err
 		}
@@ -518,7 +1225,8 @@ func (k Keeper) PayoutCollateralAuction(ctx sdk.Context, a types.CollateralAucti
 }
 
 // CloseExpiredAuctions finds all auctions that are past (or at) their ending times and closes them, paying out to the highest bidder.
-func (k Keeper) CloseExpiredAuctions(ctx sdk.Context) sdk.Error {
+// Auctions that fail to close are recorded as stuck and left in place to be retried in later blocks, so that one failed payout does not halt the chain.
+func (k Keeper) CloseExpiredAuctions(ctx sdk.Context) {
 	var expiredAuctions []uint64
 	k.IterateAuctionsByTime(ctx, ctx.BlockTime(), func(id uint64) bool {
 		expiredAuctions = append(expiredAuctions, id)
@@ -526,7 +1234,168 @@ func (k Keeper) CloseExpiredAuctions(ctx sdk.Context) sdk.Error {
 	})
 	// Note: iteration and auction closing are in separate loops as db should not be modified during iteration // TODO is this correct? gov modifies during iteration
 	for _, id := range expiredAuctions {
-		if err := k.CloseAuction(ctx, id); err != nil {
+		k.tryCloseAuction(ctx, id)
+	}
+}
+
+// tryCloseAuction closes an auction, discarding any partial state changes and recording the auction as stuck if closing fails.
+func (k Keeper) tryCloseAuction(ctx sdk.Context, auctionID uint64) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	err := k.CloseAuction(cacheCtx, auctionID)
+	if err != nil {
+		k.recordCloseFailure(ctx, auctionID, err)
+		return
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+}
+
+// recordCloseFailure updates the stuck record of an auction after a failed close.
+func (k Keeper) recordCloseFailure(ctx sdk.Context, auctionID uint64, err sdk.Error) {
+	stuck, found := k.GetStuckAuction(ctx, auctionID)
+	if !found {
+		stuck = types.NewStuckAuction(auctionID, "", ctx.BlockTime(), 0)
+	}
+	stuck.Error = err.Result().Log
+	stuck.Attempts++
+	k.SetStuckAuction(ctx, stuck)
+
+	k.Logger(ctx).Error(fmt.Sprintf("failed to close auction %d: %s", auctionID, stuck.Error))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionCloseFailed,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyError, stuck.Error),
+		),
+	)
+}
+
+// SettleStuckAuction retries closing an auction that has failed to close, returning the error if it fails again.
+func (k Keeper) SettleStuckAuction(ctx sdk.Context, auctionID uint64) sdk.Error {
+	if _, found := k.GetStuckAuction(ctx, auctionID); !found {
+		return types.ErrAuctionNotStuck(k.codespace, auctionID)
+	}
+	return k.CloseAuction(ctx, auctionID)
+}
+
+// CancelStuckAuction removes an auction that has failed to close without paying out its winner. The latest bidder is refunded their bid,
+// and the lot and debt held for the auction are returned to the initiator, along with proxy bid escrows to their bidders. If any refund
+// or return fails the auction is not cancelled and stays stuck, so no coins are left in the auction module account without an auction.
+// Cancelled auctions are not archived, and their bid history is deleted. The AfterAuctionClosed hook of the initiator is called without
+// a winner, so it can account for the lot and debt returned.
+func (k Keeper) CancelStuckAuction(ctx sdk.Context, auctionID uint64) sdk.Error {
+	if _, found := k.GetStuckAuction(ctx, auctionID); !found {
+		return types.ErrAuctionNotStuck(k.codespace, auctionID)
+	}
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return types.ErrAuctionNotFound(k.codespace, auctionID)
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.returnProxyBids(cacheCtx, auctionID); err != nil {
+		return err
+	}
+	if err := k.refundLatestBid(cacheCtx, auction); err != nil {
+		return err
+	}
+	if err := k.returnHeldCoins(cacheCtx, auction); err != nil {
+		return err
+	}
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	k.DeleteAuction(ctx, auctionID)
+	k.DeleteStuckAuction(ctx, auctionID)
+	k.deleteBidHistory(ctx, auctionID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionCancel,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+		),
+	)
+	k.afterAuctionClosed(ctx, k.cancelledAuction(ctx, auction))
+	return nil
+}
+
+// refundLatestBid refunds the latest bid of an auction that is cancelled. Bids escrowed by sealed-bid auctions are refunded from the
+// auction module account, along with the deposits of unrevealed commitments. Bids on other auctions have been paid through to the initiator,
+// and are refunded by the initiator, with the burned bids of surplus auctions minted again. Dutch auctions have no outstanding bid.
+func (k Keeper) refundLatestBid(ctx sdk.Context, auction types.Auction) sdk.Error {
+	switch a := auction.(type) {
+	case types.SurplusAuction:
+		if a.Bidder.Empty() || !a.Bid.IsPositive() {
+			return nil
+		}
+		if err := k.supplyKeeper.MintCoins(ctx, a.Initiator, sdk.NewCoins(a.Bid)); err != nil {
+			return err
+		}
+		return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, a.Initiator, a.Bidder, sdk.NewCoins(a.Bid))
+	case types.DebtAuction:
+		if a.Bidder.Empty() || a.Bidder.Equals(supply.NewModuleAddress(a.Initiator)) {
+			return nil
+		}
+		return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, a.Initiator, a.Bidder, sdk.NewCoins(a.Bid))
+	case types.CollateralAuction:
+		if a.Bidder.Empty() || !a.Bid.IsPositive() {
+			return nil
+		}
+		return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, a.Initiator, a.Bidder, sdk.NewCoins(a.Bid))
+	case types.SealedBidAuction:
+		for _, sb := range a.Commitments {
+			if sb.Revealed || !a.Deposit.IsPositive() {
+				continue
+			}
+			if err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sb.Bidder, sdk.NewCoins(a.Deposit)); err != nil {
+				return err
+			}
+		}
+		if a.Bidder.Empty() {
+			return nil
+		}
+		escrow := a.BestBid
+		if a.Kind == types.SealedBidKindDebt {
+			escrow = a.Bid
+		}
+		return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, a.Bidder, sdk.NewCoins(escrow))
+	}
+	return nil
+}
+
+// returnHeldCoins returns the lot and debt held in the auction module account for an auction that is cancelled to its initiator.
+// The lots of debt auctions are minted when they pay out, so only their debt is held.
+func (k Keeper) returnHeldCoins(ctx sdk.Context, auction types.Auction) sdk.Error {
+	switch a := auction.(type) {
+	case types.SurplusAuction:
+		return k.returnToInitiator(ctx, a.Initiator, a.Lot)
+	case types.DebtAuction:
+		return k.returnToInitiator(ctx, a.Initiator, a.CorrespondingDebt)
+	case types.CollateralAuction:
+		return k.returnToInitiator(ctx, a.Initiator, a.Lot, a.CorrespondingDebt)
+	case types.DutchAuction:
+		return k.returnToInitiator(ctx, a.Initiator, a.Lot, a.CorrespondingDebt)
+	case types.SealedBidAuction:
+		if a.Kind == types.SealedBidKindSurplus {
+			return k.returnToInitiator(ctx, a.Initiator, a.Lot, a.CorrespondingDebt)
+		}
+		return k.returnToInitiator(ctx, a.Initiator, a.CorrespondingDebt)
+	}
+	return nil
+}
+
+// returnLot sends unsold lot to the lot return addresses, divided among them by weight.
+func (k Keeper) returnLot(ctx sdk.Context, lot sdk.Coin, lotReturns types.WeightedAddresses) sdk.Error {
+	lotPayouts, err := splitCoinIntoWeightedBuckets(lot, lotReturns.Weights)
+	if err != nil {
+		return err
+	}
+	for i, payout := range lotPayouts {
+		if !payout.IsPositive() {
+			continue
+		}
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, lotReturns.Addresses[i], sdk.NewCoins(payout))
+		if err != nil {
 			return err
 		}
 	}
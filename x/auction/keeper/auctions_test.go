@@ -37,7 +37,7 @@ func TestSurplusAuctionBasic(t *testing.T) {
 	keeper := tApp.GetAuctionKeeper()
 
 	// Create an auction (lot: 20 token1, initialBid: 0 token2)
-	auctionID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2") // lot, bid denom
+	auctionID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2", sdk.ZeroDec()) // lot, bid denom
 	require.NoError(t, err)
 	// Check seller's coins have decreased
 	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100)))
@@ -167,7 +167,7 @@ func TestCollateralAuctionBasic(t *testing.T) {
 	keeper := tApp.GetAuctionKeeper()
 
 	// Start auction
-	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40), sdk.ZeroDec())
 	require.NoError(t, err)
 	// Check seller's coins have decreased
 	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 60)))
@@ -227,7 +227,7 @@ func TestCollateralAuctionDebtRemaining(t *testing.T) {
 	keeper := tApp.GetAuctionKeeper()
 
 	// Start auction
-	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40))
+	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40), sdk.ZeroDec())
 	require.NoError(t, err)
 	// Check seller's coins have decreased
 	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 60)))
@@ -255,6 +255,510 @@ func TestCollateralAuctionDebtRemaining(t *testing.T) {
 	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 100)))
 }
 
+func TestCollateralAuctionPartialFill(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	buyer := addrs[0]
+	partialBuyer := addrs[1]
+	returnAddrs := addrs[2:]
+	returnWeights := is(10)
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(partialBuyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Start auction
+	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, returnWeights, c("debt", 40), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	// Partial bids are rejected until there is a bid to price them at
+	err = keeper.PlacePartialBid(ctx, auctionID, partialBuyer, c("token1", 4))
+	require.Equal(t, types.CodeInvalidPrice, err.Result().Code)
+
+	// Place a forward bid
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 10)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 70)))
+
+	// Buy a fifth of the lot at the current bid per unit, which refunds a fifth of the latest bid
+	require.NoError(t, keeper.PlacePartialBid(ctx, auctionID, partialBuyer, c("token1", 4)))
+	tApp.CheckBalance(t, ctx, partialBuyer, cs(c("token1", 104), c("token2", 98)))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 100), c("token2", 92)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 110), c("debt", 70)))
+	auction, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, c("token1", 16), auction.GetLot())
+	require.Equal(t, c("token2", 8), auction.GetBid())
+	require.Equal(t, c("token2", 48), auction.(types.CollateralAuction).MaxBid)
+
+	// Partial bids can not be for more than the lot
+	require.Error(t, keeper.PlacePartialBid(ctx, auctionID, partialBuyer, c("token1", 17)))
+
+	// Bid up to the remaining max bid to switch phases, after which partial bids are not accepted
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 48)))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 100), c("token2", 52)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 150), c("debt", 100)))
+	require.Error(t, keeper.PlacePartialBid(ctx, auctionID, partialBuyer, c("token1", 4)))
+
+	// Close auction
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 116), c("token2", 52)))
+	tApp.CheckBalance(t, ctx, returnAddrs[0], cs(c("token1", 100), c("token2", 100)))
+}
+
+func TestCollateralAuctionPartialFillMaxBid(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	buyer := addrs[0]
+	partialBuyer := addrs[1]
+	returnAddrs := addrs[2:]
+	returnWeights := is(10)
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(partialBuyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Partial bids are not supported on surplus auctions
+	surplusID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 10), "token2", sdk.ZeroDec())
+	require.NoError(t, err)
+	err = keeper.PlacePartialBid(ctx, surplusID, partialBuyer, c("token1", 5))
+	require.Equal(t, types.CodePartialBidNotSupported, err.Result().Code)
+
+	// Start auction with a reserve price of 0.3 token2 per token1
+	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 5), returnAddrs, returnWeights, c("debt", 4), sdk.MustNewDecFromStr("0.3"))
+	require.NoError(t, err)
+
+	// Buying most of the lot at the reserve price raises all of the max bid
+	require.NoError(t, keeper.PlacePartialBid(ctx, auctionID, partialBuyer, c("token1", 19)))
+	tApp.CheckBalance(t, ctx, partialBuyer, cs(c("token1", 119), c("token2", 95)))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 100), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 70), c("token2", 105), c("debt", 100)))
+
+	// The auction accepts no more bids
+	require.Error(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 3)))
+	require.Error(t, keeper.PlacePartialBid(ctx, auctionID, partialBuyer, c("token1", 1)))
+
+	// Close auction, returning the unsold lot
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	keeper.CloseExpiredAuctions(ctx)
+	_, found := keeper.GetAuction(ctx, auctionID)
+	require.False(t, found)
+	tApp.CheckBalance(t, ctx, returnAddrs[0], cs(c("token1", 101), c("token2", 100)))
+}
+
+func TestDutchAuctionBasic(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	returnWeights := is(20, 10)
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[1], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Start auction at a market price of 2 token2 per token1, the price starts at 2.4 with the default buffer
+	auctionID, err := keeper.StartDutchAuction(ctx, sellerModName, c("token1", 20), c("token2", 40), sdk.NewDec(2), returnAddrs, returnWeights, c("debt", 40))
+	require.NoError(t, err)
+	// Check seller's coins have decreased
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 60)))
+
+	// Buy part of the lot at the start price
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 5)))
+	// Check bidder has paid 5 * 2.4 and received the lot
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 105), c("token2", 88)))
+	// Check seller's coins have increased
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 112), c("debt", 72)))
+
+	// Bids must be for the lot denom
+	require.Error(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 5)))
+
+	// Buy the rest of the lot after the price has decayed to 2.376, which costs more than is left to raise
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultDutchDecayInterval))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 20)))
+	// Check bidder has only bought enough to raise the remaining 28 token2
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 117), c("token2", 60)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 140), c("debt", 100)))
+
+	// The auction accepts no more bids once the max bid has been raised
+	require.Error(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 1)))
+
+	// Close auction, returning the unsold lot
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	keeper.CloseExpiredAuctions(ctx)
+	_, found := keeper.GetAuction(ctx, auctionID)
+	require.False(t, found)
+	tApp.CheckBalance(t, ctx, returnAddrs[0], cs(c("token1", 102), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, returnAddrs[1], cs(c("token1", 101), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 117), c("token2", 60)))
+}
+
+func TestDutchAuctionUnsold(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	returnAddrs := addrs
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Zero prices are rejected
+	_, err := keeper.StartDutchAuction(ctx, sellerModName, c("token1", 20), c("token2", 40), sdk.ZeroDec(), returnAddrs, is(1), c("debt", 40))
+	require.Error(t, err)
+
+	auctionID, err := keeper.StartDutchAuction(ctx, sellerModName, c("token1", 20), c("token2", 40), sdk.NewDec(2), returnAddrs, is(1), c("debt", 40))
+	require.NoError(t, err)
+
+	// Auction does not close before the max auction duration
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxAuctionDuration).Add(-time.Second))
+	keeper.CloseExpiredAuctions(ctx)
+	_, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+
+	// Close auction with no bids, returning the lot and debt
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	keeper.CloseExpiredAuctions(ctx)
+	_, found = keeper.GetAuction(ctx, auctionID)
+	require.False(t, found)
+	tApp.CheckBalance(t, ctx, returnAddrs[0], cs(c("token1", 120), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100), c("debt", 100)))
+}
+
+func TestDutchAuctionMinPrice(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+	params := keeper.GetParams(ctx)
+	params.DutchMinPrice = sdk.MustNewDecFromStr("0.5")
+	keeper.SetParams(ctx, params)
+
+	// Start auction at a market price of 2 token2 per token1, the price starts at 2.4 and stops decaying at 1.2
+	auctionID, err := keeper.StartDutchAuction(ctx, sellerModName, c("token1", 20), c("token2", 40), sdk.NewDec(2), returnAddrs, is(1), c("debt", 40))
+	require.NoError(t, err)
+	a, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, sdk.MustNewDecFromStr("1.2"), a.(types.DutchAuction).MinPrice)
+
+	// Buy part of the lot long after the decayed price would have fallen below the min price
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxAuctionDuration).Add(-time.Second))
+	require.Equal(t, sdk.MustNewDecFromStr("1.2"), a.(types.DutchAuction).CurrentPrice(ctx.BlockTime()))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token1", 5)))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 105), c("token2", 94)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 106), c("debt", 66)))
+}
+
+func TestSurplusAuctionReserve(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	buyer := addrs[0]
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	// Start auctions reserved at 0.5 token2 per token1
+	auctionID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2", sdk.MustNewDecFromStr("0.5"))
+	require.NoError(t, err)
+	soldID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2", sdk.MustNewDecFromStr("0.5"))
+	require.NoError(t, err)
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 60), c("token2", 100)))
+
+	// Bids below the reserve are rejected
+	err = keeper.PlaceBid(ctx, soldID, buyer, c("token2", 9))
+	require.Equal(t, types.CodeBidBelowReserve, err.Result().Code)
+	require.NoError(t, keeper.PlaceBid(ctx, soldID, buyer, c("token2", 10)))
+
+	// Auction with no bids at the reserve is restarted
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxAuctionDuration))
+	keeper.CloseExpiredAuctions(ctx)
+	a, found := keeper.GetAuction(ctx, auctionID)
+	require.True(t, found)
+	require.Equal(t, uint64(1), a.(types.SurplusAuction).Relists)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultMaxAuctionDuration), a.GetEndTime())
+	_, found = keeper.GetAuction(ctx, soldID)
+	require.False(t, found)
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 120), c("token2", 90)))
+
+	// Auction is returned after running out of relists
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxAuctionDuration))
+	keeper.CloseExpiredAuctions(ctx)
+	_, found = keeper.GetAuction(ctx, auctionID)
+	require.False(t, found)
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 100)))
+}
+
+func TestCollateralAuctionReserve(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(returnAddrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+	params := keeper.GetParams(ctx)
+	params.ReserveRelists = 0
+	keeper.SetParams(ctx, params)
+
+	// Start auctions reserved at 2 token2 per token1, above the max bid
+	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 30), returnAddrs, is(1), c("debt", 30), sdk.NewDec(2))
+	require.NoError(t, err)
+	soldID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 30), returnAddrs, is(1), c("debt", 30), sdk.NewDec(2))
+	require.NoError(t, err)
+
+	// Bids of the max bid meet the reserve
+	err = keeper.PlaceBid(ctx, soldID, buyer, c("token2", 29))
+	require.Equal(t, types.CodeBidBelowReserve, err.Result().Code)
+	require.NoError(t, keeper.PlaceBid(ctx, soldID, buyer, c("token2", 30)))
+
+	// Auction with no bids at the reserve returns the lot and debt to the seller, not the lot return addresses
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxAuctionDuration))
+	keeper.CloseExpiredAuctions(ctx)
+	_, found := keeper.GetAuction(ctx, auctionID)
+	require.False(t, found)
+	tApp.CheckBalance(t, ctx, returnAddrs[0], cs(c("token1", 100), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 80), c("token2", 130), c("debt", 100)))
+}
+
+func TestSealedBidSurplusAuction(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	sellerModName := cdp.LiquidatorMacc
+	sellerAddr := supply.NewModuleAddress(sellerModName)
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 1000))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(addrs[0], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(addrs[1], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(addrs[2], cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+	params := keeper.GetParams(ctx)
+	params.SealedBidSurplus = true
+	params.SealedBidPricing = types.SealedBidSecondPrice
+	keeper.SetParams(ctx, params)
+
+	// Start auction, with a deposit of 1% of the lot
+	auctionID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 1000), "token2", sdk.ZeroDec())
+	require.NoError(t, err)
+	tApp.CheckBalance(t, ctx, sellerAddr, nil)
+	require.Equal(t, types.CodeSealedBidsOnly, keeper.PlaceBid(ctx, auctionID, addrs[0], c("token2", 10)).Result().Code)
+
+	// Commit bids, paying deposits
+	require.NoError(t, keeper.CommitBid(ctx, auctionID, addrs[0], types.SealedBidCommitment(auctionID, addrs[0], c("token2", 30), "salt0")))
+	require.NoError(t, keeper.CommitBid(ctx, auctionID, addrs[1], types.SealedBidCommitment(auctionID, addrs[1], c("token2", 50), "salt1")))
+	require.NoError(t, keeper.CommitBid(ctx, auctionID, addrs[2], types.SealedBidCommitment(auctionID, addrs[2], c("token2", 70), "salt2")))
+	tApp.CheckBalance(t, ctx, addrs[0], cs(c("token1", 90), c("token2", 100)))
+	err = keeper.RevealBid(ctx, auctionID, addrs[0], c("token2", 30), "salt0")
+	require.Equal(t, types.CodeNotRevealPhase, err.Result().Code)
+
+	// Reveal bids, returning deposits and refunding the outbid bidder
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultCommitDuration))
+	err = keeper.CommitBid(ctx, auctionID, addrs[0], types.SealedBidCommitment(auctionID, addrs[0], c("token2", 40), "salt0"))
+	require.Equal(t, types.CodeNotCommitPhase, err.Result().Code)
+	err = keeper.RevealBid(ctx, auctionID, addrs[0], c("token2", 30), "wrong")
+	require.Equal(t, types.CodeInvalidReveal, err.Result().Code)
+	require.NoError(t, keeper.RevealBid(ctx, auctionID, addrs[0], c("token2", 30), "salt0"))
+	tApp.CheckBalance(t, ctx, addrs[0], cs(c("token1", 100), c("token2", 70)))
+	require.NoError(t, keeper.RevealBid(ctx, auctionID, addrs[1], c("token2", 50), "salt1"))
+	tApp.CheckBalance(t, ctx, addrs[0], cs(c("token1", 100), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, addrs[1], cs(c("token1", 100), c("token2", 50)))
+
+	// Close auction, forfeiting the unrevealed deposit and charging the winner the second price
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultRevealDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	tApp.CheckBalance(t, ctx, addrs[1], cs(c("token1", 1100), c("token2", 70)))
+	tApp.CheckBalance(t, ctx, addrs[2], cs(c("token1", 90), c("token2", 100)))
+	tApp.CheckBalance(t, ctx, sellerAddr, cs(c("token1", 10)))
+}
+
+func TestSealedBidDebtAuction(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	buyerModName := cdp.LiquidatorMacc
+	buyerAddr := supply.NewModuleAddress(buyerModName)
+
+	tApp := app.NewTestApp()
+	buyerAcc := supply.NewEmptyModuleAccount(buyerModName, supply.Minter)
+	require.NoError(t, buyerAcc.SetCoins(cs(c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(addrs[0], cs(c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(addrs[1], cs(c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(addrs[2], cs(c("token2", 100)), nil, 0, 0),
+			buyerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+	params := keeper.GetParams(ctx)
+	params.SealedBidDebt = true
+	params.SealedBidPricing = types.SealedBidFirstPrice
+	keeper.SetParams(ctx, params)
+
+	// Start auction, with a deposit of 1% of the bid
+	auctionID, err := keeper.StartDebtAuction(ctx, buyerModName, c("token2", 100), c("token1", 1000), c("debt", 100))
+	require.NoError(t, err)
+	tApp.CheckBalance(t, ctx, buyerAddr, nil)
+
+	// Commit lots, paying deposits
+	require.NoError(t, keeper.CommitBid(ctx, auctionID, addrs[0], types.SealedBidCommitment(auctionID, addrs[0], c("token1", 80), "salt0")))
+	require.NoError(t, keeper.CommitBid(ctx, auctionID, addrs[1], types.SealedBidCommitment(auctionID, addrs[1], c("token1", 60), "salt1")))
+	require.NoError(t, keeper.CommitBid(ctx, auctionID, addrs[2], types.SealedBidCommitment(auctionID, addrs[2], c("token1", 2000), "salt2")))
+	tApp.CheckBalance(t, ctx, addrs[0], cs(c("token2", 99)))
+
+	// Reveal lots, the lowest lot paying the bid
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultCommitDuration))
+	require.NoError(t, keeper.RevealBid(ctx, auctionID, addrs[0], c("token1", 80), "salt0"))
+	tApp.CheckBalance(t, ctx, addrs[0], nil)
+	require.NoError(t, keeper.RevealBid(ctx, auctionID, addrs[1], c("token1", 60), "salt1"))
+	tApp.CheckBalance(t, ctx, addrs[0], cs(c("token2", 100)))
+	tApp.CheckBalance(t, ctx, addrs[1], nil)
+	err = keeper.RevealBid(ctx, auctionID, addrs[2], c("token1", 2000), "salt2")
+	require.Equal(t, types.CodeLotTooLarge, err.Result().Code)
+
+	// Close auction, minting the winning lot and forfeiting the unrevealed deposit
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultRevealDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	tApp.CheckBalance(t, ctx, addrs[1], cs(c("token1", 60)))
+	tApp.CheckBalance(t, ctx, addrs[2], cs(c("token2", 99)))
+	tApp.CheckBalance(t, ctx, buyerAddr, cs(c("token2", 101), c("debt", 100)))
+}
+
+func TestAuctionParamOverrides(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+	params := keeper.GetParams(ctx)
+	params.Overrides = types.ParamOverrides{
+		types.NewParamOverride(types.AuctionTypeCollateral, "token1", 2*time.Hour, 10*time.Minute, sdk.MustNewDecFromStr("0.5")),
+	}
+	keeper.SetParams(ctx, params)
+
+	collateralID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, is(1), c("debt", 40), sdk.ZeroDec())
+	require.NoError(t, err)
+	surplusID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2", sdk.ZeroDec())
+	require.NoError(t, err)
+
+	// Collateral auctions of token1 use the overridden increment and durations
+	require.NoError(t, keeper.PlaceBid(ctx, collateralID, buyer, c("token2", 10)))
+	err = keeper.PlaceBid(ctx, collateralID, buyer, c("token2", 14))
+	require.Equal(t, types.CodeBidTooSmall, err.Result().Code)
+	require.NoError(t, keeper.PlaceBid(ctx, collateralID, buyer, c("token2", 15)))
+	auction, _ := keeper.GetAuction(ctx, collateralID)
+	require.Equal(t, ctx.BlockTime().Add(10*time.Minute), auction.GetEndTime())
+	require.Equal(t, ctx.BlockTime().Add(2*time.Hour), auction.(types.CollateralAuction).MaxEndTime)
+
+	// Other auction types selling token1 use the global params
+	require.NoError(t, keeper.PlaceBid(ctx, surplusID, buyer, c("token2", 10)))
+	require.NoError(t, keeper.PlaceBid(ctx, surplusID, buyer, c("token2", 11)))
+	auction, _ = keeper.GetAuction(ctx, surplusID)
+	require.Equal(t, ctx.BlockTime().Add(types.DefaultBidDuration), auction.GetEndTime())
+}
+
 func TestStartSurplusAuction(t *testing.T) {
 	someTime := time.Date(1998, time.January, 1, 0, 0, 0, 0, time.UTC)
 	type args struct {
@@ -308,7 +812,7 @@ func TestStartSurplusAuction(t *testing.T) {
 			keeper := tApp.GetAuctionKeeper()
 
 			// run function under test
-			id, err := keeper.StartSurplusAuction(ctx, tc.args.seller, tc.args.lot, tc.args.bidDenom)
+			id, err := keeper.StartSurplusAuction(ctx, tc.args.seller, tc.args.lot, tc.args.bidDenom, sdk.ZeroDec())
 
 			// check
 			sk := tApp.GetSupplyKeeper()
@@ -330,7 +834,9 @@ func TestStartSurplusAuction(t *testing.T) {
 					HasReceivedBids: false,
 					EndTime:         types.DistantFuture,
 					MaxEndTime:      types.DistantFuture,
-				}})
+				},
+					ReservePrice: sdk.ZeroDec(),
+				})
 				require.Equal(t, expectedAuction, actualAuc)
 			} else {
 				require.Error(t, err)
@@ -363,7 +869,7 @@ func TestCloseAuction(t *testing.T) {
 	keeper := tApp.GetAuctionKeeper()
 
 	// Create an auction (lot: 20 token1, initialBid: 0 token2)
-	id, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2") // lot, bid denom
+	id, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2", sdk.ZeroDec()) // lot, bid denom
 	require.NoError(t, err)
 
 	// Attempt to close the auction before EndTime
@@ -393,17 +899,119 @@ func TestCloseExpiredAuctions(t *testing.T) {
 	keeper := tApp.GetAuctionKeeper()
 
 	// Start auction 1
-	_, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2") // lot, bid denom
+	_, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2", sdk.ZeroDec()) // lot, bid denom
 	require.NoError(t, err)
 
 	// Start auction 2
-	_, err = keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2") // lot, bid denom
+	_, err = keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2", sdk.ZeroDec()) // lot, bid denom
 	require.NoError(t, err)
 
 	// Fast forward the block time
 	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultMaxAuctionDuration).Add(1))
 
 	// Close expired auctions
-	err = keeper.CloseExpiredAuctions(ctx)
+	keeper.CloseExpiredAuctions(ctx)
+	require.Empty(t, keeper.GetAllStuckAuctions(ctx))
+}
+
+func TestCloseExpiredAuctionsStuck(t *testing.T) {
+	// Set up
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer := addrs[0]
+	holder := addrs[1]
+	sellerModName := "liquidator"
+
+	tApp := app.NewTestApp()
+
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Minter, supply.Burner) // forward auctions burn proceeds, which are minted again to refund cancelled auctions
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("token2", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token1", 100), c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(holder, cs(), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+	supplyKeeper := tApp.GetSupplyKeeper()
+
+	// Start three auctions
+	stuckID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2", sdk.ZeroDec())
 	require.NoError(t, err)
+	cancelID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 30), "token2", sdk.ZeroDec())
+	require.NoError(t, err)
+	okID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 10), "token2", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.NoError(t, keeper.PlaceBid(ctx, stuckID, buyer, c("token2", 10)))
+	require.NoError(t, keeper.PlaceBid(ctx, cancelID, buyer, c("token2", 10)))
+	require.NoError(t, keeper.PlaceBid(ctx, okID, buyer, c("token2", 10)))
+
+	// Move the lots of the first two auctions out of the auction module account so they can't be paid out
+	require.NoError(t, supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, holder, cs(c("token1", 50))))
+
+	// Closing fails for the first two auctions without affecting the third
+	startTime := ctx.BlockTime()
+	ctx = ctx.WithBlockTime(startTime.Add(types.DefaultMaxAuctionDuration)).WithEventManager(sdk.NewEventManager())
+	keeper.CloseExpiredAuctions(ctx)
+	_, found := keeper.GetAuction(ctx, okID)
+	require.False(t, found)
+	_, found = keeper.GetAuction(ctx, stuckID)
+	require.True(t, found)
+	stuck, found := keeper.GetStuckAuction(ctx, stuckID)
+	require.True(t, found)
+	require.Equal(t, uint64(1), stuck.Attempts)
+	require.Equal(t, ctx.BlockTime(), stuck.StuckSince)
+	require.NotEmpty(t, stuck.Error)
+	require.Len(t, keeper.GetAllStuckAuctions(ctx), 2)
+	var failures int
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypeAuctionCloseFailed {
+			failures++
+		}
+	}
+	require.Equal(t, 2, failures)
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 110), c("token2", 70)))
+
+	// Closing is retried in later blocks
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	keeper.CloseExpiredAuctions(ctx)
+	stuck, found = keeper.GetStuckAuction(ctx, stuckID)
+	require.True(t, found)
+	require.Equal(t, uint64(2), stuck.Attempts)
+	require.Equal(t, startTime.Add(types.DefaultMaxAuctionDuration), stuck.StuckSince)
+
+	// Only stuck auctions can be settled or cancelled
+	require.Error(t, keeper.SettleStuckAuction(ctx, okID))
+	require.Error(t, keeper.CancelStuckAuction(ctx, okID))
+
+	// Settling fails while the payout is still unpayable
+	require.Error(t, keeper.SettleStuckAuction(ctx, stuckID))
+
+	// Once the module account is refunded the auction can be settled
+	require.NoError(t, supplyKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, cs(c("token1", 20))))
+	require.NoError(t, keeper.SettleStuckAuction(ctx, stuckID))
+	_, found = keeper.GetAuction(ctx, stuckID)
+	require.False(t, found)
+	_, found = keeper.GetStuckAuction(ctx, stuckID)
+	require.False(t, found)
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 130), c("token2", 70)))
+
+	// Cancelling fails while the lot can not be returned to the initiator, leaving the auction stuck
+	require.Error(t, keeper.CancelStuckAuction(ctx, cancelID))
+	_, found = keeper.GetAuction(ctx, cancelID)
+	require.True(t, found)
+	_, found = keeper.GetStuckAuction(ctx, cancelID)
+	require.True(t, found)
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 130), c("token2", 70)))
+
+	// Once the lot is back in the module account, cancelling removes the auction without paying out, refunding the latest bid
+	require.NoError(t, supplyKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, cs(c("token1", 30))))
+	require.NoError(t, keeper.CancelStuckAuction(ctx, cancelID))
+	_, found = keeper.GetAuction(ctx, cancelID)
+	require.False(t, found)
+	require.Empty(t, keeper.GetAllStuckAuctions(ctx))
+	tApp.CheckBalance(t, ctx, buyer, cs(c("token1", 130), c("token2", 80)))
+	tApp.CheckBalance(t, ctx, supply.NewModuleAddress(sellerModName), cs(c("token1", 70), c("token2", 100)))
+	require.True(t, supplyKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins().IsZero())
 }
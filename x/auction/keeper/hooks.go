@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// SetHooks registers the hooks called for auctions started by an initiator, usually the module account name of the
+// calling module. Hooks can only be set once per initiator, use MultiAuctionHooks to register several.
+func (k Keeper) SetHooks(initiator string, hooks types.AuctionHooks) {
+	if _, found := k.hooks[initiator]; found {
+		panic(fmt.Sprintf("cannot set auction hooks twice for initiator %s", initiator))
+	}
+	k.hooks[initiator] = hooks
+}
+
+// SetReservePricer registers the pricer that reprices the reserves of auctions started by an initiator when they are relisted.
+// Pricers can only be set once per initiator.
+func (k Keeper) SetReservePricer(initiator string, pricer types.ReservePricer) {
+	if _, found := k.pricers[initiator]; found {
+		panic(fmt.Sprintf("cannot set reserve pricer twice for initiator %s", initiator))
+	}
+	k.pricers[initiator] = pricer
+}
+
+// relistReservePrice returns the reserve price of an auction being relisted from the reserve pricer of its initiator. The previous
+// reserve price is kept if the initiator has no pricer or the current price is unavailable.
+func (k Keeper) relistReservePrice(ctx sdk.Context, auction types.Auction, previous sdk.Dec) sdk.Dec {
+	pricer, found := k.pricers[auction.GetInitiator()]
+	if !found {
+		return previous
+	}
+	price, err := pricer.ReservePrice(ctx, auction)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("failed to reprice reserve of auction %d: %s", auction.GetID(), err.Result().Log))
+		return previous
+	}
+	if price.IsNil() || price.IsNegative() {
+		return previous
+	}
+	return price
+}
+
+// afterAuctionStarted calls the AfterAuctionStarted hook of the auction's initiator, if set
+func (k Keeper) afterAuctionStarted(ctx sdk.Context, auction types.Auction) {
+	if hooks, found := k.hooks[auction.GetInitiator()]; found {
+		hooks.AfterAuctionStarted(ctx, auction)
+	}
+}
+
+// afterBidPlaced calls the AfterBidPlaced hook of the auction's initiator, if set
+func (k Keeper) afterBidPlaced(ctx sdk.Context, auction types.Auction, bidder sdk.AccAddress, lot, bid sdk.Coin) {
+	if hooks, found := k.hooks[auction.GetInitiator()]; found {
+		hooks.AfterBidPlaced(ctx, auction, bidder, lot, bid)
+	}
+}
+
+// afterAuctionClosed calls the AfterAuctionClosed hook of the auction's initiator, if set
+func (k Keeper) afterAuctionClosed(ctx sdk.Context, closed types.ClosedAuction) {
+	if hooks, found := k.hooks[closed.Initiator]; found {
+		hooks.AfterAuctionClosed(ctx, closed)
+	}
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp"
+)
+
+// mockHooks records the calls made to auction hooks
+type mockHooks struct {
+	started []types.Auction
+	bids    []sdk.Coin
+	closed  []types.ClosedAuction
+}
+
+func (h *mockHooks) AfterAuctionStarted(ctx sdk.Context, auction types.Auction) {
+	h.started = append(h.started, auction)
+}
+
+func (h *mockHooks) AfterBidPlaced(ctx sdk.Context, auction types.Auction, bidder sdk.AccAddress, lot, bid sdk.Coin) {
+	h.bids = append(h.bids, bid)
+}
+
+func (h *mockHooks) AfterAuctionClosed(ctx sdk.Context, closed types.ClosedAuction) {
+	h.closed = append(h.closed, closed)
+}
+
+func TestAuctionHooks(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	buyer := addrs[0]
+	returnAddrs := addrs[1:]
+	sellerModName := cdp.ModuleName
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(buyer, cs(c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	hooks, otherHooks := &mockHooks{}, &mockHooks{}
+	keeper.SetHooks(sellerModName, hooks)
+	keeper.SetHooks("other", otherHooks)
+	require.Panics(t, func() { keeper.SetHooks(sellerModName, &mockHooks{}) })
+	require.Panics(t, func() { keeper.SetHooks(cdp.LiquidatorMacc, &mockHooks{}) }) // registered by the app
+
+	// Hooks are called for each step of auctions started by the initiator
+	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, is(1), c("debt", 40), sdk.ZeroDec())
+	require.NoError(t, err)
+	require.Len(t, hooks.started, 1)
+	require.Equal(t, auctionID, hooks.started[0].GetID())
+
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 10)))
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, buyer, c("token2", 50)))
+	require.Equal(t, []sdk.Coin{c("token2", 10), c("token2", 50)}, hooks.bids)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	require.Len(t, hooks.closed, 1)
+	require.Equal(t, auctionID, hooks.closed[0].ID)
+	require.Equal(t, c("token1", 20), hooks.closed[0].Lot)
+	require.Equal(t, c("token2", 50), hooks.closed[0].Bid)
+	require.Equal(t, buyer, hooks.closed[0].Winner)
+
+	// Hooks for other initiators are not called
+	require.Equal(t, &mockHooks{}, otherHooks)
+}
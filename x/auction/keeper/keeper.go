@@ -19,6 +19,8 @@ type Keeper struct {
 	cdc           *codec.Codec
 	paramSubspace subspace.Subspace
 	codespace     sdk.CodespaceType
+	hooks         map[string]types.AuctionHooks  // hooks for auctions, by initiator
+	pricers       map[string]types.ReservePricer // reserve pricers for relisted auctions, by initiator
 }
 
 // NewKeeper returns a new auction keeper.
@@ -31,6 +33,8 @@ func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, supplyKeeper types.Suppl
 		storeKey:      storeKey,
 		cdc:           cdc,
 		paramSubspace: paramstore.WithKeyTable(types.ParamKeyTable()),
+		hooks:         make(map[string]types.AuctionHooks),
+		pricers:       make(map[string]types.ReservePricer),
 	}
 }
 
@@ -169,3 +173,56 @@ func (k Keeper) IterateAuctions(ctx sdk.Context, cb func(auction types.Auction)
 		}
 	}
 }
+
+// SetStuckAuction stores a record of an auction that failed to close.
+func (k Keeper) SetStuckAuction(ctx sdk.Context, stuck types.StuckAuction) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StuckAuctionKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(stuck)
+	store.Set(types.GetAuctionKey(stuck.AuctionID), bz)
+}
+
+// GetStuckAuction gets the record of an auction that failed to close from the store.
+func (k Keeper) GetStuckAuction(ctx sdk.Context, auctionID uint64) (types.StuckAuction, bool) {
+	var stuck types.StuckAuction
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StuckAuctionKeyPrefix)
+	bz := store.Get(types.GetAuctionKey(auctionID))
+	if bz == nil {
+		return stuck, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &stuck)
+	return stuck, true
+}
+
+// DeleteStuckAuction removes the record of an auction that failed to close from the store.
+func (k Keeper) DeleteStuckAuction(ctx sdk.Context, auctionID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StuckAuctionKeyPrefix)
+	store.Delete(types.GetAuctionKey(auctionID))
+}
+
+// IterateStuckAuctions provides an iterator over the records of auctions that failed to close, ordered by auction ID.
+// For each record, cb will be called. If cb returns true, the iterator will close and stop.
+func (k Keeper) IterateStuckAuctions(ctx sdk.Context, cb func(stuck types.StuckAuction) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.StuckAuctionKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var stuck types.StuckAuction
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &stuck)
+
+		if cb(stuck) {
+			break
+		}
+	}
+}
+
+// GetAllStuckAuctions returns the records of all auctions that failed to close.
+func (k Keeper) GetAllStuckAuctions(ctx sdk.Context) types.StuckAuctions {
+	stuckAuctions := types.StuckAuctions{}
+	k.IterateStuckAuctions(ctx, func(stuck types.StuckAuction) bool {
+		stuckAuctions = append(stuckAuctions, stuck)
+		return false
+	})
+	return stuckAuctions
+}
@@ -13,3 +13,8 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSubspace.GetParamSet(ctx, &params)
 	return
 }
+
+// getAuctionParams returns the durations and bid increment for an auction, after any overrides for its type and lot denom.
+func (k Keeper) getAuctionParams(ctx sdk.Context, auction types.Auction) types.AuctionParams {
+	return k.GetParams(ctx).ForAuction(auction.GetType(), auction.GetLot().Denom)
+}
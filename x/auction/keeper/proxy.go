@@ -0,0 +1,383 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kava-labs/kava/x/auction/types"
+)
+
+// SetMaxBid sets a bidder's proxy bid on an English auction, escrowing what the bidder may pay for bids, and places any bids it makes.
+// A bidder's previous proxy bid on the auction is replaced and its escrow returned. See types.ProxyBid for how the max bid is interpreted.
+func (k Keeper) SetMaxBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, maxBid sdk.Coin) sdk.Error {
+
+	auction, found := k.GetAuction(ctx, auctionID)
+	if !found {
+		return types.ErrAuctionNotFound(k.codespace, auctionID)
+	}
+	if ctx.BlockTime().After(auction.GetEndTime()) {
+		return types.ErrAuctionHasExpired(k.codespace, auctionID)
+	}
+
+	// the commitment is the most the bidder can pay for bids, as refunds of outbid bids go back into the escrow
+	var commitment sdk.Coin
+	switch a := auction.(type) {
+	case types.SurplusAuction:
+		if maxBid.Denom != a.Bid.Denom {
+			return types.ErrInvalidBidDenom(k.codespace, maxBid.Denom, a.Bid.Denom)
+		}
+		commitment = maxBid
+	case types.DebtAuction:
+		if maxBid.Denom != a.Lot.Denom {
+			return types.ErrInvalidLotDenom(k.codespace, maxBid.Denom, a.Lot.Denom)
+		}
+		commitment = a.Bid
+	case types.CollateralAuction:
+		if a.IsComplete() {
+			return types.ErrAuctionHasExpired(k.codespace, auctionID)
+		}
+		switch maxBid.Denom {
+		case a.Lot.Denom:
+			commitment = a.MaxBid
+		case a.Bid.Denom:
+			if a.IsReversePhase() {
+				return types.ErrCollateralAuctionIsInReversePhase(k.codespace, auctionID)
+			}
+			if a.MaxBid.IsLT(maxBid) {
+				return types.ErrBidTooLarge(k.codespace, maxBid, a.MaxBid)
+			}
+			commitment = maxBid
+		default:
+			return types.ErrInvalidBidDenom(k.codespace, maxBid.Denom, a.Bid.Denom)
+		}
+	case types.SealedBidAuction:
+		return types.ErrSealedBidsOnly(k.codespace, auctionID)
+	default:
+		return types.ErrProxyBidNotSupported(k.codespace, auction.GetType())
+	}
+
+	// the max bid must be able to outbid the latest bid, or keep it if the bidder placed it
+	proxy := types.NewProxyBid(auctionID, bidder, maxBid, commitment)
+	limit, _ := proxyLimit(auction, proxy)
+	reverse := isReversePhase(auction)
+	current := k.currentAmount(auction)
+	if bidder.Equals(auction.GetBidder()) {
+		if beats(reverse, current.Amount, limit) {
+			return proxyLimitError(k.codespace, reverse, maxBid, current)
+		}
+		proxy.Escrow = commitment.Sub(auction.GetBid())
+	} else if next := k.nextBidAmount(ctx, auction); beats(reverse, next, limit) {
+		return proxyLimitError(k.codespace, reverse, maxBid, sdk.NewCoin(current.Denom, next))
+	}
+
+	if err := k.returnProxyBid(ctx, auctionID, bidder); err != nil {
+		return err
+	}
+	if proxy.Escrow.IsPositive() {
+		err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, bidder, types.ModuleName, sdk.NewCoins(proxy.Escrow))
+		if err != nil {
+			return err
+		}
+	}
+	k.SetProxyBid(ctx, proxy)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAuctionMaxBid,
+			sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", auctionID)),
+			sdk.NewAttribute(types.AttributeKeyBidder, bidder.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxBid, maxBid.String()),
+		),
+	)
+
+	return k.runProxyBids(ctx, auctionID)
+}
+
+// runProxyBids places bids on behalf of proxy bidders until the best proxy bid is winning the auction, or no proxy can outbid the latest bid.
+// The best proxy bid only bids enough to outbid the next best, so competing proxies settle in one bid per auction phase.
+// Proxy bids that fail to bid are cancelled and their escrow returned, so they can't block bidding on the auction.
+func (k Keeper) runProxyBids(ctx sdk.Context, auctionID uint64) sdk.Error {
+	for {
+		auction, found := k.GetAuction(ctx, auctionID)
+		if !found || ctx.BlockTime().After(auction.GetEndTime()) {
+			return nil
+		}
+		if a, ok := auction.(types.CollateralAuction); ok && a.IsComplete() {
+			return nil
+		}
+		proxy, amount, found := k.nextProxyBid(ctx, auction)
+		if !found {
+			return nil
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.placeProxyBid(cacheCtx, auction, proxy, amount); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("cancelling proxy bid of %s on auction %d: %s", proxy.Bidder, auctionID, err.Result().Log))
+			if err := k.returnProxyBid(ctx, auctionID, proxy.Bidder); err != nil {
+				return err
+			}
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// nextProxyBid returns the proxy bid that should bid next on an auction, and the bid (or in reverse phase lot) amount it should bid.
+// Ties between proxy bids with the same limit go to the latest bidder, then to the proxy bid first in store order.
+func (k Keeper) nextProxyBid(ctx sdk.Context, auction types.Auction) (types.ProxyBid, sdk.Int, bool) {
+	reverse := isReversePhase(auction)
+	latestBidder := auction.GetBidder()
+
+	var best types.ProxyBid
+	var bestLimit, secondLimit sdk.Int
+	var hasBest, hasSecond bool
+	k.IterateProxyBids(ctx, auction.GetID(), func(pb types.ProxyBid) bool {
+		limit, ok := proxyLimit(auction, pb)
+		switch {
+		case !ok:
+		case !hasBest:
+			best, bestLimit, hasBest = pb, limit, true
+		case beats(reverse, limit, bestLimit) || (limit.Equal(bestLimit) && pb.Bidder.Equals(latestBidder)):
+			secondLimit, hasSecond = bestLimit, true
+			best, bestLimit = pb, limit
+		case !hasSecond || beats(reverse, limit, secondLimit):
+			secondLimit, hasSecond = limit, true
+		}
+		return false
+	})
+	if !hasBest {
+		return types.ProxyBid{}, sdk.Int{}, false
+	}
+
+	next := k.nextBidAmount(ctx, auction)
+	secondCompetes := hasSecond && !beats(reverse, next, secondLimit)
+	if best.Bidder.Equals(latestBidder) {
+		// the winning proxy only bids again to stay ahead of the next best proxy
+		if !secondCompetes {
+			return types.ProxyBid{}, sdk.Int{}, false
+		}
+		return best, capAtLimit(reverse, k.outbidAmount(ctx, auction, secondLimit), bestLimit), true
+	}
+	if beats(reverse, next, bestLimit) {
+		return types.ProxyBid{}, sdk.Int{}, false
+	}
+	if secondCompetes {
+		return best, capAtLimit(reverse, k.outbidAmount(ctx, auction, secondLimit), bestLimit), true
+	}
+	return best, next, true
+}
+
+// placeProxyBid places a bid on behalf of a proxy bidder, paying for it from the proxy bid's escrow.
+func (k Keeper) placeProxyBid(ctx sdk.Context, auction types.Auction, proxy types.ProxyBid, amount sdk.Int) sdk.Error {
+	// the bidder pays the full bid, or in reverse phase the auction's bid, less any bid of theirs it replaces
+	current := k.currentAmount(auction)
+	cost := auction.GetBid()
+	if !isReversePhase(auction) {
+		cost = sdk.NewCoin(cost.Denom, amount)
+	}
+	if proxy.Bidder.Equals(auction.GetBidder()) {
+		cost = cost.Sub(auction.GetBid())
+	}
+
+	if cost.IsPositive() {
+		if proxy.Escrow.IsLT(cost) {
+			return sdk.ErrInsufficientCoins(fmt.Sprintf("proxy bid escrow %s is less than bid cost %s", proxy.Escrow, cost))
+		}
+		proxy.Escrow = proxy.Escrow.Sub(cost)
+		k.SetProxyBid(ctx, proxy)
+		err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proxy.Bidder, sdk.NewCoins(cost))
+		if err != nil {
+			return err
+		}
+	}
+	return k.placeBid(ctx, auction.GetID(), proxy.Bidder, sdk.NewCoin(current.Denom, amount))
+}
+
+// refundBid returns an outbid bid to its bidder, or to the escrow of the bidder's proxy bid on the auction if they have one.
+func (k Keeper) refundBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress, refund sdk.Coin) sdk.Error {
+	if proxy, found := k.GetProxyBid(ctx, auctionID, bidder); found {
+		proxy.Escrow = proxy.Escrow.Add(refund)
+		k.SetProxyBid(ctx, proxy)
+		return nil
+	}
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(refund))
+}
+
+// returnProxyBid deletes a bidder's proxy bid on an auction, if there is one, returning its escrow.
+func (k Keeper) returnProxyBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress) sdk.Error {
+	proxy, found := k.GetProxyBid(ctx, auctionID, bidder)
+	if !found {
+		return nil
+	}
+	k.DeleteProxyBid(ctx, auctionID, bidder)
+	if !proxy.Escrow.IsPositive() {
+		return nil
+	}
+	return k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, sdk.NewCoins(proxy.Escrow))
+}
+
+// returnProxyBids deletes all proxy bids on an auction, returning their escrow.
+func (k Keeper) returnProxyBids(ctx sdk.Context, auctionID uint64) sdk.Error {
+	var bidders []sdk.AccAddress
+	k.IterateProxyBids(ctx, auctionID, func(pb types.ProxyBid) bool {
+		bidders = append(bidders, pb.Bidder)
+		return false
+	})
+	for _, bidder := range bidders {
+		if err := k.returnProxyBid(ctx, auctionID, bidder); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// currentAmount returns the side of an auction that bids compete on, the bid in forward phases and the lot in reverse phases.
+func (k Keeper) currentAmount(auction types.Auction) sdk.Coin {
+	if isReversePhase(auction) {
+		return auction.GetLot()
+	}
+	return auction.GetBid()
+}
+
+// nextBidAmount returns the least competitive bid amount (or in reverse phase lot amount) that the auction would accept next.
+func (k Keeper) nextBidAmount(ctx sdk.Context, auction types.Auction) sdk.Int {
+	next := k.outbidAmount(ctx, auction, k.currentAmount(auction).Amount)
+	switch a := auction.(type) {
+	case types.SurplusAuction:
+		return sdk.MaxInt(next, a.MinReserveBid().Amount)
+	case types.CollateralAuction:
+		if !a.IsReversePhase() {
+			return sdk.MaxInt(next, a.MinReserveBid().Amount)
+		}
+	}
+	return next
+}
+
+// outbidAmount returns the least competitive amount that outbids an amount by the auction's minimum increment.
+// Lots are decreased in reverse phase, possibly below zero where no lot can outbid the amount.
+func (k Keeper) outbidAmount(ctx sdk.Context, auction types.Auction, amount sdk.Int) sdk.Int {
+	increment := k.getAuctionParams(ctx, auction).Increment
+	if a, ok := auction.(types.CollateralAuction); ok && !a.IsReversePhase() {
+		// bids are allowed to hit MaxBid even though it may be less than the increment
+		return sdk.MinInt(amount.Add(minIncrement(amount, increment)), a.MaxBid.Amount)
+	}
+	if isReversePhase(auction) {
+		return amount.Sub(minIncrement(amount, increment))
+	}
+	return amount.Add(minIncrement(amount, increment))
+}
+
+// minIncrement returns the increment of an amount, which is at least 1 to avoid replacing a bid at no cost.
+func minIncrement(amount sdk.Int, increment sdk.Dec) sdk.Int {
+	return sdk.MaxInt(sdk.NewInt(1), sdk.NewDecFromInt(amount).Mul(increment).RoundInt())
+}
+
+// proxyLimit returns the limit of a proxy bid in the current phase of an auction, and whether the proxy bid bids in that phase.
+func proxyLimit(auction types.Auction, proxy types.ProxyBid) (sdk.Int, bool) {
+	switch a := auction.(type) {
+	case types.SurplusAuction, types.DebtAuction:
+		return proxy.MaxBid.Amount, true
+	case types.CollateralAuction:
+		if proxy.MaxBid.Denom == a.Lot.Denom {
+			if a.IsReversePhase() {
+				return proxy.MaxBid.Amount, true
+			}
+			return a.MaxBid.Amount, true
+		}
+		// partial bids can reduce MaxBid below the proxy bid's limit
+		return sdk.MinInt(proxy.MaxBid.Amount, a.MaxBid.Amount), !a.IsReversePhase()
+	default:
+		return sdk.Int{}, false
+	}
+}
+
+// isReversePhase returns whether bids on an auction decrease the lot rather than increase the bid.
+func isReversePhase(auction types.Auction) bool {
+	switch a := auction.(type) {
+	case types.DebtAuction:
+		return true
+	case types.CollateralAuction:
+		return a.IsReversePhase()
+	default:
+		return false
+	}
+}
+
+// beats returns whether an amount is strictly more competitive than another, larger bids in forward phases and smaller lots in reverse phases.
+func beats(reverse bool, amount, other sdk.Int) bool {
+	if reverse {
+		return amount.LT(other)
+	}
+	return amount.GT(other)
+}
+
+// capAtLimit returns the amount, or the limit if the amount is more competitive than it.
+func capAtLimit(reverse bool, amount, limit sdk.Int) sdk.Int {
+	if beats(reverse, amount, limit) {
+		return limit
+	}
+	return amount
+}
+
+// proxyLimitError returns the error for a max bid that can't outbid the auction.
+func proxyLimitError(codespace sdk.CodespaceType, reverse bool, maxBid, required sdk.Coin) sdk.Error {
+	if reverse {
+		return types.ErrLotTooLarge(codespace, maxBid, required)
+	}
+	return types.ErrBidTooSmall(codespace, maxBid, required)
+}
+
+// SetProxyBid sets a proxy bid in the store
+func (k Keeper) SetProxyBid(ctx sdk.Context, proxy types.ProxyBid) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProxyBidKeyPrefix)
+	store.Set(types.GetProxyBidKey(proxy.AuctionID, proxy.Bidder), k.cdc.MustMarshalBinaryLengthPrefixed(proxy))
+}
+
+// GetProxyBid returns a bidder's proxy bid on an auction
+func (k Keeper) GetProxyBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress) (types.ProxyBid, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProxyBidKeyPrefix)
+	bz := store.Get(types.GetProxyBidKey(auctionID, bidder))
+	if bz == nil {
+		return types.ProxyBid{}, false
+	}
+	var proxy types.ProxyBid
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &proxy)
+	return proxy, true
+}
+
+// DeleteProxyBid deletes a bidder's proxy bid on an auction from the store
+func (k Keeper) DeleteProxyBid(ctx sdk.Context, auctionID uint64, bidder sdk.AccAddress) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProxyBidKeyPrefix)
+	store.Delete(types.GetProxyBidKey(auctionID, bidder))
+}
+
+// IterateProxyBids iterates over the proxy bids on an auction and performs a callback function
+func (k Keeper) IterateProxyBids(ctx sdk.Context, auctionID uint64, cb func(proxy types.ProxyBid) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProxyBidKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetAuctionKey(auctionID))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var proxy types.ProxyBid
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &proxy)
+		if cb(proxy) {
+			break
+		}
+	}
+}
+
+// IterateAllProxyBids iterates over the proxy bids on all auctions and performs a callback function
+func (k Keeper) IterateAllProxyBids(ctx sdk.Context, cb func(proxy types.ProxyBid) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ProxyBidKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var proxy types.ProxyBid
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &proxy)
+		if cb(proxy) {
+			break
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp"
+)
+
+func TestSurplusAuctionProxyBids(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(3)
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(addrs[0], cs(c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(addrs[1], cs(c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(addrs[2], cs(c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	auctionID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2", sdk.ZeroDec())
+	require.NoError(t, err)
+
+	// A proxy bid places the minimum bid, escrowing the rest of its max bid
+	require.NoError(t, keeper.SetMaxBid(ctx, auctionID, addrs[0], c("token2", 100)))
+	auction, _ := keeper.GetAuction(ctx, auctionID)
+	require.Equal(t, addrs[0], auction.GetBidder())
+	require.Equal(t, c("token2", 1), auction.GetBid())
+	proxy, found := keeper.GetProxyBid(ctx, auctionID, addrs[0])
+	require.True(t, found)
+	require.Equal(t, c("token2", 99), proxy.Escrow)
+	tApp.CheckBalance(t, ctx, addrs[0], nil)
+
+	// A lower proxy bid is outbid by the minimum increment above its max bid
+	require.NoError(t, keeper.SetMaxBid(ctx, auctionID, addrs[1], c("token2", 60)))
+	auction, _ = keeper.GetAuction(ctx, auctionID)
+	require.Equal(t, addrs[0], auction.GetBidder())
+	require.Equal(t, c("token2", 63), auction.GetBid())
+	proxy, _ = keeper.GetProxyBid(ctx, auctionID, addrs[0])
+	require.Equal(t, c("token2", 37), proxy.Escrow)
+	tApp.CheckBalance(t, ctx, addrs[1], cs(c("token2", 40)))
+
+	// Bids are outbid automatically, with refunds returned to the escrow
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, addrs[2], c("token2", 70)))
+	auction, _ = keeper.GetAuction(ctx, auctionID)
+	require.Equal(t, addrs[0], auction.GetBidder())
+	require.Equal(t, c("token2", 74), auction.GetBid())
+	proxy, _ = keeper.GetProxyBid(ctx, auctionID, addrs[0])
+	require.Equal(t, c("token2", 26), proxy.Escrow)
+	tApp.CheckBalance(t, ctx, addrs[2], cs(c("token2", 100)))
+
+	// Escrows are returned when the auction closes
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	tApp.CheckBalance(t, ctx, addrs[0], cs(c("token1", 20), c("token2", 26)))
+	tApp.CheckBalance(t, ctx, addrs[1], cs(c("token2", 100)))
+	_, found = keeper.GetProxyBid(ctx, auctionID, addrs[0])
+	require.False(t, found)
+	_, found = keeper.GetProxyBid(ctx, auctionID, addrs[1])
+	require.False(t, found)
+}
+
+func TestCollateralAuctionProxyBids(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(4)
+	returnAddrs := addrs[3:]
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(addrs[0], cs(c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(addrs[1], cs(c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(addrs[2], cs(c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	auctionID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), returnAddrs, is(1), c("debt", 40), sdk.ZeroDec())
+	require.NoError(t, err)
+
+	// A proxy bid in the bid denom only bids in forward phase
+	require.NoError(t, keeper.SetMaxBid(ctx, auctionID, addrs[1], c("token2", 30)))
+	// A proxy bid in the lot denom bids up to MaxBid, then down to its max bid in reverse phase
+	require.NoError(t, keeper.SetMaxBid(ctx, auctionID, addrs[0], c("token1", 15)))
+	auction, _ := keeper.GetAuction(ctx, auctionID)
+	require.Equal(t, addrs[0], auction.GetBidder())
+	require.Equal(t, c("token2", 32), auction.GetBid())
+
+	// Reaching MaxBid switches to reverse phase, where the proxy bid continues to outbid
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, addrs[2], c("token2", 50)))
+	auction, _ = keeper.GetAuction(ctx, auctionID)
+	require.Equal(t, addrs[0], auction.GetBidder())
+	require.Equal(t, c("token1", 19), auction.GetLot())
+
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, addrs[2], c("token1", 16)))
+	auction, _ = keeper.GetAuction(ctx, auctionID)
+	require.Equal(t, addrs[0], auction.GetBidder())
+	require.Equal(t, c("token1", 15), auction.GetLot())
+	proxy, _ := keeper.GetProxyBid(ctx, auctionID, addrs[0])
+	require.Equal(t, c("token2", 0), proxy.Escrow)
+	tApp.CheckBalance(t, ctx, addrs[2], cs(c("token2", 100)))
+
+	// Bids beyond the max bid are not outbid
+	require.NoError(t, keeper.PlaceBid(ctx, auctionID, addrs[2], c("token1", 14)))
+	auction, _ = keeper.GetAuction(ctx, auctionID)
+	require.Equal(t, addrs[2], auction.GetBidder())
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultBidDuration))
+	require.NoError(t, keeper.CloseAuction(ctx, auctionID))
+	tApp.CheckBalance(t, ctx, addrs[0], cs(c("token2", 100)))
+	tApp.CheckBalance(t, ctx, addrs[1], cs(c("token2", 100)))
+	tApp.CheckBalance(t, ctx, addrs[2], cs(c("token1", 14), c("token2", 50)))
+}
+
+func TestSetMaxBidErrors(t *testing.T) {
+	// Setup
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	sellerModName := cdp.LiquidatorMacc
+
+	tApp := app.NewTestApp()
+	sellerAcc := supply.NewEmptyModuleAccount(sellerModName, supply.Burner, supply.Minter)
+	require.NoError(t, sellerAcc.SetCoins(cs(c("token1", 100), c("debt", 100))))
+	tApp.InitializeFromGenesisStates(
+		NewAuthGenStateFromAccs(authexported.GenesisAccounts{
+			auth.NewBaseAccount(addrs[0], cs(c("token2", 100)), nil, 0, 0),
+			auth.NewBaseAccount(addrs[1], cs(c("token2", 100)), nil, 0, 0),
+			sellerAcc,
+		}),
+	)
+	ctx := tApp.NewContext(false, abci.Header{})
+	keeper := tApp.GetAuctionKeeper()
+
+	surplusID, err := keeper.StartSurplusAuction(ctx, sellerModName, c("token1", 20), "token2", sdk.ZeroDec())
+	require.NoError(t, err)
+	require.NoError(t, keeper.PlaceBid(ctx, surplusID, addrs[0], c("token2", 50)))
+	debtID, err := keeper.StartDebtAuction(ctx, sellerModName, c("token2", 20), c("token1", 100), c("debt", 20))
+	require.NoError(t, err)
+	collateralID, err := keeper.StartCollateralAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), addrs[1:], is(1), c("debt", 40), sdk.ZeroDec())
+	require.NoError(t, err)
+	dutchID, err := keeper.StartDutchAuction(ctx, sellerModName, c("token1", 20), c("token2", 50), sdk.OneDec(), addrs[1:], is(1), c("debt", 40))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		auctionID     uint64
+		maxBid        sdk.Coin
+		expectedError sdk.CodeType
+	}{
+		{"auction not found", 100, c("token2", 10), types.CodeAuctionNotFound},
+		{"invalid bid denom", surplusID, c("token1", 10), types.CodeInvalidBidDenom},
+		{"max bid below next bid", surplusID, c("token2", 51), types.CodeBidTooSmall},
+		{"max lot above next lot", debtID, c("token1", 96), types.CodeLotTooLarge},
+		{"max bid above collateral max bid", collateralID, c("token2", 60), types.CodeBidTooLarge},
+		{"dutch auction", dutchID, c("token2", 10), types.CodeProxyBidNotSupported},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := keeper.SetMaxBid(ctx, tc.auctionID, addrs[1], tc.maxBid)
+			require.Error(t, err)
+			require.Equal(t, tc.expectedError, err.Result().Code)
+		})
+	}
+
+	// The latest bidder can set a max bid at their current bid, and replacing it returns the previous escrow
+	require.NoError(t, keeper.SetMaxBid(ctx, surplusID, addrs[0], c("token2", 80)))
+	tApp.CheckBalance(t, ctx, addrs[0], cs(c("token2", 20)))
+	require.NoError(t, keeper.SetMaxBid(ctx, surplusID, addrs[0], c("token2", 50)))
+	tApp.CheckBalance(t, ctx, addrs[0], cs(c("token2", 50)))
+}
@@ -18,6 +18,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryAuctions(ctx, req, keeper)
 		case types.QueryGetParams:
 			return queryGetParams(ctx, req, keeper)
+		case types.QueryGetStuckAuctions:
+			return queryStuckAuctions(ctx, req, keeper)
+		case types.QueryGetClosedAuctions:
+			return queryClosedAuctions(ctx, req, keeper)
+		case types.QueryGetBidHistory:
+			return queryBidHistory(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auction query endpoint")
 		}
@@ -48,12 +54,24 @@ func queryAuction(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte
 }
 
 func queryAuctions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
-	// Get all auctions
+	// Decode request, an empty request returns all auctions
+	var requestParams types.QueryAllAuctionParams
+	if len(req.Data) != 0 {
+		err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+		if err != nil {
+			return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+		}
+	}
+
+	// Get the matching auctions
 	auctionsList := types.Auctions{}
 	keeper.IterateAuctions(ctx, func(a types.Auction) bool {
-		auctionsList = append(auctionsList, a)
+		if requestParams.Matches(a) {
+			auctionsList = append(auctionsList, a)
+		}
 		return false
 	})
+	auctionsList = paginateAuctions(auctionsList, requestParams.Page, requestParams.Limit)
 
 	// Encode Results
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, auctionsList)
@@ -64,6 +82,19 @@ func queryAuctions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byt
 	return bz, nil
 }
 
+func queryStuckAuctions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	// Get all stuck auctions
+	stuckAuctions := keeper.GetAllStuckAuctions(ctx)
+
+	// Encode results
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, stuckAuctions)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
 // query params in the auction store
 func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	// Get params
@@ -77,3 +108,88 @@ func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]by
 
 	return bz, nil
 }
+
+func queryClosedAuctions(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	// Decode request
+	var requestParams types.QueryArchiveParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	// Lookup closed auctions, by ID if one is given
+	closedAuctions := types.ClosedAuctions{}
+	if requestParams.AuctionID != 0 {
+		closed, found := keeper.GetClosedAuction(ctx, requestParams.AuctionID)
+		if found && (requestParams.Bidder.Empty() || closed.Winner.Equals(requestParams.Bidder)) {
+			closedAuctions = append(closedAuctions, closed)
+		}
+	} else {
+		keeper.IterateClosedAuctions(ctx, func(closed types.ClosedAuction) bool {
+			if requestParams.Bidder.Empty() || closed.Winner.Equals(requestParams.Bidder) {
+				closedAuctions = append(closedAuctions, closed)
+			}
+			return false
+		})
+	}
+
+	// Encode results
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, closedAuctions)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+func queryBidHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	// Decode request
+	var requestParams types.QueryArchiveParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	// Lookup bids, by auction if an ID is given
+	bids := types.AuctionBids{}
+	appendBid := func(bid types.AuctionBid) bool {
+		if requestParams.Bidder.Empty() || bid.Bidder.Equals(requestParams.Bidder) {
+			bids = append(bids, bid)
+		}
+		return false
+	}
+	if requestParams.AuctionID != 0 {
+		for _, bid := range keeper.GetBidHistory(ctx, requestParams.AuctionID) {
+			appendBid(bid)
+		}
+	} else {
+		keeper.IterateBidHistory(ctx, appendBid)
+	}
+
+	// Encode results
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, bids)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+
+	return bz, nil
+}
+
+// paginateAuctions returns the auctions on the input page, or all auctions if the limit is 0
+func paginateAuctions(auctions types.Auctions, page, limit int) types.Auctions {
+	if limit <= 0 {
+		return auctions
+	}
+	if page < 1 {
+		page = 1
+	}
+	start := (page - 1) * limit
+	if start < 0 || start >= len(auctions) {
+		return types.Auctions{}
+	}
+	end := start + limit
+	if end > len(auctions) {
+		end = len(auctions)
+	}
+	return auctions[start:end]
+}
@@ -4,6 +4,7 @@ import (
 	"math/rand"
 	"strings"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -62,7 +63,7 @@ func (suite *QuerierTestSuite) SetupTest() {
 	randSrc := rand.New(rand.NewSource(int64(1234)))
 	for j := 0; j < TestAuctionCount; j++ {
 		lotAmount := simulation.RandIntBetween(randSrc, 10, 100)
-		id, err := suite.keeper.StartSurplusAuction(suite.ctx, modName, c("token1", int64(lotAmount)), "token2")
+		id, err := suite.keeper.StartSurplusAuction(suite.ctx, modName, c("token1", int64(lotAmount)), "token2", sdk.ZeroDec())
 		suite.NoError(err)
 
 		auc, found := suite.keeper.GetAuction(suite.ctx, id)
@@ -104,7 +105,7 @@ func (suite *QuerierTestSuite) TestQueryAuctions() {
 	// Set up request query
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAuctions}, "/"),
-		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAllAuctionParams(1, TestAuctionCount)),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryAllAuctionParams(1, TestAuctionCount, "", "", "", nil, "", "", time.Time{})),
 	}
 
 	// Execute query and check the []byte result
@@ -129,6 +130,129 @@ func (suite *QuerierTestSuite) TestQueryAuctions() {
 	}
 }
 
+func (suite *QuerierTestSuite) TestQueryAuctionsFilters() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	buyer := addrs[0]
+
+	var surplusIDs []uint64
+	suite.keeper.IterateAuctions(ctx, func(a types.Auction) bool {
+		surplusIDs = append(surplusIDs, a.GetID())
+		return false
+	})
+
+	// Add a bid on collateral auction alongside the surplus auctions
+	collateralID, err := suite.keeper.StartCollateralAuction(ctx, cdp.LiquidatorMacc, c("token2", 20), c("token1", 50),
+		addrs, []sdk.Int{sdk.NewInt(1)}, c("debt", 40), sdk.ZeroDec())
+	suite.NoError(err)
+	suite.NoError(suite.keeper.PlaceBid(ctx, collateralID, buyer, c("token1", 10)))
+	allIDs := append(append([]uint64{}, surplusIDs...), collateralID)
+
+	testCases := []struct {
+		name        string
+		params      types.QueryAllAuctionParams
+		expectedIDs []uint64
+	}{
+		{"no filters", types.QueryAllAuctionParams{}, allIDs},
+		{"type", types.QueryAllAuctionParams{Type: "collateral"}, []uint64{collateralID}},
+		{"phase", types.QueryAllAuctionParams{Phase: "forward"}, allIDs},
+		{"initiator", types.QueryAllAuctionParams{Initiator: cdp.LiquidatorMacc}, allIDs},
+		{"unknown initiator", types.QueryAllAuctionParams{Initiator: "other"}, nil},
+		{"bidder", types.QueryAllAuctionParams{Bidder: buyer}, []uint64{collateralID}},
+		{"lot denom", types.QueryAllAuctionParams{LotDenom: "token2"}, []uint64{collateralID}},
+		{"bid denom", types.QueryAllAuctionParams{BidDenom: "token2"}, surplusIDs},
+		{"end before", types.QueryAllAuctionParams{EndBefore: ctx.BlockTime().Add(365 * 24 * time.Hour)}, []uint64{collateralID}},
+		{"combined filters", types.QueryAllAuctionParams{Type: "surplus", LotDenom: "token2"}, nil},
+		{"page", types.QueryAllAuctionParams{Page: 2, Limit: 4}, allIDs[4:8]},
+		{"last page", types.QueryAllAuctionParams{Page: 3, Limit: 4}, allIDs[8:]},
+		{"page past end", types.QueryAllAuctionParams{Page: 4, Limit: 4}, nil},
+		{"filtered page", types.QueryAllAuctionParams{Type: "surplus", Page: 3, Limit: 4}, surplusIDs[8:]},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			query := abci.RequestQuery{
+				Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetAuctions}, "/"),
+				Data: types.ModuleCdc.MustMarshalJSON(tc.params),
+			}
+			bz, err := suite.querier(ctx, []string{types.QueryGetAuctions}, query)
+			suite.NoError(err)
+
+			var auctions types.Auctions
+			suite.NoError(types.ModuleCdc.UnmarshalJSON(bz, &auctions))
+			var ids []uint64
+			for _, a := range auctions {
+				ids = append(ids, a.GetID())
+			}
+			suite.Equal(tc.expectedIDs, ids)
+		})
+	}
+
+	// A request without params returns all auctions
+	bz, err := suite.querier(ctx, []string{types.QueryGetAuctions}, abci.RequestQuery{})
+	suite.NoError(err)
+	var auctions types.Auctions
+	suite.NoError(types.ModuleCdc.UnmarshalJSON(bz, &auctions))
+	suite.Len(auctions, len(allIDs))
+
+	// Malformed params are rejected
+	_, err = suite.querier(ctx, []string{types.QueryGetAuctions}, abci.RequestQuery{Data: []byte("not json")})
+	suite.Error(err)
+}
+
+func (suite *QuerierTestSuite) TestQueryArchive() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+
+	closed := types.ClosedAuctions{
+		types.NewClosedAuction(1, "collateral", cdp.LiquidatorMacc, c("token1", 10), c("token2", 50), addrs[0], 1, ctx.BlockTime()),
+		types.NewClosedAuction(2, "surplus", cdp.LiquidatorMacc, c("token1", 10), c("token2", 20), addrs[1], 1, ctx.BlockTime()),
+	}
+	bids := types.AuctionBids{
+		types.NewAuctionBid(1, 0, types.EventTypeAuctionBid, addrs[1], c("token1", 10), c("token2", 40), 1, ctx.BlockTime()),
+		types.NewAuctionBid(1, 1, types.EventTypeAuctionBid, addrs[0], c("token1", 10), c("token2", 50), 1, ctx.BlockTime()),
+		types.NewAuctionBid(2, 2, types.EventTypeAuctionBid, addrs[1], c("token1", 10), c("token2", 20), 1, ctx.BlockTime()),
+	}
+	for _, ca := range closed {
+		suite.keeper.SetClosedAuction(ctx, ca)
+	}
+	for _, b := range bids {
+		suite.keeper.SetAuctionBid(ctx, b)
+	}
+
+	testCases := []struct {
+		name           string
+		params         types.QueryArchiveParams
+		expectedClosed types.ClosedAuctions
+		expectedBids   types.AuctionBids
+	}{
+		{"all", types.NewQueryArchiveParams(0, nil), closed, bids},
+		{"auction ID", types.NewQueryArchiveParams(1, nil), closed[:1], bids[:2]},
+		{"bidder", types.NewQueryArchiveParams(0, addrs[1]), closed[1:], types.AuctionBids{bids[0], bids[2]}},
+		{"auction ID and bidder", types.NewQueryArchiveParams(1, addrs[1]), nil, bids[:1]},
+		{"unknown auction ID", types.NewQueryArchiveParams(3, nil), nil, nil},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			query := abci.RequestQuery{
+				Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetClosedAuctions}, "/"),
+				Data: types.ModuleCdc.MustMarshalJSON(tc.params),
+			}
+			bz, err := suite.querier(ctx, []string{types.QueryGetClosedAuctions}, query)
+			suite.NoError(err)
+			var closedAuctions types.ClosedAuctions
+			suite.NoError(types.ModuleCdc.UnmarshalJSON(bz, &closedAuctions))
+			suite.Equal(tc.expectedClosed, closedAuctions)
+
+			query.Path = strings.Join([]string{custom, types.QuerierRoute, types.QueryGetBidHistory}, "/")
+			bz, err = suite.querier(ctx, []string{types.QueryGetBidHistory}, query)
+			suite.NoError(err)
+			var auctionBids types.AuctionBids
+			suite.NoError(types.ModuleCdc.UnmarshalJSON(bz, &auctionBids))
+			suite.Equal(tc.expectedBids, auctionBids)
+		})
+	}
+}
+
 func TestQuerierTestSuite(t *testing.T) {
 	suite.Run(t, new(QuerierTestSuite))
 }
@@ -0,0 +1,117 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ClosedAuction is the outcome of an auction, kept after the auction closes until it is older than the archive retention.
+// Lot and Bid are the lot and bid of the auction when it closed, or the winning price for sealed-bid auctions.
+// Collateral auctions add the lot sold and bid raised by partial fills, and count the remaining lot only if it has a winner.
+// Dutch auctions record the lot sold and the total paid for it.
+type ClosedAuction struct {
+	ID        uint64         `json:"id" yaml:"id"`
+	Type      string         `json:"type" yaml:"type"`
+	Initiator string         `json:"initiator" yaml:"initiator"`
+	Lot       sdk.Coin       `json:"lot" yaml:"lot"`
+	Bid       sdk.Coin       `json:"bid" yaml:"bid"`
+	Winner    sdk.AccAddress `json:"winner" yaml:"winner"` // empty if the auction closed without a winning bid
+	Height    int64          `json:"height" yaml:"height"`
+	CloseTime time.Time      `json:"close_time" yaml:"close_time"`
+}
+
+// NewClosedAuction returns a new ClosedAuction
+func NewClosedAuction(id uint64, auctionType, initiator string, lot, bid sdk.Coin, winner sdk.AccAddress, height int64, closeTime time.Time) ClosedAuction {
+	return ClosedAuction{
+		ID:        id,
+		Type:      auctionType,
+		Initiator: initiator,
+		Lot:       lot,
+		Bid:       bid,
+		Winner:    winner,
+		Height:    height,
+		CloseTime: closeTime,
+	}
+}
+
+// String implements fmt.Stringer
+func (ca ClosedAuction) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Closed Auction %d:
+	Type: %s
+	Initiator: %s
+	Lot: %s
+	Bid: %s
+	Winner: %s
+	Height: %d
+	Close Time: %s`,
+		ca.ID, ca.Type, ca.Initiator, ca.Lot, ca.Bid, ca.Winner, ca.Height, ca.CloseTime))
+}
+
+// ClosedAuctions a collection of ClosedAuction objects
+type ClosedAuctions []ClosedAuction
+
+// String implements fmt.Stringer
+func (cas ClosedAuctions) String() string {
+	out := ""
+	for _, ca := range cas {
+		out += ca.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// AuctionBid is an accepted bid, fill or reveal on an auction, kept until the auction's archive entry is pruned.
+// Lot and Bid are the terms of the bid: the auction's lot and bid after a bid, the lot bought and its cost for a fill,
+// and the revealed amount with the auction's lot or bid for a reveal.
+type AuctionBid struct {
+	AuctionID uint64         `json:"auction_id" yaml:"auction_id"`
+	Sequence  uint64         `json:"sequence" yaml:"sequence"` // orders bids across all auctions
+	Type      string         `json:"type" yaml:"type"`         // type of the event emitted for the bid
+	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Lot       sdk.Coin       `json:"lot" yaml:"lot"`
+	Bid       sdk.Coin       `json:"bid" yaml:"bid"`
+	Height    int64          `json:"height" yaml:"height"`
+	Time      time.Time      `json:"time" yaml:"time"`
+}
+
+// NewAuctionBid returns a new AuctionBid
+func NewAuctionBid(auctionID, sequence uint64, bidType string, bidder sdk.AccAddress, lot, bid sdk.Coin, height int64, bidTime time.Time) AuctionBid {
+	return AuctionBid{
+		AuctionID: auctionID,
+		Sequence:  sequence,
+		Type:      bidType,
+		Bidder:    bidder,
+		Lot:       lot,
+		Bid:       bid,
+		Height:    height,
+		Time:      bidTime,
+	}
+}
+
+// String implements fmt.Stringer
+func (b AuctionBid) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Auction Bid:
+	Auction ID: %d
+	Sequence: %d
+	Type: %s
+	Bidder: %s
+	Lot: %s
+	Bid: %s
+	Height: %d
+	Time: %s`,
+		b.AuctionID, b.Sequence, b.Type, b.Bidder, b.Lot, b.Bid, b.Height, b.Time))
+}
+
+// AuctionBids a collection of AuctionBid objects
+type AuctionBids []AuctionBid
+
+// String implements fmt.Stringer
+func (bs AuctionBids) String() string {
+	out := ""
+	for _, b := range bs {
+		out += b.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
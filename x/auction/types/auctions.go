@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"time"
 
@@ -13,6 +15,15 @@ import (
 // Also amino panics when encoding times ≥ the start of year 10000.
 var DistantFuture = time.Date(9000, 1, 1, 0, 0, 0, 0, time.UTC)
 
+// Auction types, as returned by GetType
+const (
+	AuctionTypeSurplus    = "surplus"
+	AuctionTypeDebt       = "debt"
+	AuctionTypeCollateral = "collateral"
+	AuctionTypeDutch      = "dutch"
+	AuctionTypeSealed     = "sealed"
+)
+
 // Auction is an interface for handling common actions on auctions.
 type Auction interface {
 	GetID() uint64
@@ -90,13 +101,16 @@ func (a BaseAuction) String() string {
 // It is normally used to sell off excess pegged asset acquired by the CDP system.
 type SurplusAuction struct {
 	BaseAuction `json:"base_auction" yaml:"base_auction"`
+
+	ReservePrice sdk.Dec `json:"reserve_price" yaml:"reserve_price"` // minimum price of one unit of the lot in units of the bid, 0 for no reserve
+	Relists      uint64  `json:"relists" yaml:"relists"`             // number of times the auction has been restarted after ending below its reserve
 }
 
 // WithID returns an auction with the ID set.
 func (a SurplusAuction) WithID(id uint64) Auction { a.ID = id; return a }
 
 // GetType returns the auction type. Used to identify auctions in event attributes.
-func (a SurplusAuction) GetType() string { return "surplus" }
+func (a SurplusAuction) GetType() string { return AuctionTypeSurplus }
 
 // GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
 // It is used in genesis initialize the module account correctly.
@@ -108,18 +122,52 @@ func (a SurplusAuction) GetModuleAccountCoins() sdk.Coins {
 // GetPhase returns the direction of a surplus auction, which never changes.
 func (a SurplusAuction) GetPhase() string { return "forward" }
 
+// HasReserve returns whether the auction has a reserve price.
+func (a SurplusAuction) HasReserve() bool {
+	return !a.ReservePrice.IsNil() && a.ReservePrice.IsPositive()
+}
+
+// MinReserveBid returns the lowest bid at the reserve price, rounded up.
+func (a SurplusAuction) MinReserveBid() sdk.Coin {
+	if !a.HasReserve() {
+		return sdk.NewCoin(a.Bid.Denom, sdk.ZeroInt())
+	}
+	return sdk.NewCoin(a.Bid.Denom, sdk.NewDecFromInt(a.Lot.Amount).Mul(a.ReservePrice).Ceil().RoundInt())
+}
+
+// Validate checks the auction's reserve price is not negative.
+func (a SurplusAuction) Validate() error {
+	if err := a.BaseAuction.Validate(); err != nil {
+		return err
+	}
+	if !a.ReservePrice.IsNil() && a.ReservePrice.IsNegative() {
+		return fmt.Errorf("reserve price must not be negative, is %s", a.ReservePrice)
+	}
+	return nil
+}
+
+func (a SurplusAuction) String() string {
+	return fmt.Sprintf(`%s
+  Reserve Price:          %s
+  Relists:                %d`,
+		a.BaseAuction, a.ReservePrice, a.Relists,
+	)
+}
+
 // NewSurplusAuction returns a new surplus auction.
-func NewSurplusAuction(seller string, lot sdk.Coin, bidDenom string, endTime time.Time) SurplusAuction {
-	auction := SurplusAuction{BaseAuction{
-		// no ID
-		Initiator:       seller,
-		Lot:             lot,
-		Bidder:          nil,
-		Bid:             sdk.NewInt64Coin(bidDenom, 0),
-		HasReceivedBids: false, // new auctions don't have any bids
-		EndTime:         endTime,
-		MaxEndTime:      endTime,
-	}}
+func NewSurplusAuction(seller string, lot sdk.Coin, bidDenom string, endTime time.Time, reservePrice sdk.Dec) SurplusAuction {
+	auction := SurplusAuction{
+		BaseAuction: BaseAuction{
+			// no ID
+			Initiator:       seller,
+			Lot:             lot,
+			Bidder:          nil,
+			Bid:             sdk.NewInt64Coin(bidDenom, 0),
+			HasReceivedBids: false, // new auctions don't have any bids
+			EndTime:         endTime,
+			MaxEndTime:      endTime},
+		ReservePrice: reservePrice,
+	}
 	return auction
 }
 
@@ -135,7 +183,7 @@ type DebtAuction struct {
 func (a DebtAuction) WithID(id uint64) Auction { a.ID = id; return a }
 
 // GetType returns the auction type. Used to identify auctions in event attributes.
-func (a DebtAuction) GetType() string { return "debt" }
+func (a DebtAuction) GetType() string { return AuctionTypeDebt }
 
 // GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
 // It is used in genesis initialize the module account correctly.
@@ -179,13 +227,17 @@ type CollateralAuction struct {
 	CorrespondingDebt sdk.Coin          `json:"corresponding_debt" yaml:"corresponding_debt"`
 	MaxBid            sdk.Coin          `json:"max_bid" yaml:"max_bid"`
 	LotReturns        WeightedAddresses `json:"lot_returns" yaml:"lot_returns"`
+	ReservePrice      sdk.Dec           `json:"reserve_price" yaml:"reserve_price"` // minimum price of one unit of the lot in units of the bid, 0 for no reserve
+	Relists           uint64            `json:"relists" yaml:"relists"`             // number of times the auction has been restarted after ending below its reserve
+	LotSold           sdk.Coin          `json:"lot_sold" yaml:"lot_sold"`           // lot paid out to partial fills
+	BidRaised         sdk.Coin          `json:"bid_raised" yaml:"bid_raised"`       // bid paid for the lot sold to partial fills
 }
 
 // WithID returns an auction with the ID set.
 func (a CollateralAuction) WithID(id uint64) Auction { a.ID = id; return a }
 
 // GetType returns the auction type. Used to identify auctions in event attributes.
-func (a CollateralAuction) GetType() string { return "collateral" }
+func (a CollateralAuction) GetType() string { return AuctionTypeCollateral }
 
 // GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
 // It is used in genesis initialize the module account correctly.
@@ -200,6 +252,42 @@ func (a CollateralAuction) IsReversePhase() bool {
 	return a.Bid.IsEqual(a.MaxBid)
 }
 
+// IsComplete returns whether partial bids have bought all of the lot or raised all of MaxBid, after which no more bids are accepted.
+func (a CollateralAuction) IsComplete() bool {
+	return a.Lot.IsZero() || a.MaxBid.IsZero()
+}
+
+// HasReserve returns whether the auction has a reserve price.
+func (a CollateralAuction) HasReserve() bool {
+	return !a.ReservePrice.IsNil() && a.ReservePrice.IsPositive()
+}
+
+// MinReserveBid returns the lowest bid at the reserve price, rounded up.
+// Bids that raise all of MaxBid always meet the reserve, as they cover the debt the collateral was seized for.
+func (a CollateralAuction) MinReserveBid() sdk.Coin {
+	if !a.HasReserve() {
+		return sdk.NewCoin(a.Bid.Denom, sdk.ZeroInt())
+	}
+	return sdk.NewCoin(a.Bid.Denom, sdk.MinInt(a.MaxBid.Amount, sdk.NewDecFromInt(a.Lot.Amount).Mul(a.ReservePrice).Ceil().RoundInt()))
+}
+
+// Validate checks the auction's reserve price is not negative, and that the lot sold and bid raised by partial fills are in the lot and bid denoms.
+func (a CollateralAuction) Validate() error {
+	if err := a.BaseAuction.Validate(); err != nil {
+		return err
+	}
+	if !a.ReservePrice.IsNil() && a.ReservePrice.IsNegative() {
+		return fmt.Errorf("reserve price must not be negative, is %s", a.ReservePrice)
+	}
+	if !a.LotSold.IsValid() || a.LotSold.Denom != a.Lot.Denom {
+		return fmt.Errorf("invalid lot sold %s for lot %s", a.LotSold, a.Lot)
+	}
+	if !a.BidRaised.IsValid() || a.BidRaised.Denom != a.Bid.Denom {
+		return fmt.Errorf("invalid bid raised %s for bid %s", a.BidRaised, a.Bid)
+	}
+	return nil
+}
+
 // GetPhase returns the direction of a collateral auction.
 func (a CollateralAuction) GetPhase() string {
 	if a.IsReversePhase() {
@@ -217,15 +305,20 @@ func (a CollateralAuction) String() string {
   End Time:   						%s
 	Max End Time:      			%s
 	Max Bid									%s
-	LotReturns						%s`,
+	LotReturns						%s
+	Reserve Price						%s
+	Relists									%d
+	Lot Sold								%s
+	Bid Raised							%s`,
 		a.GetID(), a.Initiator, a.Lot,
 		a.Bidder, a.Bid, a.GetEndTime().String(),
 		a.MaxEndTime.String(), a.MaxBid, a.LotReturns,
+		a.ReservePrice, a.Relists, a.LotSold, a.BidRaised,
 	)
 }
 
 // NewCollateralAuction returns a new collateral auction.
-func NewCollateralAuction(seller string, lot sdk.Coin, endTime time.Time, maxBid sdk.Coin, lotReturns WeightedAddresses, debt sdk.Coin) CollateralAuction {
+func NewCollateralAuction(seller string, lot sdk.Coin, endTime time.Time, maxBid sdk.Coin, lotReturns WeightedAddresses, debt sdk.Coin, reservePrice sdk.Dec) CollateralAuction {
 	auction := CollateralAuction{
 		BaseAuction: BaseAuction{
 			// no ID
@@ -239,10 +332,336 @@ func NewCollateralAuction(seller string, lot sdk.Coin, endTime time.Time, maxBid
 		CorrespondingDebt: debt,
 		MaxBid:            maxBid,
 		LotReturns:        lotReturns,
+		ReservePrice:      reservePrice,
+		LotSold:           sdk.NewInt64Coin(lot.Denom, 0),
+		BidRaised:         sdk.NewInt64Coin(maxBid.Denom, 0),
+	}
+	return auction
+}
+
+// DutchAuction is a descending price auction.
+// The price of the lot starts above its market value and decreases by PriceDecay every DecayInterval. Any amount of the lot can be bought
+// at the current price, until MaxBid has been raised or the lot is sold out.
+// Unsold Lot is sent to LotReturns, being divided among the addresses by weight.
+// Dutch auctions are an alternative to collateral auctions for selling off collateral seized from CDPs.
+type DutchAuction struct {
+	BaseAuction `json:"base_auction" yaml:"base_auction"`
+
+	CorrespondingDebt sdk.Coin          `json:"corresponding_debt" yaml:"corresponding_debt"`
+	MaxBid            sdk.Coin          `json:"max_bid" yaml:"max_bid"`
+	LotReturns        WeightedAddresses `json:"lot_returns" yaml:"lot_returns"`
+	StartTime         time.Time         `json:"start_time" yaml:"start_time"`
+	StartPrice        sdk.Dec           `json:"start_price" yaml:"start_price"`       // price of one unit of the lot, in units of the bid denom, when the auction starts
+	PriceDecay        sdk.Dec           `json:"price_decay" yaml:"price_decay"`       // fraction of the price removed every DecayInterval
+	DecayInterval     time.Duration     `json:"decay_interval" yaml:"decay_interval"` // time between price decreases
+	MinPrice          sdk.Dec           `json:"min_price" yaml:"min_price"`           // price below which the price stops decaying
+	LotSold           sdk.Coin          `json:"lot_sold" yaml:"lot_sold"`             // lot bought so far, Bid is the total paid for it
+}
+
+// WithID returns an auction with the ID set.
+func (a DutchAuction) WithID(id uint64) Auction { a.ID = id; return a }
+
+// GetType returns the auction type. Used to identify auctions in event attributes.
+func (a DutchAuction) GetType() string { return AuctionTypeDutch }
+
+// GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
+// It is used in genesis initialize the module account correctly.
+func (a DutchAuction) GetModuleAccountCoins() sdk.Coins {
+	// a.Bid is paid out on bids, so is never stored in the module account
+	return sdk.NewCoins(a.Lot).Add(sdk.NewCoins(a.CorrespondingDebt))
+}
+
+// GetPhase returns the direction of a dutch auction, which never changes.
+func (a DutchAuction) GetPhase() string { return "descending" }
+
+// IsComplete returns whether the auction has sold all of its lot or raised MaxBid, after which no more bids are accepted.
+func (a DutchAuction) IsComplete() bool {
+	return a.Lot.IsZero() || a.Bid.IsGTE(a.MaxBid)
+}
+
+// CurrentPrice returns the price of one unit of the lot at the input time.
+// The price is StartPrice * (1 - PriceDecay)^n, where n is the number of whole DecayIntervals since StartTime,
+// but never less than MinPrice.
+func (a DutchAuction) CurrentPrice(t time.Time) sdk.Dec {
+	if !t.After(a.StartTime) || a.DecayInterval <= 0 {
+		return a.StartPrice
+	}
+	steps := uint64(t.Sub(a.StartTime) / a.DecayInterval)
+	price := a.StartPrice.Mul(decPow(sdk.OneDec().Sub(a.PriceDecay), steps))
+	if !a.MinPrice.IsNil() && price.LT(a.MinPrice) {
+		return a.MinPrice
+	}
+	return price
+}
+
+// Validate verifies that the auction end time is before max end time, that the price curve is valid, and that the lot sold is in the lot denom
+func (a DutchAuction) Validate() error {
+	if err := a.BaseAuction.Validate(); err != nil {
+		return err
+	}
+	if a.StartPrice.IsNil() || !a.StartPrice.IsPositive() {
+		return fmt.Errorf("start price must be positive, is %s", a.StartPrice)
+	}
+	if a.PriceDecay.IsNil() || a.PriceDecay.IsNegative() || a.PriceDecay.GTE(sdk.OneDec()) {
+		return fmt.Errorf("price decay must be between 0 and 1, is %s", a.PriceDecay)
+	}
+	if a.DecayInterval <= 0 {
+		return fmt.Errorf("decay interval must be positive, is %s", a.DecayInterval)
+	}
+	if !a.MinPrice.IsNil() && (a.MinPrice.IsNegative() || a.MinPrice.GT(a.StartPrice)) {
+		return fmt.Errorf("min price must be between 0 and the start price, is %s", a.MinPrice)
+	}
+	if !a.LotSold.IsValid() || a.LotSold.Denom != a.Lot.Denom {
+		return fmt.Errorf("invalid lot sold %s for lot %s", a.LotSold, a.Lot)
+	}
+	return nil
+}
+
+func (a DutchAuction) String() string {
+	return fmt.Sprintf(`Auction %d:
+  Initiator:              %s
+  Lot:                    %s
+  Bidder:                 %s
+  Bid:                    %s
+  End Time:               %s
+  Max Bid:                %s
+  LotReturns:             %s
+  Start Time:             %s
+  Start Price:            %s
+  Price Decay:            %s
+  Decay Interval:         %s
+  Min Price:              %s
+  Lot Sold:               %s`,
+		a.GetID(), a.Initiator, a.Lot,
+		a.Bidder, a.Bid, a.GetEndTime().String(),
+		a.MaxBid, a.LotReturns, a.StartTime.String(),
+		a.StartPrice, a.PriceDecay, a.DecayInterval, a.MinPrice, a.LotSold,
+	)
+}
+
+// NewDutchAuction returns a new dutch auction.
+func NewDutchAuction(seller string, lot sdk.Coin, startTime, endTime time.Time, maxBid sdk.Coin, startPrice, priceDecay sdk.Dec, decayInterval time.Duration, minPrice sdk.Dec, lotReturns WeightedAddresses, debt sdk.Coin) DutchAuction {
+	auction := DutchAuction{
+		BaseAuction: BaseAuction{
+			// no ID
+			Initiator:       seller,
+			Lot:             lot,
+			Bidder:          nil,
+			Bid:             sdk.NewInt64Coin(maxBid.Denom, 0),
+			HasReceivedBids: false, // new auctions don't have any bids
+			EndTime:         endTime,
+			MaxEndTime:      endTime},
+		CorrespondingDebt: debt,
+		MaxBid:            maxBid,
+		LotReturns:        lotReturns,
+		StartTime:         startTime,
+		StartPrice:        startPrice,
+		PriceDecay:        priceDecay,
+		DecayInterval:     decayInterval,
+		MinPrice:          minPrice,
+		LotSold:           sdk.NewInt64Coin(lot.Denom, 0),
+	}
+	return auction
+}
+
+// decPow returns d to the power of n, using exponentiation by squaring
+func decPow(d sdk.Dec, n uint64) sdk.Dec {
+	result := sdk.OneDec()
+	for n > 0 {
+		if n%2 == 1 {
+			result = result.Mul(d)
+		}
+		d = d.Mul(d)
+		n /= 2
+	}
+	return result
+}
+
+// Kinds and pricing rules of sealed-bid auctions
+const (
+	SealedBidKindSurplus = "surplus" // sells a fixed lot to the highest bid, burning the proceeds
+	SealedBidKindDebt    = "debt"    // raises a fixed bid from the lowest lot, minting the lot
+
+	SealedBidFirstPrice  = "first-price"  // the winner pays their own bid
+	SealedBidSecondPrice = "second-price" // the winner pays the second best bid
+)
+
+// SealedBidAuction is a surplus or debt auction where bids are hidden until bidding has closed.
+// During the commit phase bidders submit a hash of their bid along with a deposit. Once CommitEndTime has passed bidders reveal
+// their bids, and the best revealed bid wins when the auction closes. Surplus auctions are won by the highest bid for the Lot,
+// debt auctions by the lowest lot for the Bid. Depending on Pricing the winner pays their own bid or the second best revealed bid.
+// Deposits are returned when a bid is revealed. Deposits of commitments that are never revealed are forfeited to the Initiator.
+type SealedBidAuction struct {
+	BaseAuction `json:"base_auction" yaml:"base_auction"`
+
+	Kind              string     `json:"kind" yaml:"kind"`       // "surplus" or "debt"
+	Pricing           string     `json:"pricing" yaml:"pricing"` // "first-price" or "second-price"
+	CommitEndTime     time.Time  `json:"commit_end_time" yaml:"commit_end_time"`
+	Deposit           sdk.Coin   `json:"deposit" yaml:"deposit"` // paid with each commitment, returned when it is revealed
+	Commitments       SealedBids `json:"commitments" yaml:"commitments"`
+	BestBid           sdk.Coin   `json:"best_bid" yaml:"best_bid"`     // best revealed bid amount (surplus) or lot (debt) of Bidder, zero if there are none
+	SecondBid         sdk.Coin   `json:"second_bid" yaml:"second_bid"` // second best revealed bid amount (surplus) or lot (debt), zero if there are none
+	CorrespondingDebt sdk.Coin   `json:"corresponding_debt" yaml:"corresponding_debt"`
+}
+
+// WithID returns an auction with the ID set.
+func (a SealedBidAuction) WithID(id uint64) Auction { a.ID = id; return a }
+
+// GetType returns the auction type. Used to identify auctions in event attributes.
+func (a SealedBidAuction) GetType() string { return AuctionTypeSealed }
+
+// GetModuleAccountCoins returns the total number of coins held in the module account for this auction.
+// It is used in genesis initialize the module account correctly.
+func (a SealedBidAuction) GetModuleAccountCoins() sdk.Coins {
+	coins := sdk.NewCoins(a.CorrespondingDebt)
+	for _, sb := range a.Commitments {
+		if !sb.Revealed {
+			coins = coins.Add(sdk.NewCoins(a.Deposit))
+		}
+	}
+	if a.Kind == SealedBidKindSurplus {
+		// the best bid is held until the auction closes
+		return coins.Add(sdk.NewCoins(a.Lot)).Add(sdk.NewCoins(a.BestBid))
+	}
+	// a.Lot is minted at auction close, so is never stored in the module account
+	if !a.Bidder.Empty() {
+		coins = coins.Add(sdk.NewCoins(a.Bid))
+	}
+	return coins
+}
+
+// GetPhase returns the direction of a sealed-bid auction, which never changes.
+func (a SealedBidAuction) GetPhase() string {
+	if a.Kind == SealedBidKindDebt {
+		return "reverse"
+	}
+	return "forward"
+}
+
+// IsRevealPhase returns whether bids can be revealed at the input time, rather than committed.
+func (a SealedBidAuction) IsRevealPhase(t time.Time) bool {
+	return !t.Before(a.CommitEndTime)
+}
+
+// IsBetterBid returns whether a revealed bid amount beats another, where a zero amount means there is no bid.
+// Surplus auctions are won by higher bids and debt auctions by lower lots. Ties are won by the earlier reveal.
+func (a SealedBidAuction) IsBetterBid(amount, other sdk.Coin) bool {
+	if other.IsZero() {
+		return true
+	}
+	if a.Kind == SealedBidKindDebt {
+		return amount.Amount.LT(other.Amount)
+	}
+	return amount.Amount.GT(other.Amount)
+}
+
+// WinningPrice returns the amount paid by (surplus) or paid to (debt) the winning bidder.
+func (a SealedBidAuction) WinningPrice() sdk.Coin {
+	if a.Pricing == SealedBidSecondPrice && a.SecondBid.IsPositive() {
+		return a.SecondBid
+	}
+	return a.BestBid
+}
+
+// Validate verifies that the auction end time is before max end time, and that the commit phase ends before the auction does
+func (a SealedBidAuction) Validate() error {
+	if err := a.BaseAuction.Validate(); err != nil {
+		return err
+	}
+	if a.CommitEndTime.After(a.EndTime) {
+		return fmt.Errorf("EndTime < CommitEndTime (%s < %s)", a.EndTime, a.CommitEndTime)
+	}
+	if a.Kind != SealedBidKindSurplus && a.Kind != SealedBidKindDebt {
+		return fmt.Errorf("invalid sealed-bid auction kind %s", a.Kind)
+	}
+	if a.Pricing != SealedBidFirstPrice && a.Pricing != SealedBidSecondPrice {
+		return fmt.Errorf("invalid sealed-bid auction pricing %s", a.Pricing)
+	}
+	return nil
+}
+
+func (a SealedBidAuction) String() string {
+	return fmt.Sprintf(`Auction %d:
+  Initiator:              %s
+  Lot:                    %s
+  Bidder:                 %s
+  Bid:                    %s
+  End Time:               %s
+  Kind:                   %s
+  Pricing:                %s
+  Commit End Time:        %s
+  Deposit:                %s
+  Commitments:            %d
+  Best Bid:               %s
+  Second Bid:             %s`,
+		a.GetID(), a.Initiator, a.Lot,
+		a.Bidder, a.Bid, a.GetEndTime().String(),
+		a.Kind, a.Pricing, a.CommitEndTime.String(),
+		a.Deposit, len(a.Commitments), a.BestBid, a.SecondBid,
+	)
+}
+
+// NewSealedBidAuction returns a new sealed-bid auction.
+// Surplus auctions sell lot for bids in the denom of bid, debt auctions raise bid for at most lot.
+func NewSealedBidAuction(initiator, kind, pricing string, lot, bid sdk.Coin, commitEndTime, endTime time.Time, deposit, debt sdk.Coin) SealedBidAuction {
+	bestDenom := bid.Denom
+	if kind == SealedBidKindDebt {
+		bestDenom = lot.Denom
+	}
+	auction := SealedBidAuction{
+		BaseAuction: BaseAuction{
+			// no ID
+			Initiator:       initiator,
+			Lot:             lot,
+			Bidder:          nil,
+			Bid:             bid,
+			HasReceivedBids: false, // new auctions don't have any bids
+			EndTime:         endTime,
+			MaxEndTime:      endTime},
+		Kind:              kind,
+		Pricing:           pricing,
+		CommitEndTime:     commitEndTime,
+		Deposit:           deposit,
+		Commitments:       SealedBids{},
+		BestBid:           sdk.NewInt64Coin(bestDenom, 0),
+		SecondBid:         sdk.NewInt64Coin(bestDenom, 0),
+		CorrespondingDebt: debt,
 	}
 	return auction
 }
 
+// SealedBid is a commitment to a hidden bid on a sealed-bid auction.
+type SealedBid struct {
+	Bidder     sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Commitment []byte         `json:"commitment" yaml:"commitment"` // hash of the bid, see SealedBidCommitment
+	Revealed   bool           `json:"revealed" yaml:"revealed"`
+}
+
+// SealedBids is a slice of SealedBid
+type SealedBids []SealedBid
+
+// Find returns the index of the commitment of a bidder.
+func (sbs SealedBids) Find(bidder sdk.AccAddress) (int, bool) {
+	for i, sb := range sbs {
+		if sb.Bidder.Equals(bidder) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// SealedBidCommitment returns the hash committed to for a bid on a sealed-bid auction.
+// The salt is a secret chosen by the bidder that stops other bidders from guessing the bid from the hash.
+func SealedBidCommitment(auctionID uint64, bidder sdk.AccAddress, amount sdk.Coin, salt string) []byte {
+	hash := sha256.Sum256([]byte(fmt.Sprintf("%d/%s/%s/%s", auctionID, bidder, amount, salt)))
+	return hash[:]
+}
+
+// MatchesCommitment returns whether a revealed bid matches a commitment.
+func (sb SealedBid) MatchesCommitment(auctionID uint64, amount sdk.Coin, salt string) bool {
+	return bytes.Equal(sb.Commitment, SealedBidCommitment(auctionID, sb.Bidder, amount, salt))
+}
+
 // WeightedAddresses is a type for storing some addresses and associated weights.
 type WeightedAddresses struct {
 	Addresses []sdk.AccAddress `json:"addresses" yaml:"addresses"`
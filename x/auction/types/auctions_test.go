@@ -107,6 +107,7 @@ func TestBaseAuctionGetters(t *testing.T) {
 		TestInitiatorModuleName,
 		c(TestLotDenom, TestLotAmount),
 		TestBidDenom, endTime,
+		sdk.ZeroDec(),
 	)
 
 	auctionID := auction.GetID()
@@ -130,6 +131,7 @@ func TestNewSurplusAuction(t *testing.T) {
 		TestInitiatorModuleName,
 		c(TestLotDenom, TestLotAmount),
 		TestBidDenom, endTime,
+		sdk.ZeroDec(),
 	)
 
 	require.Equal(t, surplusAuction.Initiator, TestInitiatorModuleName)
@@ -182,6 +184,7 @@ func TestNewCollateralAuction(t *testing.T) {
 		c(TestBidDenom, TestBidAmount),
 		weightedAddresses,
 		c(TestDebtDenom, TestDebtAmount2),
+		sdk.ZeroDec(),
 	)
 
 	require.Equal(t, collateralAuction.BaseAuction.Initiator, TestInitiatorModuleName)
@@ -193,3 +196,163 @@ func TestNewCollateralAuction(t *testing.T) {
 	require.Equal(t, collateralAuction.LotReturns, weightedAddresses)
 	require.Equal(t, collateralAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount2))
 }
+
+func TestNewDutchAuction(t *testing.T) {
+	addresses := []sdk.AccAddress{
+		sdk.AccAddress([]byte(TestAccAddress1)),
+		sdk.AccAddress([]byte(TestAccAddress2)),
+	}
+	weightedAddresses, _ := NewWeightedAddresses(addresses, []sdk.Int{sdk.NewInt(6), sdk.NewInt(8)})
+
+	startTime := time.Now()
+	endTime := startTime.Add(TestExtraEndTime)
+
+	dutchAuction := NewDutchAuction(
+		TestInitiatorModuleName,
+		c(TestLotDenom, TestLotAmount),
+		startTime,
+		endTime,
+		c(TestBidDenom, TestBidAmount),
+		sdk.MustNewDecFromStr("0.5"),
+		sdk.MustNewDecFromStr("0.1"),
+		time.Minute,
+		sdk.MustNewDecFromStr("0.25"),
+		weightedAddresses,
+		c(TestDebtDenom, TestDebtAmount2),
+	)
+
+	require.Equal(t, dutchAuction.BaseAuction.Initiator, TestInitiatorModuleName)
+	require.Equal(t, dutchAuction.BaseAuction.Lot, c(TestLotDenom, TestLotAmount))
+	require.Equal(t, dutchAuction.BaseAuction.Bid, c(TestBidDenom, 0))
+	require.Equal(t, dutchAuction.BaseAuction.EndTime, endTime)
+	require.Equal(t, dutchAuction.BaseAuction.MaxEndTime, endTime)
+	require.Equal(t, dutchAuction.MaxBid, c(TestBidDenom, TestBidAmount))
+	require.Equal(t, dutchAuction.LotReturns, weightedAddresses)
+	require.Equal(t, dutchAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount2))
+	require.Equal(t, dutchAuction.StartTime, startTime)
+	require.False(t, dutchAuction.IsComplete())
+	require.NoError(t, dutchAuction.Validate())
+}
+
+func TestDutchAuctionCurrentPrice(t *testing.T) {
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	auction := NewDutchAuction(
+		TestInitiatorModuleName,
+		c(TestLotDenom, TestLotAmount),
+		startTime,
+		startTime.Add(time.Hour),
+		c(TestBidDenom, TestBidAmount),
+		sdk.MustNewDecFromStr("100"),
+		sdk.MustNewDecFromStr("0.1"),
+		time.Minute,
+		sdk.MustNewDecFromStr("50"),
+		WeightedAddresses{},
+		c(TestDebtDenom, TestDebtAmount2),
+	)
+
+	testCases := []struct {
+		name     string
+		time     time.Time
+		expected sdk.Dec
+	}{
+		{"before start", startTime.Add(-time.Minute), sdk.MustNewDecFromStr("100")},
+		{"at start", startTime, sdk.MustNewDecFromStr("100")},
+		{"within first interval", startTime.Add(59 * time.Second), sdk.MustNewDecFromStr("100")},
+		{"one interval", startTime.Add(time.Minute), sdk.MustNewDecFromStr("90")},
+		{"two intervals", startTime.Add(2*time.Minute + 30*time.Second), sdk.MustNewDecFromStr("81")},
+		{"five intervals", startTime.Add(5 * time.Minute), sdk.MustNewDecFromStr("59.049")},
+		{"below min price", startTime.Add(7 * time.Minute), sdk.MustNewDecFromStr("50")},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, auction.CurrentPrice(tc.time))
+		})
+	}
+}
+
+func TestNewSealedBidAuction(t *testing.T) {
+	commitEndTime := time.Now().Add(TestExtraEndTime)
+	endTime := commitEndTime.Add(TestExtraEndTime)
+
+	surplusAuction := NewSealedBidAuction(
+		TestInitiatorModuleName,
+		SealedBidKindSurplus,
+		SealedBidSecondPrice,
+		c(TestLotDenom, TestLotAmount),
+		c(TestBidDenom, 0),
+		commitEndTime,
+		endTime,
+		c(TestLotDenom, 1),
+		c(TestDebtDenom, 0),
+	)
+	require.Equal(t, surplusAuction.Initiator, TestInitiatorModuleName)
+	require.Equal(t, surplusAuction.BestBid, c(TestBidDenom, 0))
+	require.Equal(t, surplusAuction.EndTime, endTime)
+	require.Equal(t, surplusAuction.GetPhase(), "forward")
+	require.False(t, surplusAuction.IsRevealPhase(commitEndTime.Add(-time.Second)))
+	require.True(t, surplusAuction.IsRevealPhase(commitEndTime))
+	require.NoError(t, surplusAuction.Validate())
+
+	debtAuction := NewSealedBidAuction(
+		TestInitiatorModuleName,
+		SealedBidKindDebt,
+		SealedBidFirstPrice,
+		c(TestLotDenom, TestLotAmount),
+		c(TestBidDenom, TestBidAmount),
+		commitEndTime,
+		endTime,
+		c(TestBidDenom, 1),
+		c(TestDebtDenom, TestDebtAmount1),
+	)
+	require.Equal(t, debtAuction.BestBid, c(TestLotDenom, 0))
+	require.Equal(t, debtAuction.GetPhase(), "reverse")
+	require.Equal(t, debtAuction.CorrespondingDebt, c(TestDebtDenom, TestDebtAmount1))
+	require.NoError(t, debtAuction.Validate())
+}
+
+func TestSealedBidAuctionWinningPrice(t *testing.T) {
+	testCases := []struct {
+		name      string
+		kind      string
+		pricing   string
+		bestBid   sdk.Coin
+		secondBid sdk.Coin
+		expected  sdk.Coin
+	}{
+		{"surplus first price", SealedBidKindSurplus, SealedBidFirstPrice, c(TestBidDenom, 30), c(TestBidDenom, 20), c(TestBidDenom, 30)},
+		{"surplus second price", SealedBidKindSurplus, SealedBidSecondPrice, c(TestBidDenom, 30), c(TestBidDenom, 20), c(TestBidDenom, 20)},
+		{"single reveal second price", SealedBidKindSurplus, SealedBidSecondPrice, c(TestBidDenom, 30), c(TestBidDenom, 0), c(TestBidDenom, 30)},
+		{"debt second price", SealedBidKindDebt, SealedBidSecondPrice, c(TestLotDenom, 60), c(TestLotDenom, 80), c(TestLotDenom, 80)},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			auction := SealedBidAuction{Kind: tc.kind, Pricing: tc.pricing, BestBid: tc.bestBid, SecondBid: tc.secondBid}
+			require.Equal(t, tc.expected, auction.WinningPrice())
+		})
+	}
+
+	surplus := SealedBidAuction{Kind: SealedBidKindSurplus}
+	require.True(t, surplus.IsBetterBid(c(TestBidDenom, 30), c(TestBidDenom, 20)))
+	require.False(t, surplus.IsBetterBid(c(TestBidDenom, 20), c(TestBidDenom, 30)))
+	debt := SealedBidAuction{Kind: SealedBidKindDebt}
+	require.True(t, debt.IsBetterBid(c(TestLotDenom, 20), c(TestLotDenom, 30)))
+	require.True(t, debt.IsBetterBid(c(TestLotDenom, 30), c(TestLotDenom, 0)))
+}
+
+func TestAuctionMinReserveBid(t *testing.T) {
+	surplusAuction := NewSurplusAuction(TestInitiatorModuleName, c(TestLotDenom, TestLotAmount), TestBidDenom, time.Now(), sdk.MustNewDecFromStr("0.15"))
+	require.True(t, surplusAuction.HasReserve())
+	require.Equal(t, c(TestBidDenom, 15), surplusAuction.MinReserveBid())
+	surplusAuction.ReservePrice = sdk.MustNewDecFromStr("0.151")
+	require.Equal(t, c(TestBidDenom, 16), surplusAuction.MinReserveBid())
+	surplusAuction.ReservePrice = sdk.ZeroDec()
+	require.False(t, surplusAuction.HasReserve())
+	require.Equal(t, c(TestBidDenom, 0), surplusAuction.MinReserveBid())
+
+	collateralAuction := NewCollateralAuction(TestInitiatorModuleName, c(TestLotDenom, TestLotAmount), time.Now(), c(TestBidDenom, TestBidAmount), WeightedAddresses{}, c(TestDebtDenom, TestDebtAmount2), sdk.MustNewDecFromStr("0.15"))
+	require.Equal(t, c(TestBidDenom, 15), collateralAuction.MinReserveBid())
+	collateralAuction.ReservePrice = sdk.OneDec()
+	require.Equal(t, c(TestBidDenom, TestBidAmount), collateralAuction.MinReserveBid())
+	collateralAuction.ReservePrice = sdk.MustNewDecFromStr("-1")
+	require.Error(t, collateralAuction.Validate())
+}
@@ -14,10 +14,18 @@ func init() {
 // RegisterCodec registers concrete types on the codec.
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgPlaceBid{}, "auction/MsgPlaceBid", nil)
+	cdc.RegisterConcrete(MsgPlacePartialBid{}, "auction/MsgPlacePartialBid", nil)
+	cdc.RegisterConcrete(MsgCommitBid{}, "auction/MsgCommitBid", nil)
+	cdc.RegisterConcrete(MsgRevealBid{}, "auction/MsgRevealBid", nil)
+	cdc.RegisterConcrete(MsgSetMaxBid{}, "auction/MsgSetMaxBid", nil)
 
 	cdc.RegisterInterface((*GenesisAuction)(nil), nil)
 	cdc.RegisterInterface((*Auction)(nil), nil)
 	cdc.RegisterConcrete(SurplusAuction{}, "auction/SurplusAuction", nil)
 	cdc.RegisterConcrete(DebtAuction{}, "auction/DebtAuction", nil)
 	cdc.RegisterConcrete(CollateralAuction{}, "auction/CollateralAuction", nil)
+	cdc.RegisterConcrete(DutchAuction{}, "auction/DutchAuction", nil)
+	cdc.RegisterConcrete(SealedBidAuction{}, "auction/SealedBidAuction", nil)
+
+	cdc.RegisterConcrete(StuckAuctionProposal{}, "auction/StuckAuctionProposal", nil)
 }
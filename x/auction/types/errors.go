@@ -25,6 +25,18 @@ const (
 	CodeLotTooLarge                       sdk.CodeType      = 12
 	CodeCollateralAuctionIsInReversePhase sdk.CodeType      = 13
 	CodeCollateralAuctionIsInForwardPhase sdk.CodeType      = 14
+	CodeInvalidPrice                      sdk.CodeType      = 15
+	CodePartialBidNotSupported            sdk.CodeType      = 16
+	CodeAuctionNotStuck                   sdk.CodeType      = 17
+	CodeInvalidStuckAuctionAction         sdk.CodeType      = 18
+	CodeNotSealedBidAuction               sdk.CodeType      = 19
+	CodeSealedBidsOnly                    sdk.CodeType      = 20
+	CodeNotCommitPhase                    sdk.CodeType      = 21
+	CodeNotRevealPhase                    sdk.CodeType      = 22
+	CodeCommitmentNotFound                sdk.CodeType      = 23
+	CodeInvalidReveal                     sdk.CodeType      = 24
+	CodeBidBelowReserve                   sdk.CodeType      = 25
+	CodeProxyBidNotSupported              sdk.CodeType      = 26
 )
 
 // ErrInvalidInitialAuctionID error for when the initial auction ID hasn't been set
@@ -96,3 +108,63 @@ func ErrCollateralAuctionIsInReversePhase(codespace sdk.CodespaceType, id uint64
 func ErrCollateralAuctionIsInForwardPhase(codespace sdk.CodespaceType, id uint64) sdk.Error {
 	return sdk.NewError(codespace, CodeCollateralAuctionIsInForwardPhase, fmt.Sprintf("invalid bid - auction %d is in forward phase", id))
 }
+
+// ErrInvalidPrice error for when a dutch auction is started with a price that is not positive
+func ErrInvalidPrice(codespace sdk.CodespaceType, price sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPrice, fmt.Sprintf("invalid auction price %s, must be positive", price))
+}
+
+// ErrPartialBidNotSupported error for when a partial bid is placed on an auction type that does not support partial fills
+func ErrPartialBidNotSupported(codespace sdk.CodespaceType, auctionType string) sdk.Error {
+	return sdk.NewError(codespace, CodePartialBidNotSupported, fmt.Sprintf("partial bids are not supported on %s auctions", auctionType))
+}
+
+// ErrAuctionNotStuck error for when governance settles or cancels an auction that has not failed to close
+func ErrAuctionNotStuck(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeAuctionNotStuck, fmt.Sprintf("auction %d has not failed to close", id))
+}
+
+// ErrInvalidStuckAuctionAction error for an unknown stuck auction proposal action
+func ErrInvalidStuckAuctionAction(codespace sdk.CodespaceType, action string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidStuckAuctionAction, fmt.Sprintf("invalid stuck auction action '%s', must be '%s' or '%s'", action, StuckAuctionSettle, StuckAuctionCancel))
+}
+
+// ErrNotSealedBidAuction error for when a sealed bid is committed or revealed on an auction that is not a sealed-bid auction
+func ErrNotSealedBidAuction(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeNotSealedBidAuction, fmt.Sprintf("auction %d is not a sealed-bid auction", id))
+}
+
+// ErrSealedBidsOnly error for when an open bid is placed on a sealed-bid auction
+func ErrSealedBidsOnly(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeSealedBidsOnly, fmt.Sprintf("auction %d is a sealed-bid auction, bids must be committed and revealed", id))
+}
+
+// ErrNotCommitPhase error for when a bid is committed after the commit phase of a sealed-bid auction has ended
+func ErrNotCommitPhase(codespace sdk.CodespaceType, id uint64, commitEndTime time.Time) sdk.Error {
+	return sdk.NewError(codespace, CodeNotCommitPhase, fmt.Sprintf("auction %d stopped accepting commitments at %v", id, commitEndTime))
+}
+
+// ErrNotRevealPhase error for when a bid is revealed before the commit phase of a sealed-bid auction has ended
+func ErrNotRevealPhase(codespace sdk.CodespaceType, id uint64, commitEndTime time.Time) sdk.Error {
+	return sdk.NewError(codespace, CodeNotRevealPhase, fmt.Sprintf("auction %d does not accept reveals until %v", id, commitEndTime))
+}
+
+// ErrCommitmentNotFound error for when a bid is revealed by a bidder without an unrevealed commitment
+func ErrCommitmentNotFound(codespace sdk.CodespaceType, id uint64, bidder sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeCommitmentNotFound, fmt.Sprintf("auction %d has no unrevealed commitment from %s", id, bidder))
+}
+
+// ErrInvalidReveal error for when a revealed bid does not match its commitment
+func ErrInvalidReveal(codespace sdk.CodespaceType, id uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidReveal, fmt.Sprintf("revealed bid does not match commitment on auction %d", id))
+}
+
+// ErrBidBelowReserve error for when a bid is below the reserve price of an auction
+func ErrBidBelowReserve(codespace sdk.CodespaceType, bid sdk.Coin, minBid sdk.Coin) sdk.Error {
+	return sdk.NewError(codespace, CodeBidBelowReserve, fmt.Sprintf("bid %s is below the auction's reserve price, must be at least %s", bid, minBid))
+}
+
+// ErrProxyBidNotSupported error for when a proxy bid is set on an auction type that does not support proxy bidding
+func ErrProxyBidNotSupported(codespace sdk.CodespaceType, auctionType string) sdk.Error {
+	return sdk.NewError(codespace, CodeProxyBidNotSupported, fmt.Sprintf("proxy bids are not supported on %s auctions", auctionType))
+}
@@ -2,9 +2,17 @@ package types
 
 // Events for auction module
 const (
-	EventTypeAuctionStart = "auction_start"
-	EventTypeAuctionBid   = "auction_bid"
-	EventTypeAuctionClose = "auction_close"
+	EventTypeAuctionStart  = "auction_start"
+	EventTypeAuctionBid    = "auction_bid"
+	EventTypeAuctionFill   = "auction_fill"
+	EventTypeAuctionCommit = "auction_commit"
+	EventTypeAuctionReveal = "auction_reveal"
+	EventTypeAuctionMaxBid = "auction_max_bid"
+	EventTypeAuctionClose  = "auction_close"
+
+	EventTypeAuctionCloseFailed = "auction_close_failed"
+	EventTypeAuctionCancel      = "auction_cancel"
+	EventTypeAuctionRelist      = "auction_relist"
 
 	AttributeValueCategory  = ModuleName
 	AttributeKeyAuctionID   = "auction_id"
@@ -14,5 +22,7 @@ const (
 	AttributeKeyLotDenom    = "lot_denom"
 	AttributeKeyBidAmount   = "bid_amount"
 	AttributeKeyLotAmount   = "lot_amount"
+	AttributeKeyMaxBid      = "max_bid"
 	AttributeKeyEndTime     = "end_time"
+	AttributeKeyError       = "error"
 )
@@ -17,3 +17,16 @@ type SupplyKeeper interface {
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
 }
+
+// AuctionHooks are called by the auction keeper for auctions started by the module that registered them
+type AuctionHooks interface {
+	AfterAuctionStarted(ctx sdk.Context, auction Auction)                                      // called once a new auction is stored
+	AfterBidPlaced(ctx sdk.Context, auction Auction, bidder sdk.AccAddress, lot, bid sdk.Coin) // called for every accepted bid, partial fill and sealed bid reveal
+	AfterAuctionClosed(ctx sdk.Context, closed ClosedAuction)                                  // called once an auction has paid out, with its final lot, bid and winner, or is cancelled
+}
+
+// ReservePricer prices the reserves of auctions started by the module that registered it, so that auctions relisted after ending
+// without a bid at their reserve price are restarted at the current market price
+type ReservePricer interface {
+	ReservePrice(ctx sdk.Context, auction Auction) (sdk.Dec, sdk.Error) // current price of one unit of the lot in units of the bid, 0 for no reserve
+}
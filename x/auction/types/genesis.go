@@ -22,17 +22,25 @@ type GenesisAuctions []GenesisAuction
 
 // GenesisState is auction state that must be provided at chain genesis.
 type GenesisState struct {
-	NextAuctionID uint64          `json:"next_auction_id" yaml:"next_auction_id"`
-	Params        Params          `json:"params" yaml:"params"`
-	Auctions      GenesisAuctions `json:"auctions" yaml:"auctions"`
+	NextAuctionID  uint64          `json:"next_auction_id" yaml:"next_auction_id"`
+	Params         Params          `json:"params" yaml:"params"`
+	Auctions       GenesisAuctions `json:"auctions" yaml:"auctions"`
+	ClosedAuctions ClosedAuctions  `json:"closed_auctions" yaml:"closed_auctions"`
+	BidHistory     AuctionBids     `json:"bid_history" yaml:"bid_history"`
+	ProxyBids      ProxyBids       `json:"proxy_bids" yaml:"proxy_bids"`
+	StuckAuctions  StuckAuctions   `json:"stuck_auctions" yaml:"stuck_auctions"`
 }
 
 // NewGenesisState returns a new genesis state object for auctions module.
-func NewGenesisState(nextID uint64, ap Params, ga GenesisAuctions) GenesisState {
+func NewGenesisState(nextID uint64, ap Params, ga GenesisAuctions, closed ClosedAuctions, bids AuctionBids, proxies ProxyBids, stuck StuckAuctions) GenesisState {
 	return GenesisState{
-		NextAuctionID: nextID,
-		Params:        ap,
-		Auctions:      ga,
+		NextAuctionID:  nextID,
+		Params:         ap,
+		Auctions:       ga,
+		ClosedAuctions: closed,
+		BidHistory:     bids,
+		ProxyBids:      proxies,
+		StuckAuctions:  stuck,
 	}
 }
 
@@ -42,6 +50,10 @@ func DefaultGenesisState() GenesisState {
 		DefaultNextAuctionID,
 		DefaultParams(),
 		GenesisAuctions{},
+		ClosedAuctions{},
+		AuctionBids{},
+		ProxyBids{},
+		StuckAuctions{},
 	)
 }
 
@@ -79,5 +91,57 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("found auction ID >= the nextAuctionID (%d >= %d)", a.GetID(), gs.NextAuctionID)
 		}
 	}
+
+	for _, ca := range gs.ClosedAuctions {
+		if ids[ca.ID] {
+			return fmt.Errorf("found duplicate auction ID (%d) in closed auctions", ca.ID)
+		}
+		ids[ca.ID] = true
+
+		if ca.ID >= gs.NextAuctionID {
+			return fmt.Errorf("found closed auction ID >= the nextAuctionID (%d >= %d)", ca.ID, gs.NextAuctionID)
+		}
+	}
+
+	openIDs := map[uint64]bool{}
+	for _, a := range gs.Auctions {
+		openIDs[a.GetID()] = true
+	}
+	proxies := map[string]bool{}
+	for _, pb := range gs.ProxyBids {
+		if err := pb.Validate(); err != nil {
+			return fmt.Errorf("found invalid proxy bid: %w", err)
+		}
+		if !openIDs[pb.AuctionID] {
+			return fmt.Errorf("found proxy bid for unknown auction ID (%d)", pb.AuctionID)
+		}
+		key := string(GetProxyBidKey(pb.AuctionID, pb.Bidder))
+		if proxies[key] {
+			return fmt.Errorf("found duplicate proxy bid by %s on auction ID (%d)", pb.Bidder, pb.AuctionID)
+		}
+		proxies[key] = true
+	}
+
+	stuckIDs := map[uint64]bool{}
+	for _, sa := range gs.StuckAuctions {
+		if !openIDs[sa.AuctionID] {
+			return fmt.Errorf("found stuck record for unknown auction ID (%d)", sa.AuctionID)
+		}
+		if stuckIDs[sa.AuctionID] {
+			return fmt.Errorf("found duplicate stuck record for auction ID (%d)", sa.AuctionID)
+		}
+		stuckIDs[sa.AuctionID] = true
+	}
+
+	sequences := map[uint64]bool{}
+	for _, b := range gs.BidHistory {
+		if !ids[b.AuctionID] {
+			return fmt.Errorf("found bid history for unknown auction ID (%d)", b.AuctionID)
+		}
+		if sequences[b.Sequence] {
+			return fmt.Errorf("found duplicate bid history sequence (%d)", b.Sequence)
+		}
+		sequences[b.Sequence] = true
+	}
 	return nil
 }
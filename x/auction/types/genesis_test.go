@@ -7,31 +7,65 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var testCoin = sdk.NewInt64Coin("test", 20)
+var (
+	testCoin = sdk.NewInt64Coin("test", 20)
+	testAddr = sdk.AccAddress([]byte("testAddr"))
+)
 
 func TestGenesisState_Validate(t *testing.T) {
 	testCases := []struct {
 		name       string
 		nextID     uint64
 		auctions   GenesisAuctions
+		closed     ClosedAuctions
+		bids       AuctionBids
+		proxies    ProxyBids
 		expectPass bool
 	}{
-		{"default", DefaultGenesisState().NextAuctionID, DefaultGenesisState().Auctions, true},
-		{"invalid next ID", 54, GenesisAuctions{SurplusAuction{BaseAuction{ID: 105}}}, false},
+		{"default", DefaultGenesisState().NextAuctionID, DefaultGenesisState().Auctions, ClosedAuctions{}, AuctionBids{}, ProxyBids{}, true},
+		{"invalid next ID", 54, GenesisAuctions{SurplusAuction{BaseAuction: BaseAuction{ID: 105}}}, nil, nil, nil, false},
 		{
 			"repeated ID",
 			1000,
 			GenesisAuctions{
-				SurplusAuction{BaseAuction{ID: 105}},
+				SurplusAuction{BaseAuction: BaseAuction{ID: 105}},
 				DebtAuction{BaseAuction{ID: 105}, testCoin},
 			},
+			nil,
+			nil,
+			nil,
+			false,
+		},
+		{
+			"archive",
+			1000,
+			GenesisAuctions{SurplusAuction{BaseAuction: BaseAuction{ID: 105}}},
+			ClosedAuctions{{ID: 104}},
+			AuctionBids{{AuctionID: 104, Sequence: 0}, {AuctionID: 105, Sequence: 1}},
+			ProxyBids{NewProxyBid(105, testAddr, testCoin, testCoin)},
+			true,
+		},
+		{"closed auction ID repeats auction ID", 1000, GenesisAuctions{SurplusAuction{BaseAuction: BaseAuction{ID: 105}}}, ClosedAuctions{{ID: 105}}, nil, nil, false},
+		{"repeated closed auction ID", 1000, nil, ClosedAuctions{{ID: 104}, {ID: 104}}, nil, nil, false},
+		{"invalid closed auction ID", 54, nil, ClosedAuctions{{ID: 104}}, nil, nil, false},
+		{"bid for unknown auction", 1000, nil, ClosedAuctions{{ID: 104}}, AuctionBids{{AuctionID: 103}}, nil, false},
+		{"repeated bid sequence", 1000, nil, ClosedAuctions{{ID: 104}}, AuctionBids{{AuctionID: 104, Sequence: 3}, {AuctionID: 104, Sequence: 3}}, nil, false},
+		{"proxy bid for closed auction", 1000, nil, ClosedAuctions{{ID: 104}}, nil, ProxyBids{NewProxyBid(104, testAddr, testCoin, testCoin)}, false},
+		{
+			"repeated proxy bid",
+			1000,
+			GenesisAuctions{SurplusAuction{BaseAuction: BaseAuction{ID: 105}}},
+			nil,
+			nil,
+			ProxyBids{NewProxyBid(105, testAddr, testCoin, testCoin), NewProxyBid(105, testAddr, testCoin, testCoin)},
 			false,
 		},
+		{"invalid proxy bid", 1000, GenesisAuctions{SurplusAuction{BaseAuction: BaseAuction{ID: 105}}}, nil, nil, ProxyBids{NewProxyBid(105, nil, testCoin, testCoin)}, false},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			gs := NewGenesisState(tc.nextID, DefaultParams(), tc.auctions)
+			gs := NewGenesisState(tc.nextID, DefaultParams(), tc.auctions, tc.closed, tc.bids, tc.proxies, StuckAuctions{})
 
 			err := gs.Validate()
 
@@ -44,3 +78,30 @@ func TestGenesisState_Validate(t *testing.T) {
 	}
 
 }
+
+func TestGenesisState_ValidateStuckAuctions(t *testing.T) {
+	auctions := GenesisAuctions{SurplusAuction{BaseAuction: BaseAuction{ID: 105}}}
+	testCases := []struct {
+		name       string
+		stuck      StuckAuctions
+		expectPass bool
+	}{
+		{"valid", StuckAuctions{{AuctionID: 105, Attempts: 1}}, true},
+		{"stuck record for unknown auction", StuckAuctions{{AuctionID: 104, Attempts: 1}}, false},
+		{"repeated stuck record", StuckAuctions{{AuctionID: 105, Attempts: 1}, {AuctionID: 105, Attempts: 2}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gs := NewGenesisState(1000, DefaultParams(), auctions, nil, nil, nil, tc.stuck)
+
+			err := gs.Validate()
+
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
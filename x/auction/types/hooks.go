@@ -0,0 +1,36 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ AuctionHooks = MultiAuctionHooks{}
+
+// MultiAuctionHooks combines multiple auction hooks, all hook functions are run in array sequence
+type MultiAuctionHooks []AuctionHooks
+
+// NewMultiAuctionHooks returns a new MultiAuctionHooks
+func NewMultiAuctionHooks(hooks ...AuctionHooks) MultiAuctionHooks {
+	return hooks
+}
+
+// AfterAuctionStarted runs the AfterAuctionStarted hook of each of the hooks
+func (h MultiAuctionHooks) AfterAuctionStarted(ctx sdk.Context, auction Auction) {
+	for i := range h {
+		h[i].AfterAuctionStarted(ctx, auction)
+	}
+}
+
+// AfterBidPlaced runs the AfterBidPlaced hook of each of the hooks
+func (h MultiAuctionHooks) AfterBidPlaced(ctx sdk.Context, auction Auction, bidder sdk.AccAddress, lot, bid sdk.Coin) {
+	for i := range h {
+		h[i].AfterBidPlaced(ctx, auction, bidder, lot, bid)
+	}
+}
+
+// AfterAuctionClosed runs the AfterAuctionClosed hook of each of the hooks
+func (h MultiAuctionHooks) AfterAuctionClosed(ctx sdk.Context, closed ClosedAuction) {
+	for i := range h {
+		h[i].AfterAuctionClosed(ctx, closed)
+	}
+}
@@ -30,6 +30,15 @@ var (
 	AuctionByTimeKeyPrefix = []byte{0x01} // prefix for keys that are part of the auctionsByTime index
 
 	NextAuctionIDKey = []byte{0x02} // key for the next auction id
+
+	StuckAuctionKeyPrefix = []byte{0x03} // prefix for keys that store auctions that failed to close
+
+	ClosedAuctionKeyPrefix = []byte{0x04} // prefix for keys that store the archive of closed auctions
+	ClosedByTimeKeyPrefix  = []byte{0x05} // prefix for keys that are part of the index of closed auctions by close time, used to prune the archive
+	AuctionBidKeyPrefix    = []byte{0x06} // prefix for keys that store the bid history of auctions
+	NextBidSequenceKey     = []byte{0x07} // key for the sequence of the next bid history entry
+
+	ProxyBidKeyPrefix = []byte{0x08} // prefix for keys that store the proxy bids on auctions
 )
 
 // GetAuctionKey returns the bytes of an auction key
@@ -42,6 +51,16 @@ func GetAuctionByTimeKey(endTime time.Time, auctionID uint64) []byte {
 	return append(sdk.FormatTimeBytes(endTime), Uint64ToBytes(auctionID)...)
 }
 
+// GetAuctionBidKey returns the key of an entry in the bid history of an auction
+func GetAuctionBidKey(auctionID, sequence uint64) []byte {
+	return append(Uint64ToBytes(auctionID), Uint64ToBytes(sequence)...)
+}
+
+// GetProxyBidKey returns the key of a bidder's proxy bid on an auction
+func GetProxyBidKey(auctionID uint64, bidder sdk.AccAddress) []byte {
+	return append(Uint64ToBytes(auctionID), bidder...)
+}
+
 // Uint64ToBytes converts a uint64 into fixed length bytes for use in store keys.
 func Uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
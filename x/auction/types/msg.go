@@ -1,12 +1,20 @@
 package types
 
 import (
+	"crypto/sha256"
 	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ensure Msg interface compliance at compile time
-var _ sdk.Msg = &MsgPlaceBid{}
+var (
+	_ sdk.Msg = &MsgPlaceBid{}
+	_ sdk.Msg = &MsgPlacePartialBid{}
+	_ sdk.Msg = &MsgCommitBid{}
+	_ sdk.Msg = &MsgRevealBid{}
+	_ sdk.Msg = &MsgSetMaxBid{}
+)
 
 // MsgPlaceBid is the message type used to place a bid on any type of auction.
 type MsgPlaceBid struct {
@@ -51,3 +59,184 @@ func (msg MsgPlaceBid) GetSignBytes() []byte {
 func (msg MsgPlaceBid) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Bidder}
 }
+
+// MsgPlacePartialBid is the message type used to buy part of the lot of a collateral or dutch auction at the current price.
+type MsgPlacePartialBid struct {
+	AuctionID uint64         `json:"auction_id" yaml:"auction_id"`
+	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Lot       sdk.Coin       `json:"lot" yaml:"lot"` // The amount of the lot to buy.
+}
+
+// NewMsgPlacePartialBid returns a new MsgPlacePartialBid.
+func NewMsgPlacePartialBid(auctionID uint64, bidder sdk.AccAddress, lot sdk.Coin) MsgPlacePartialBid {
+	return MsgPlacePartialBid{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		Lot:       lot,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgPlacePartialBid) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgPlacePartialBid) Type() string { return "place_partial_bid" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgPlacePartialBid) ValidateBasic() sdk.Error {
+	if msg.Bidder.Empty() {
+		return sdk.ErrInvalidAddress("invalid (empty) bidder address")
+	}
+	if !msg.Lot.IsValid() || !msg.Lot.IsPositive() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid lot amount: %s", msg.Lot))
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgPlacePartialBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgPlacePartialBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// MsgCommitBid is the message type used to commit to a hidden bid on a sealed-bid auction.
+type MsgCommitBid struct {
+	AuctionID  uint64         `json:"auction_id" yaml:"auction_id"`
+	Bidder     sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Commitment []byte         `json:"commitment" yaml:"commitment"` // The hash of the bid, see SealedBidCommitment.
+}
+
+// NewMsgCommitBid returns a new MsgCommitBid.
+func NewMsgCommitBid(auctionID uint64, bidder sdk.AccAddress, commitment []byte) MsgCommitBid {
+	return MsgCommitBid{
+		AuctionID:  auctionID,
+		Bidder:     bidder,
+		Commitment: commitment,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgCommitBid) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgCommitBid) Type() string { return "commit_bid" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgCommitBid) ValidateBasic() sdk.Error {
+	if msg.Bidder.Empty() {
+		return sdk.ErrInvalidAddress("invalid (empty) bidder address")
+	}
+	if len(msg.Commitment) != sha256.Size {
+		return sdk.ErrUnknownRequest(fmt.Sprintf("invalid commitment length %d, must be %d", len(msg.Commitment), sha256.Size))
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgCommitBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgCommitBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// MsgRevealBid is the message type used to reveal a committed bid on a sealed-bid auction.
+type MsgRevealBid struct {
+	AuctionID uint64         `json:"auction_id" yaml:"auction_id"`
+	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	Amount    sdk.Coin       `json:"amount" yaml:"amount"` // The bid (surplus auctions) or lot (debt auctions) committed to.
+	Salt      string         `json:"salt" yaml:"salt"`     // The secret included in the commitment.
+}
+
+// NewMsgRevealBid returns a new MsgRevealBid.
+func NewMsgRevealBid(auctionID uint64, bidder sdk.AccAddress, amt sdk.Coin, salt string) MsgRevealBid {
+	return MsgRevealBid{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		Amount:    amt,
+		Salt:      salt,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRevealBid) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRevealBid) Type() string { return "reveal_bid" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgRevealBid) ValidateBasic() sdk.Error {
+	if msg.Bidder.Empty() {
+		return sdk.ErrInvalidAddress("invalid (empty) bidder address")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid bid amount: %s", msg.Amount))
+	}
+	if msg.Salt == "" {
+		return sdk.ErrUnknownRequest("invalid (empty) salt")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRevealBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRevealBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
+
+// MsgSetMaxBid is the message type used to set a proxy bid, which outbids other bidders on an English auction by the minimum increment up to a limit.
+type MsgSetMaxBid struct {
+	AuctionID uint64         `json:"auction_id" yaml:"auction_id"`
+	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	MaxBid    sdk.Coin       `json:"max_bid" yaml:"max_bid"` // The highest bid, or in reverse phase the lowest lot, to bid. See ProxyBid.
+}
+
+// NewMsgSetMaxBid returns a new MsgSetMaxBid.
+func NewMsgSetMaxBid(auctionID uint64, bidder sdk.AccAddress, maxBid sdk.Coin) MsgSetMaxBid {
+	return MsgSetMaxBid{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		MaxBid:    maxBid,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgSetMaxBid) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgSetMaxBid) Type() string { return "set_max_bid" }
+
+// ValidateBasic does a simple validation check that doesn't require access to state.
+func (msg MsgSetMaxBid) ValidateBasic() sdk.Error {
+	if msg.Bidder.Empty() {
+		return sdk.ErrInvalidAddress("invalid (empty) bidder address")
+	}
+	if !msg.MaxBid.IsValid() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid max bid amount: %s", msg.MaxBid))
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgSetMaxBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgSetMaxBid) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Bidder}
+}
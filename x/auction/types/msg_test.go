@@ -39,4 +39,133 @@ func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgPlacePartialBid_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	tests := []struct {
+		name       string
+		msg        MsgPlacePartialBid
+		expectPass bool
+	}{
+		{"normal",
+			NewMsgPlacePartialBid(0, addr, c("token", 10)),
+			true},
+		{"emptyAddr",
+			NewMsgPlacePartialBid(0, sdk.AccAddress{}, c("token", 10)),
+			false},
+		{"negativeAmount",
+			NewMsgPlacePartialBid(0, addr, sdk.Coin{Denom: "token", Amount: sdk.NewInt(-10)}),
+			false},
+		{"zeroAmount",
+			NewMsgPlacePartialBid(0, addr, c("token", 0)),
+			false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.NoError(t, tc.msg.ValidateBasic())
+			} else {
+				require.Error(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgCommitBid_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	commitment := SealedBidCommitment(0, addr, c("token", 10), "salt")
+	tests := []struct {
+		name       string
+		msg        MsgCommitBid
+		expectPass bool
+	}{
+		{"normal",
+			NewMsgCommitBid(0, addr, commitment),
+			true},
+		{"emptyAddr",
+			NewMsgCommitBid(0, sdk.AccAddress{}, commitment),
+			false},
+		{"shortCommitment",
+			NewMsgCommitBid(0, addr, commitment[:16]),
+			false},
+		{"emptyCommitment",
+			NewMsgCommitBid(0, addr, nil),
+			false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.NoError(t, tc.msg.ValidateBasic())
+			} else {
+				require.Error(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgRevealBid_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	tests := []struct {
+		name       string
+		msg        MsgRevealBid
+		expectPass bool
+	}{
+		{"normal",
+			NewMsgRevealBid(0, addr, c("token", 10), "salt"),
+			true},
+		{"emptyAddr",
+			NewMsgRevealBid(0, sdk.AccAddress{}, c("token", 10), "salt"),
+			false},
+		{"zeroAmount",
+			NewMsgRevealBid(0, addr, c("token", 0), "salt"),
+			false},
+		{"emptySalt",
+			NewMsgRevealBid(0, addr, c("token", 10), ""),
+			false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.NoError(t, tc.msg.ValidateBasic())
+			} else {
+				require.Error(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
+
+func TestMsgSetMaxBid_ValidateBasic(t *testing.T) {
+	addr := sdk.AccAddress([]byte("someName"))
+	tests := []struct {
+		name       string
+		msg        MsgSetMaxBid
+		expectPass bool
+	}{
+		{"normal",
+			NewMsgSetMaxBid(0, addr, c("token", 10)),
+			true},
+		{"emptyAddr",
+			NewMsgSetMaxBid(0, sdk.AccAddress{}, c("token", 10)),
+			false},
+		{"negativeAmount",
+			NewMsgSetMaxBid(0, addr, sdk.Coin{Denom: "token", Amount: sdk.NewInt(-10)}),
+			false},
+		{"zeroAmount",
+			NewMsgSetMaxBid(0, addr, c("token", 0)),
+			true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.NoError(t, tc.msg.ValidateBasic())
+			} else {
+				require.Error(t, tc.msg.ValidateBasic())
+			}
+		})
+	}
+}
+
 func c(denom string, amount int64) sdk.Coin { return sdk.NewInt64Coin(denom, amount) }
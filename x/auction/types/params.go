@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"time"
 
@@ -15,38 +16,101 @@ const (
 	DefaultMaxAuctionDuration time.Duration = 2 * 24 * time.Hour
 	// DefaultBidDuration how long an auction gets extended when someone bids
 	DefaultBidDuration time.Duration = 1 * time.Hour
+	// DefaultDutchDecayInterval how often the price of a dutch auction decreases
+	DefaultDutchDecayInterval time.Duration = 10 * time.Minute
+	// DefaultCommitDuration how long bids can be committed to sealed-bid auctions
+	DefaultCommitDuration time.Duration = 24 * time.Hour
+	// DefaultRevealDuration how long bids can be revealed on sealed-bid auctions after commits close
+	DefaultRevealDuration time.Duration = 6 * time.Hour
+	// DefaultSealedBidPricing how the winners of sealed-bid auctions pay
+	DefaultSealedBidPricing = SealedBidSecondPrice
+	// DefaultReserveRelists how many times auctions ending below their reserve price are restarted
+	DefaultReserveRelists uint64 = 1
+	// DefaultArchiveRetention how long closed auctions and their bid history are kept
+	DefaultArchiveRetention time.Duration = 0
 )
 
 var (
 	// DefaultIncrement is the smallest percent change a new bid must have from the old one
 	DefaultIncrement sdk.Dec = sdk.MustNewDecFromStr("0.05")
+	// DefaultDutchBuffer is the multiple of the market price that dutch auctions start at
+	DefaultDutchBuffer sdk.Dec = sdk.MustNewDecFromStr("1.2")
+	// DefaultDutchDecay is the fraction of the price of a dutch auction removed every decay interval
+	DefaultDutchDecay sdk.Dec = sdk.MustNewDecFromStr("0.01")
+	// DefaultDutchMinPrice is zero, so the price of dutch auctions decays without a floor
+	DefaultDutchMinPrice sdk.Dec = sdk.ZeroDec()
+	// DefaultBidDeposit is the deposit for a sealed bid, as a fraction of the lot (surplus) or bid (debt) of the auction
+	DefaultBidDeposit sdk.Dec = sdk.MustNewDecFromStr("0.01")
+	// DefaultOverrides is empty, so all auctions use the global durations and increments
+	DefaultOverrides ParamOverrides = nil
 	// ParamStoreKeyParams Param store key for auction params
 	KeyBidDuration         = []byte("BidDuration")
 	KeyMaxAuctionDuration  = []byte("MaxAuctionDuration")
 	KeyIncrementSurplus    = []byte("IncrementSurplus")
 	KeyIncrementDebt       = []byte("IncrementDebt")
 	KeyIncrementCollateral = []byte("IncrementCollateral")
+	KeyDutchPriceBuffer    = []byte("DutchPriceBuffer")
+	KeyDutchPriceDecay     = []byte("DutchPriceDecay")
+	KeyDutchDecayInterval  = []byte("DutchDecayInterval")
+	KeyDutchMinPrice       = []byte("DutchMinPrice")
+	KeySealedBidSurplus    = []byte("SealedBidSurplus")
+	KeySealedBidDebt       = []byte("SealedBidDebt")
+	KeySealedBidPricing    = []byte("SealedBidPricing")
+	KeyCommitDuration      = []byte("CommitDuration")
+	KeyRevealDuration      = []byte("RevealDuration")
+	KeyBidDeposit          = []byte("BidDeposit")
+	KeyReserveRelists      = []byte("ReserveRelists")
+	KeyArchiveRetention    = []byte("ArchiveRetention")
+	KeyOverrides           = []byte("Overrides")
 )
 
 var _ subspace.ParamSet = &Params{}
 
 // Params is the governance parameters for the auction module.
 type Params struct {
-	MaxAuctionDuration  time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"` // max length of auction
-	BidDuration         time.Duration `json:"bid_duration" yaml:"bid_duration"`                 // additional time added to the auction end time after each bid, capped by the expiry.
-	IncrementSurplus    sdk.Dec       `json:"increment_surplus" yaml:"increment_surplus"`       // percentage change (of auc.Bid) required for a new bid on a surplus auction
-	IncrementDebt       sdk.Dec       `json:"increment_debt" yaml:"increment_debt"`             // percentage change (of auc.Lot) required for a new bid on a debt auction
-	IncrementCollateral sdk.Dec       `json:"increment_collateral" yaml:"increment_collateral"` // percentage change (of auc.Bid or auc.Lot) required for a new bid on a collateral auction
+	MaxAuctionDuration  time.Duration  `json:"max_auction_duration" yaml:"max_auction_duration"` // max length of auction
+	BidDuration         time.Duration  `json:"bid_duration" yaml:"bid_duration"`                 // additional time added to the auction end time after each bid, capped by the expiry.
+	IncrementSurplus    sdk.Dec        `json:"increment_surplus" yaml:"increment_surplus"`       // percentage change (of auc.Bid) required for a new bid on a surplus auction
+	IncrementDebt       sdk.Dec        `json:"increment_debt" yaml:"increment_debt"`             // percentage change (of auc.Lot) required for a new bid on a debt auction
+	IncrementCollateral sdk.Dec        `json:"increment_collateral" yaml:"increment_collateral"` // percentage change (of auc.Bid or auc.Lot) required for a new bid on a collateral auction
+	DutchPriceBuffer    sdk.Dec        `json:"dutch_price_buffer" yaml:"dutch_price_buffer"`     // multiple of the market price of the lot that dutch auctions start at
+	DutchPriceDecay     sdk.Dec        `json:"dutch_price_decay" yaml:"dutch_price_decay"`       // fraction of the price of a dutch auction removed every DutchDecayInterval
+	DutchDecayInterval  time.Duration  `json:"dutch_decay_interval" yaml:"dutch_decay_interval"` // time between price decreases of a dutch auction
+	DutchMinPrice       sdk.Dec        `json:"dutch_min_price" yaml:"dutch_min_price"`           // fraction of the start price of a dutch auction below which its price stops decaying, 0 for no floor
+	SealedBidSurplus    bool           `json:"sealed_bid_surplus" yaml:"sealed_bid_surplus"`     // start surplus auctions as sealed-bid auctions
+	SealedBidDebt       bool           `json:"sealed_bid_debt" yaml:"sealed_bid_debt"`           // start debt auctions as sealed-bid auctions
+	SealedBidPricing    string         `json:"sealed_bid_pricing" yaml:"sealed_bid_pricing"`     // "first-price" or "second-price", what the winner of a sealed-bid auction pays
+	CommitDuration      time.Duration  `json:"commit_duration" yaml:"commit_duration"`           // length of the commit phase of a sealed-bid auction
+	RevealDuration      time.Duration  `json:"reveal_duration" yaml:"reveal_duration"`           // length of the reveal phase of a sealed-bid auction
+	BidDeposit          sdk.Dec        `json:"bid_deposit" yaml:"bid_deposit"`                   // deposit for a sealed bid, as a fraction of the lot (surplus) or bid (debt) of the auction
+	ReserveRelists      uint64         `json:"reserve_relists" yaml:"reserve_relists"`           // times an auction ending without a bid at its reserve price is restarted before its lot is returned
+	ArchiveRetention    time.Duration  `json:"archive_retention" yaml:"archive_retention"`       // how long closed auctions and their bid history are kept, 0 to disable the archive
+	Overrides           ParamOverrides `json:"overrides" yaml:"overrides"`                       // durations and increments for auctions of a type or lot denom, replacing the values above
 }
 
 // NewParams returns a new Params object.
-func NewParams(maxAuctionDuration, bidDuration time.Duration, incrementSurplus, incrementDebt, incrementCollateral sdk.Dec) Params {
+func NewParams(maxAuctionDuration, bidDuration time.Duration, incrementSurplus, incrementDebt, incrementCollateral, dutchPriceBuffer, dutchPriceDecay sdk.Dec, dutchDecayInterval time.Duration, dutchMinPrice sdk.Dec,
+	sealedBidSurplus, sealedBidDebt bool, sealedBidPricing string, commitDuration, revealDuration time.Duration, bidDeposit sdk.Dec,
+	reserveRelists uint64, archiveRetention time.Duration, overrides ParamOverrides) Params {
 	return Params{
 		MaxAuctionDuration:  maxAuctionDuration,
 		BidDuration:         bidDuration,
 		IncrementSurplus:    incrementSurplus,
 		IncrementDebt:       incrementDebt,
 		IncrementCollateral: incrementCollateral,
+		DutchPriceBuffer:    dutchPriceBuffer,
+		DutchPriceDecay:     dutchPriceDecay,
+		DutchDecayInterval:  dutchDecayInterval,
+		DutchMinPrice:       dutchMinPrice,
+		SealedBidSurplus:    sealedBidSurplus,
+		SealedBidDebt:       sealedBidDebt,
+		SealedBidPricing:    sealedBidPricing,
+		CommitDuration:      commitDuration,
+		RevealDuration:      revealDuration,
+		BidDeposit:          bidDeposit,
+		ReserveRelists:      reserveRelists,
+		ArchiveRetention:    archiveRetention,
+		Overrides:           overrides,
 	}
 }
 
@@ -58,6 +122,19 @@ func DefaultParams() Params {
 		DefaultIncrement,
 		DefaultIncrement,
 		DefaultIncrement,
+		DefaultDutchBuffer,
+		DefaultDutchDecay,
+		DefaultDutchDecayInterval,
+		DefaultDutchMinPrice,
+		false,
+		false,
+		DefaultSealedBidPricing,
+		DefaultCommitDuration,
+		DefaultRevealDuration,
+		DefaultBidDeposit,
+		DefaultReserveRelists,
+		DefaultArchiveRetention,
+		DefaultOverrides,
 	)
 }
 
@@ -74,6 +151,19 @@ func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 		{Key: KeyIncrementSurplus, Value: &p.IncrementSurplus},
 		{Key: KeyIncrementDebt, Value: &p.IncrementDebt},
 		{Key: KeyIncrementCollateral, Value: &p.IncrementCollateral},
+		{Key: KeyDutchPriceBuffer, Value: &p.DutchPriceBuffer},
+		{Key: KeyDutchPriceDecay, Value: &p.DutchPriceDecay},
+		{Key: KeyDutchDecayInterval, Value: &p.DutchDecayInterval},
+		{Key: KeyDutchMinPrice, Value: &p.DutchMinPrice},
+		{Key: KeySealedBidSurplus, Value: &p.SealedBidSurplus},
+		{Key: KeySealedBidDebt, Value: &p.SealedBidDebt},
+		{Key: KeySealedBidPricing, Value: &p.SealedBidPricing},
+		{Key: KeyCommitDuration, Value: &p.CommitDuration},
+		{Key: KeyRevealDuration, Value: &p.RevealDuration},
+		{Key: KeyBidDeposit, Value: &p.BidDeposit},
+		{Key: KeyReserveRelists, Value: &p.ReserveRelists},
+		{Key: KeyArchiveRetention, Value: &p.ArchiveRetention},
+		{Key: KeyOverrides, Value: &p.Overrides},
 	}
 }
 
@@ -91,8 +181,24 @@ func (p Params) String() string {
 	Bid Duration: %s
 	Increment Surplus: %s
 	Increment Debt: %s
-	Increment Collateral: %s`,
-		p.MaxAuctionDuration, p.BidDuration, p.IncrementSurplus, p.IncrementDebt, p.IncrementCollateral)
+	Increment Collateral: %s
+	Dutch Price Buffer: %s
+	Dutch Price Decay: %s
+	Dutch Decay Interval: %s
+	Dutch Min Price: %s
+	Sealed Bid Surplus: %t
+	Sealed Bid Debt: %t
+	Sealed Bid Pricing: %s
+	Commit Duration: %s
+	Reveal Duration: %s
+	Bid Deposit: %s
+	Reserve Relists: %d
+	Archive Retention: %s
+	Overrides: %s`,
+		p.MaxAuctionDuration, p.BidDuration, p.IncrementSurplus, p.IncrementDebt, p.IncrementCollateral,
+		p.DutchPriceBuffer, p.DutchPriceDecay, p.DutchDecayInterval, p.DutchMinPrice,
+		p.SealedBidSurplus, p.SealedBidDebt, p.SealedBidPricing, p.CommitDuration, p.RevealDuration, p.BidDeposit,
+		p.ReserveRelists, p.ArchiveRetention, p.Overrides)
 }
 
 // Validate checks that the parameters have valid values.
@@ -103,6 +209,9 @@ func (p Params) Validate() error {
 	if p.MaxAuctionDuration < 0 {
 		return sdk.ErrInternal("max auction duration cannot be negative")
 	}
+	if p.ArchiveRetention < 0 {
+		return sdk.ErrInternal("archive retention cannot be negative")
+	}
 	if p.BidDuration > p.MaxAuctionDuration {
 		return sdk.ErrInternal("bid duration param cannot be larger than max auction duration")
 	}
@@ -118,5 +227,192 @@ func (p Params) Validate() error {
 	if p.IncrementCollateral.IsNegative() {
 		return sdk.ErrInternal("collateral auction increment cannot be less than zero")
 	}
+	if p.DutchPriceBuffer == (sdk.Dec{}) || p.DutchPriceDecay == (sdk.Dec{}) {
+		return sdk.ErrInternal("dutch auction price values cannot be nil")
+	}
+	if p.DutchPriceBuffer.LT(sdk.OneDec()) {
+		return sdk.ErrInternal("dutch auction price buffer cannot be less than one")
+	}
+	if p.DutchPriceDecay.IsNegative() || p.DutchPriceDecay.GTE(sdk.OneDec()) {
+		return sdk.ErrInternal("dutch auction price decay must be between zero and one")
+	}
+	if p.DutchDecayInterval <= 0 {
+		return sdk.ErrInternal("dutch auction decay interval must be positive")
+	}
+	if p.DutchMinPrice == (sdk.Dec{}) || p.DutchMinPrice.IsNegative() || p.DutchMinPrice.GTE(sdk.OneDec()) {
+		return sdk.ErrInternal("dutch auction min price must be between zero and one")
+	}
+	if p.SealedBidPricing != SealedBidFirstPrice && p.SealedBidPricing != SealedBidSecondPrice {
+		return sdk.ErrInternal(fmt.Sprintf("sealed-bid auction pricing must be '%s' or '%s'", SealedBidFirstPrice, SealedBidSecondPrice))
+	}
+	if p.CommitDuration <= 0 || p.RevealDuration <= 0 {
+		return sdk.ErrInternal("sealed-bid auction commit and reveal durations must be positive")
+	}
+	if p.BidDeposit == (sdk.Dec{}) || p.BidDeposit.IsNegative() {
+		return sdk.ErrInternal("sealed-bid auction deposit cannot be nil or less than zero")
+	}
+	return p.validateOverrides()
+}
+
+// validateOverrides checks each override is valid and unique, and that every auction type and lot denom they apply to
+// ends up with a bid duration no larger than its max auction duration.
+func (p Params) validateOverrides() error {
+	seen := make(map[string]bool)
+	for _, o := range p.Overrides {
+		if err := o.Validate(); err != nil {
+			return sdk.ErrInternal(err.Error())
+		}
+		key := o.AuctionType + "/" + o.LotDenom
+		if seen[key] {
+			return sdk.ErrInternal(fmt.Sprintf("duplicate param override for auction type '%s' and lot denom '%s'", o.AuctionType, o.LotDenom))
+		}
+		seen[key] = true
+	}
+	for _, o := range p.Overrides {
+		auctionTypes := []string{o.AuctionType}
+		if o.AuctionType == "" {
+			auctionTypes = []string{AuctionTypeSurplus, AuctionTypeDebt, AuctionTypeCollateral}
+		}
+		for _, auctionType := range auctionTypes {
+			if auctionType == AuctionTypeDutch {
+				continue
+			}
+			ap := p.ForAuction(auctionType, o.LotDenom)
+			if ap.BidDuration > ap.MaxAuctionDuration {
+				return sdk.ErrInternal(fmt.Sprintf("bid duration cannot be larger than max auction duration for %s auctions of '%s'", auctionType, o.LotDenom))
+			}
+		}
+	}
+	return nil
+}
+
+// ForAuction returns the durations and bid increment for an auction of a type selling a lot denom. Overrides matching
+// both the auction type and lot denom take precedence over those matching only the lot denom, which take precedence over
+// those matching only the auction type. Values not set by any matching override fall back to the global params.
+func (p Params) ForAuction(auctionType, lotDenom string) AuctionParams {
+	ap := AuctionParams{
+		MaxAuctionDuration: p.MaxAuctionDuration,
+		BidDuration:        p.BidDuration,
+		Increment:          p.increment(auctionType),
+	}
+	for _, match := range [][2]string{{auctionType, ""}, {"", lotDenom}, {auctionType, lotDenom}} {
+		if o, found := p.Overrides.find(match[0], match[1]); found {
+			ap = o.apply(ap)
+		}
+	}
+	return ap
+}
+
+// increment returns the global bid increment for an auction type, zero for types without bidding increments.
+func (p Params) increment(auctionType string) sdk.Dec {
+	switch auctionType {
+	case AuctionTypeSurplus:
+		return p.IncrementSurplus
+	case AuctionTypeDebt:
+		return p.IncrementDebt
+	case AuctionTypeCollateral:
+		return p.IncrementCollateral
+	default:
+		return sdk.ZeroDec()
+	}
+}
+
+// AuctionParams are the durations and bid increment that apply to a single auction, after overrides.
+type AuctionParams struct {
+	MaxAuctionDuration time.Duration
+	BidDuration        time.Duration
+	Increment          sdk.Dec
+}
+
+// ParamOverride replaces the durations and bid increment of auctions of a type, auctions selling a lot denom, or both.
+// Zero values are not overridden. Dutch auctions only use the max auction duration.
+type ParamOverride struct {
+	AuctionType        string        `json:"auction_type" yaml:"auction_type"`                 // "surplus", "debt", "collateral" or "dutch", empty for any type
+	LotDenom           string        `json:"lot_denom" yaml:"lot_denom"`                       // denom of the auction lot, empty for any denom
+	MaxAuctionDuration time.Duration `json:"max_auction_duration" yaml:"max_auction_duration"` // max length of matching auctions
+	BidDuration        time.Duration `json:"bid_duration" yaml:"bid_duration"`                 // time added to the end time of matching auctions after each bid
+	Increment          sdk.Dec       `json:"increment" yaml:"increment"`                       // percentage change required for a new bid on matching auctions
+}
+
+// NewParamOverride returns a new ParamOverride
+func NewParamOverride(auctionType, lotDenom string, maxAuctionDuration, bidDuration time.Duration, increment sdk.Dec) ParamOverride {
+	return ParamOverride{
+		AuctionType:        auctionType,
+		LotDenom:           lotDenom,
+		MaxAuctionDuration: maxAuctionDuration,
+		BidDuration:        bidDuration,
+		Increment:          increment,
+	}
+}
+
+// Validate performs a basic validation of the override fields.
+func (o ParamOverride) Validate() error {
+	switch o.AuctionType {
+	case "", AuctionTypeSurplus, AuctionTypeDebt, AuctionTypeCollateral, AuctionTypeDutch:
+	default:
+		return fmt.Errorf("invalid param override auction type '%s'", o.AuctionType)
+	}
+	if o.AuctionType == "" && o.LotDenom == "" {
+		return errors.New("param override must set an auction type or lot denom")
+	}
+	if o.LotDenom != "" && !(sdk.Coin{Denom: o.LotDenom, Amount: sdk.ZeroInt()}).IsValid() {
+		return fmt.Errorf("invalid param override lot denom '%s'", o.LotDenom)
+	}
+	if o.MaxAuctionDuration < 0 || o.BidDuration < 0 {
+		return errors.New("param override durations cannot be negative")
+	}
+	if o.Increment == (sdk.Dec{}) || o.Increment.IsNegative() {
+		return errors.New("param override increment cannot be nil or less than zero")
+	}
+	if o.AuctionType == AuctionTypeDutch && (o.BidDuration != 0 || !o.Increment.IsZero()) {
+		return errors.New("dutch auction param overrides can only set the max auction duration")
+	}
 	return nil
 }
+
+// apply returns the auction params with the values set by the override replaced.
+func (o ParamOverride) apply(ap AuctionParams) AuctionParams {
+	if o.MaxAuctionDuration != 0 {
+		ap.MaxAuctionDuration = o.MaxAuctionDuration
+	}
+	if o.BidDuration != 0 {
+		ap.BidDuration = o.BidDuration
+	}
+	if !o.Increment.IsZero() {
+		ap.Increment = o.Increment
+	}
+	return ap
+}
+
+// String implements fmt.Stringer
+func (o ParamOverride) String() string {
+	return fmt.Sprintf(`
+		Auction Type: %s
+		Lot Denom: %s
+		Max Auction Duration: %s
+		Bid Duration: %s
+		Increment: %s`,
+		o.AuctionType, o.LotDenom, o.MaxAuctionDuration, o.BidDuration, o.Increment)
+}
+
+// ParamOverrides a collection of ParamOverride objects
+type ParamOverrides []ParamOverride
+
+// find returns the override for exactly the auction type and lot denom.
+func (os ParamOverrides) find(auctionType, lotDenom string) (ParamOverride, bool) {
+	for _, o := range os {
+		if o.AuctionType == auctionType && o.LotDenom == lotDenom {
+			return o, true
+		}
+	}
+	return ParamOverride{}, false
+}
+
+// String implements fmt.Stringer
+func (os ParamOverrides) String() string {
+	out := ""
+	for _, o := range os {
+		out += o.String()
+	}
+	return out
+}
@@ -29,6 +29,14 @@ func TestParams_Validate(t *testing.T) {
 				IncrementSurplus:    d("0.05"),
 				IncrementDebt:       d("0.05"),
 				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchPriceDecay:     d("0.01"),
+				DutchDecayInterval:  10 * time.Minute,
+				DutchMinPrice:       d("0"),
+				SealedBidPricing:    SealedBidSecondPrice,
+				CommitDuration:      24 * time.Hour,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("0.01"),
 			},
 			true,
 		},
@@ -40,6 +48,14 @@ func TestParams_Validate(t *testing.T) {
 				IncrementSurplus:    d("0.05"),
 				IncrementDebt:       d("0.05"),
 				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchPriceDecay:     d("0.01"),
+				DutchDecayInterval:  10 * time.Minute,
+				DutchMinPrice:       d("0"),
+				SealedBidPricing:    SealedBidSecondPrice,
+				CommitDuration:      24 * time.Hour,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("0.01"),
 			},
 			true,
 		},
@@ -51,6 +67,14 @@ func TestParams_Validate(t *testing.T) {
 				IncrementSurplus:    d("0.05"),
 				IncrementDebt:       d("0.05"),
 				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchPriceDecay:     d("0.01"),
+				DutchDecayInterval:  10 * time.Minute,
+				DutchMinPrice:       d("0"),
+				SealedBidPricing:    SealedBidSecondPrice,
+				CommitDuration:      24 * time.Hour,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("0.01"),
 			},
 			true,
 		},
@@ -62,6 +86,14 @@ func TestParams_Validate(t *testing.T) {
 				IncrementSurplus:    d("-0.05"),
 				IncrementDebt:       d("0.05"),
 				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchPriceDecay:     d("0.01"),
+				DutchDecayInterval:  10 * time.Minute,
+				DutchMinPrice:       d("0"),
+				SealedBidPricing:    SealedBidSecondPrice,
+				CommitDuration:      24 * time.Hour,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("0.01"),
 			},
 			true,
 		},
@@ -73,6 +105,14 @@ func TestParams_Validate(t *testing.T) {
 				IncrementSurplus:    d("0.05"),
 				IncrementDebt:       d("-0.05"),
 				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchPriceDecay:     d("0.01"),
+				DutchDecayInterval:  10 * time.Minute,
+				DutchMinPrice:       d("0"),
+				SealedBidPricing:    SealedBidSecondPrice,
+				CommitDuration:      24 * time.Hour,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("0.01"),
 			},
 			true,
 		},
@@ -84,9 +124,261 @@ func TestParams_Validate(t *testing.T) {
 				IncrementSurplus:    d("0.05"),
 				IncrementDebt:       d("0.05"),
 				IncrementCollateral: d("-0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchPriceDecay:     d("0.01"),
+				DutchDecayInterval:  10 * time.Minute,
+				DutchMinPrice:       d("0"),
+				SealedBidPricing:    SealedBidSecondPrice,
+				CommitDuration:      24 * time.Hour,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("0.01"),
 			},
 			true,
 		},
+		{
+			"dutch buffer below one",
+			Params{
+				MaxAuctionDuration:  24 * time.Hour,
+				BidDuration:         1 * time.Hour,
+				IncrementSurplus:    d("0.05"),
+				IncrementDebt:       d("0.05"),
+				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("0.9"),
+				DutchPriceDecay:     d("0.01"),
+				DutchDecayInterval:  10 * time.Minute,
+				DutchMinPrice:       d("0"),
+				SealedBidPricing:    SealedBidSecondPrice,
+				CommitDuration:      24 * time.Hour,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("0.01"),
+			},
+			true,
+		},
+		{
+			"dutch decay of one",
+			Params{
+				MaxAuctionDuration:  24 * time.Hour,
+				BidDuration:         1 * time.Hour,
+				IncrementSurplus:    d("0.05"),
+				IncrementDebt:       d("0.05"),
+				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchPriceDecay:     d("1"),
+				DutchDecayInterval:  10 * time.Minute,
+				DutchMinPrice:       d("0"),
+				SealedBidPricing:    SealedBidSecondPrice,
+				CommitDuration:      24 * time.Hour,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("0.01"),
+			},
+			true,
+		},
+		{
+			"negative dutch decay",
+			Params{
+				MaxAuctionDuration:  24 * time.Hour,
+				BidDuration:         1 * time.Hour,
+				IncrementSurplus:    d("0.05"),
+				IncrementDebt:       d("0.05"),
+				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchPriceDecay:     d("-0.01"),
+				DutchDecayInterval:  10 * time.Minute,
+				DutchMinPrice:       d("0"),
+				SealedBidPricing:    SealedBidSecondPrice,
+				CommitDuration:      24 * time.Hour,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("0.01"),
+			},
+			true,
+		},
+		{
+			"zero dutch interval",
+			Params{
+				MaxAuctionDuration:  24 * time.Hour,
+				BidDuration:         1 * time.Hour,
+				IncrementSurplus:    d("0.05"),
+				IncrementDebt:       d("0.05"),
+				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchPriceDecay:     d("0.01"),
+				DutchDecayInterval:  0,
+				DutchMinPrice:       d("0"),
+				SealedBidPricing:    SealedBidSecondPrice,
+				CommitDuration:      24 * time.Hour,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("0.01"),
+			},
+			true,
+		},
+		{
+			"dutch min price of one",
+			Params{
+				MaxAuctionDuration:  24 * time.Hour,
+				BidDuration:         1 * time.Hour,
+				IncrementSurplus:    d("0.05"),
+				IncrementDebt:       d("0.05"),
+				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchPriceDecay:     d("0.01"),
+				DutchDecayInterval:  10 * time.Minute,
+				DutchMinPrice:       d("1"),
+				SealedBidPricing:    SealedBidSecondPrice,
+				CommitDuration:      24 * time.Hour,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("0.01"),
+			},
+			true,
+		},
+		{
+			"sealed bid first price",
+			Params{
+				MaxAuctionDuration:  24 * time.Hour,
+				BidDuration:         1 * time.Hour,
+				IncrementSurplus:    d("0.05"),
+				IncrementDebt:       d("0.05"),
+				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchPriceDecay:     d("0.01"),
+				DutchDecayInterval:  10 * time.Minute,
+				DutchMinPrice:       d("0"),
+				SealedBidSurplus:    true,
+				SealedBidDebt:       true,
+				SealedBidPricing:    SealedBidFirstPrice,
+				CommitDuration:      24 * time.Hour,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("0"),
+			},
+			false,
+		},
+		{
+			"invalid sealed bid pricing",
+			Params{
+				MaxAuctionDuration:  24 * time.Hour,
+				BidDuration:         1 * time.Hour,
+				IncrementSurplus:    d("0.05"),
+				IncrementDebt:       d("0.05"),
+				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchPriceDecay:     d("0.01"),
+				DutchDecayInterval:  10 * time.Minute,
+				DutchMinPrice:       d("0"),
+				SealedBidSurplus:    true,
+				SealedBidDebt:       true,
+				SealedBidPricing:    "third-price",
+				CommitDuration:      24 * time.Hour,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("0.01"),
+			},
+			true,
+		},
+		{
+			"zero commit duration",
+			Params{
+				MaxAuctionDuration:  24 * time.Hour,
+				BidDuration:         1 * time.Hour,
+				IncrementSurplus:    d("0.05"),
+				IncrementDebt:       d("0.05"),
+				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchPriceDecay:     d("0.01"),
+				DutchDecayInterval:  10 * time.Minute,
+				DutchMinPrice:       d("0"),
+				SealedBidSurplus:    true,
+				SealedBidDebt:       true,
+				SealedBidPricing:    SealedBidSecondPrice,
+				CommitDuration:      0,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("0.01"),
+			},
+			true,
+		},
+		{
+			"negative bid deposit",
+			Params{
+				MaxAuctionDuration:  24 * time.Hour,
+				BidDuration:         1 * time.Hour,
+				IncrementSurplus:    d("0.05"),
+				IncrementDebt:       d("0.05"),
+				IncrementCollateral: d("0.05"),
+				DutchPriceBuffer:    d("1.2"),
+				DutchPriceDecay:     d("0.01"),
+				DutchDecayInterval:  10 * time.Minute,
+				DutchMinPrice:       d("0"),
+				SealedBidSurplus:    true,
+				SealedBidDebt:       true,
+				SealedBidPricing:    SealedBidSecondPrice,
+				CommitDuration:      24 * time.Hour,
+				RevealDuration:      6 * time.Hour,
+				BidDeposit:          d("-0.01"),
+			},
+			true,
+		},
+		{
+			"valid overrides",
+			withOverrides(
+				NewParamOverride(AuctionTypeCollateral, "", 0, 0, d("0.1")),
+				NewParamOverride("", "xrp", 6*time.Hour, 30*time.Minute, sdk.ZeroDec()),
+				NewParamOverride(AuctionTypeDutch, "xrp", 2*time.Hour, 0, sdk.ZeroDec()),
+			),
+			false,
+		},
+		{
+			"override without type or denom",
+			withOverrides(NewParamOverride("", "", time.Hour, 0, sdk.ZeroDec())),
+			true,
+		},
+		{
+			"override invalid type",
+			withOverrides(NewParamOverride(AuctionTypeSealed, "", time.Hour, 0, sdk.ZeroDec())),
+			true,
+		},
+		{
+			"override invalid denom",
+			withOverrides(NewParamOverride("", "X", time.Hour, 0, sdk.ZeroDec())),
+			true,
+		},
+		{
+			"override negative duration",
+			withOverrides(NewParamOverride("", "xrp", -time.Hour, 0, sdk.ZeroDec())),
+			true,
+		},
+		{
+			"override nil increment",
+			withOverrides(ParamOverride{LotDenom: "xrp", MaxAuctionDuration: time.Hour}),
+			true,
+		},
+		{
+			"override negative increment",
+			withOverrides(NewParamOverride("", "xrp", 0, 0, d("-0.1"))),
+			true,
+		},
+		{
+			"dutch override bid duration",
+			withOverrides(NewParamOverride(AuctionTypeDutch, "", time.Hour, time.Minute, sdk.ZeroDec())),
+			true,
+		},
+		{
+			"duplicate overrides",
+			withOverrides(
+				NewParamOverride("", "xrp", time.Hour, 0, sdk.ZeroDec()),
+				NewParamOverride("", "xrp", 2*time.Hour, 0, sdk.ZeroDec()),
+			),
+			true,
+		},
+		{
+			"override bid>auction",
+			withOverrides(NewParamOverride("", "xrp", 30*time.Minute, 0, sdk.ZeroDec())),
+			true,
+		},
+		{
+			"override bid>auction after combining overrides",
+			withOverrides(
+				NewParamOverride(AuctionTypeSurplus, "", 0, 3*time.Hour, sdk.ZeroDec()),
+				NewParamOverride("", "xrp", 2*time.Hour, 0, sdk.ZeroDec()),
+			),
+			true,
+		},
 		{
 			"zero value",
 			Params{},
@@ -105,4 +397,35 @@ func TestParams_Validate(t *testing.T) {
 	}
 }
 
+func TestParams_ForAuction(t *testing.T) {
+	p := withOverrides(
+		NewParamOverride(AuctionTypeCollateral, "", 0, 30*time.Minute, d("0.1")),
+		NewParamOverride("", "xrp", 6*time.Hour, 0, sdk.ZeroDec()),
+		NewParamOverride(AuctionTypeCollateral, "xrp", 0, 0, d("0.2")),
+	)
+	testCases := []struct {
+		name        string
+		auctionType string
+		lotDenom    string
+		expected    AuctionParams
+	}{
+		{"no overrides", AuctionTypeSurplus, "ukava", AuctionParams{DefaultMaxAuctionDuration, DefaultBidDuration, DefaultIncrement}},
+		{"type override", AuctionTypeCollateral, "btc", AuctionParams{DefaultMaxAuctionDuration, 30 * time.Minute, d("0.1")}},
+		{"denom override", AuctionTypeSurplus, "xrp", AuctionParams{6 * time.Hour, DefaultBidDuration, DefaultIncrement}},
+		{"type and denom overrides", AuctionTypeCollateral, "xrp", AuctionParams{6 * time.Hour, 30 * time.Minute, d("0.2")}},
+		{"dutch", AuctionTypeDutch, "xrp", AuctionParams{6 * time.Hour, DefaultBidDuration, sdk.ZeroDec()}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, p.ForAuction(tc.auctionType, tc.lotDenom))
+		})
+	}
+}
+
+func withOverrides(overrides ...ParamOverride) Params {
+	p := DefaultParams()
+	p.Overrides = overrides
+	return p
+}
+
 func d(amount string) sdk.Dec { return sdk.MustNewDecFromStr(amount) }
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeStuckAuction defines the type for a StuckAuctionProposal
+	ProposalTypeStuckAuction = "StuckAuction"
+
+	// StuckAuctionSettle retries closing a stuck auction, paying out as normal
+	StuckAuctionSettle = "settle"
+	// StuckAuctionCancel removes a stuck auction without paying out, leaving its coins in the auction module account
+	StuckAuctionCancel = "cancel"
+)
+
+// Assert StuckAuctionProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = StuckAuctionProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeStuckAuction)
+	govtypes.RegisterProposalTypeCodec(StuckAuctionProposal{}, "auction/StuckAuctionProposal")
+}
+
+// StuckAuctionProposal settles or cancels an auction that has failed to close
+type StuckAuctionProposal struct {
+	Title       string `json:"title" yaml:"title"`
+	Description string `json:"description" yaml:"description"`
+	AuctionID   uint64 `json:"auction_id" yaml:"auction_id"`
+	Action      string `json:"action" yaml:"action"`
+}
+
+// NewStuckAuctionProposal creates a new StuckAuctionProposal
+func NewStuckAuctionProposal(title, description string, auctionID uint64, action string) StuckAuctionProposal {
+	return StuckAuctionProposal{
+		Title:       title,
+		Description: description,
+		AuctionID:   auctionID,
+		Action:      action,
+	}
+}
+
+// GetTitle returns the title of the proposal.
+func (sap StuckAuctionProposal) GetTitle() string { return sap.Title }
+
+// GetDescription returns the description of the proposal.
+func (sap StuckAuctionProposal) GetDescription() string { return sap.Description }
+
+// ProposalRoute returns the routing key of the proposal.
+func (sap StuckAuctionProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of the proposal.
+func (sap StuckAuctionProposal) ProposalType() string { return ProposalTypeStuckAuction }
+
+// ValidateBasic runs basic stateless validity checks
+func (sap StuckAuctionProposal) ValidateBasic() sdk.Error {
+	err := govtypes.ValidateAbstract(DefaultCodespace, sap)
+	if err != nil {
+		return err
+	}
+	switch sap.Action {
+	case StuckAuctionSettle, StuckAuctionCancel:
+	default:
+		return ErrInvalidStuckAuctionAction(DefaultCodespace, sap.Action)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (sap StuckAuctionProposal) String() string {
+	return fmt.Sprintf(`Stuck Auction Proposal:
+  Title:       %s
+  Description: %s
+  Auction ID:  %d
+  Action:      %s
+`, sap.Title, sap.Description, sap.AuctionID, sap.Action)
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStuckAuctionProposal_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name       string
+		proposal   StuckAuctionProposal
+		expectPass bool
+	}{
+		{"settle",
+			NewStuckAuctionProposal("title", "description", 1, StuckAuctionSettle),
+			true},
+		{"cancel",
+			NewStuckAuctionProposal("title", "description", 1, StuckAuctionCancel),
+			true},
+		{"invalidAction",
+			NewStuckAuctionProposal("title", "description", 1, "close"),
+			false},
+		{"emptyTitle",
+			NewStuckAuctionProposal("", "description", 1, StuckAuctionSettle),
+			false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.expectPass {
+				require.NoError(t, tc.proposal.ValidateBasic())
+			} else {
+				require.Error(t, tc.proposal.ValidateBasic())
+			}
+		})
+	}
+}
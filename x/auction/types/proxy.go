@@ -0,0 +1,65 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProxyBid is a standing instruction to outbid other bidders on an auction by the minimum increment, up to a limit.
+// MaxBid is the highest bid to place in forward phases, or the lowest lot to bid in reverse phases. A collateral auction
+// proxy bid in the lot denom bids up to the auction's MaxBid in forward phase then continues down to MaxBid in reverse phase.
+type ProxyBid struct {
+	AuctionID uint64         `json:"auction_id" yaml:"auction_id"`
+	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`
+	MaxBid    sdk.Coin       `json:"max_bid" yaml:"max_bid"`
+	Escrow    sdk.Coin       `json:"escrow" yaml:"escrow"` // bid coins held in the auction module account to pay for bids, returned when the auction closes
+}
+
+// NewProxyBid returns a new ProxyBid
+func NewProxyBid(auctionID uint64, bidder sdk.AccAddress, maxBid, escrow sdk.Coin) ProxyBid {
+	return ProxyBid{
+		AuctionID: auctionID,
+		Bidder:    bidder,
+		MaxBid:    maxBid,
+		Escrow:    escrow,
+	}
+}
+
+// Validate performs a basic validation of the proxy bid fields.
+func (pb ProxyBid) Validate() error {
+	if pb.Bidder.Empty() {
+		return errors.New("proxy bid bidder cannot be empty")
+	}
+	if !pb.MaxBid.IsValid() {
+		return fmt.Errorf("invalid proxy bid max bid: %s", pb.MaxBid)
+	}
+	if !pb.Escrow.IsValid() {
+		return fmt.Errorf("invalid proxy bid escrow: %s", pb.Escrow)
+	}
+	return nil
+}
+
+// String implements fmt.Stringer
+func (pb ProxyBid) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Proxy Bid:
+	Auction ID: %d
+	Bidder: %s
+	Max Bid: %s
+	Escrow: %s`,
+		pb.AuctionID, pb.Bidder, pb.MaxBid, pb.Escrow))
+}
+
+// ProxyBids a collection of ProxyBid objects
+type ProxyBids []ProxyBid
+
+// String implements fmt.Stringer
+func (pbs ProxyBids) String() string {
+	out := ""
+	for _, pb := range pbs {
+		out += pb.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// QueryGetAuction is the query path for querying one auction
 	QueryGetAuction = "auction"
@@ -7,6 +13,23 @@ const (
 	QueryGetAuctions = "auctions"
 	// QueryGetParams is the query path for querying the global auction params
 	QueryGetParams = "params"
+	// QueryGetStuckAuctions is the query path for querying auctions that failed to close
+	QueryGetStuckAuctions = "stuck"
+	// QueryGetClosedAuctions is the query path for querying the archive of closed auctions
+	QueryGetClosedAuctions = "closed-auctions"
+	// QueryGetBidHistory is the query path for querying the bid history of auctions
+	QueryGetBidHistory = "bids"
+)
+
+// Query parameters of the REST auction listing routes
+const (
+	RestType      = "type"
+	RestPhase     = "phase"
+	RestInitiator = "initiator"
+	RestBidder    = "bidder"
+	RestLotDenom  = "lot-denom"
+	RestBidDenom  = "bid-denom"
+	RestEndBefore = "end-before"
 )
 
 // QueryAuctionParams params for query /auction/auction
@@ -16,15 +39,70 @@ type QueryAuctionParams struct {
 
 // QueryAllAuctionParams is the params for an auctions query
 type QueryAllAuctionParams struct {
-	Page  int `json:"page" yaml:"page"`
-	Limit int `json:"limit" yaml:"limit"`
+	Page      int            `json:"page" yaml:"page"`             // page of results to return, starting at 1
+	Limit     int            `json:"limit" yaml:"limit"`           // maximum number of results per page, 0 returns all
+	Type      string         `json:"type" yaml:"type"`             // get auctions of this type, if set
+	Phase     string         `json:"phase" yaml:"phase"`           // get auctions in this phase, if set
+	Initiator string         `json:"initiator" yaml:"initiator"`   // get auctions started by this module, if set
+	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`         // get auctions with this latest bidder, if set
+	LotDenom  string         `json:"lot_denom" yaml:"lot_denom"`   // get auctions with a lot of this denom, if set
+	BidDenom  string         `json:"bid_denom" yaml:"bid_denom"`   // get auctions with a bid of this denom, if set
+	EndBefore time.Time      `json:"end_before" yaml:"end_before"` // get auctions ending before this time, if non-zero
 }
 
 // NewQueryAllAuctionParams creates a new QueryAllAuctionParams
-func NewQueryAllAuctionParams(page int, limit int) QueryAllAuctionParams {
+func NewQueryAllAuctionParams(page int, limit int, auctionType, phase, initiator string, bidder sdk.AccAddress,
+	lotDenom, bidDenom string, endBefore time.Time) QueryAllAuctionParams {
 	return QueryAllAuctionParams{
-		Page:  page,
-		Limit: limit,
+		Page:      page,
+		Limit:     limit,
+		Type:      auctionType,
+		Phase:     phase,
+		Initiator: initiator,
+		Bidder:    bidder,
+		LotDenom:  lotDenom,
+		BidDenom:  bidDenom,
+		EndBefore: endBefore,
+	}
+}
+
+// Matches returns true if the auction passes all of the set filters
+func (p QueryAllAuctionParams) Matches(a Auction) bool {
+	if p.Type != "" && a.GetType() != p.Type {
+		return false
+	}
+	if p.Phase != "" && a.GetPhase() != p.Phase {
+		return false
+	}
+	if p.Initiator != "" && a.GetInitiator() != p.Initiator {
+		return false
+	}
+	if !p.Bidder.Empty() && !a.GetBidder().Equals(p.Bidder) {
+		return false
+	}
+	if p.LotDenom != "" && a.GetLot().Denom != p.LotDenom {
+		return false
+	}
+	if p.BidDenom != "" && a.GetBid().Denom != p.BidDenom {
+		return false
+	}
+	if !p.EndBefore.IsZero() && !a.GetEndTime().Before(p.EndBefore) {
+		return false
+	}
+	return true
+}
+
+// QueryArchiveParams is the params for closed auction and bid history queries
+type QueryArchiveParams struct {
+	AuctionID uint64         `json:"auction_id" yaml:"auction_id"` // get the closed auction or bids with this auction ID, if non-zero
+	Bidder    sdk.AccAddress `json:"bidder" yaml:"bidder"`         // get the closed auctions won by or bids placed by this bidder, if set
+}
+
+// NewQueryArchiveParams creates a new QueryArchiveParams
+func NewQueryArchiveParams(auctionID uint64, bidder sdk.AccAddress) QueryArchiveParams {
+	return QueryArchiveParams{
+		AuctionID: auctionID,
+		Bidder:    bidder,
 	}
 }
 
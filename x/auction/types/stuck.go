@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// StuckAuction records an expired auction that failed to close. Closing it is retried every block until it succeeds, or governance settles or cancels it.
+type StuckAuction struct {
+	AuctionID  uint64    `json:"auction_id" yaml:"auction_id"`
+	Error      string    `json:"error" yaml:"error"`             // log of the latest failed close
+	StuckSince time.Time `json:"stuck_since" yaml:"stuck_since"` // block time of the first failed close
+	Attempts   uint64    `json:"attempts" yaml:"attempts"`       // number of failed closes
+}
+
+// NewStuckAuction returns a new StuckAuction
+func NewStuckAuction(auctionID uint64, err string, stuckSince time.Time, attempts uint64) StuckAuction {
+	return StuckAuction{
+		AuctionID:  auctionID,
+		Error:      err,
+		StuckSince: stuckSince,
+		Attempts:   attempts,
+	}
+}
+
+// String implements fmt.Stringer
+func (sa StuckAuction) String() string {
+	return fmt.Sprintf(`Stuck Auction %d:
+  Error:       %s
+  Stuck Since: %s
+  Attempts:    %d`,
+		sa.AuctionID, sa.Error, sa.StuckSince, sa.Attempts,
+	)
+}
+
+// StuckAuctions a slice of StuckAuction
+type StuckAuctions []StuckAuction
+
+// String implements fmt.Stringer
+func (sas StuckAuctions) String() string {
+	var out []string
+	for _, sa := range sas {
+		out = append(out, sa.String())
+	}
+	return strings.Join(out, "\n")
+}
@@ -8,8 +8,16 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-// BeginBlocker compounds the debt in outstanding cdps and liquidates cdps that are below the required collateralization ratio
+// BeginBlocker compounds the debt in outstanding cdps, liquidates cdps that are below the required collateralization ratio,
+// closes cdps with debt below the debt floor if governance has enabled the dust sweep, and pays the savings rate to savings deposits.
+// Once a global settlement has started the cdp system is frozen and none of these run.
+// Cdp history entries older than the history retention are pruned in every block.
 func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	k.PruneCdpHistory(ctx)
+	if k.IsSettled(ctx) {
+		k.SetPreviousBlockTime(ctx, ctx.BlockTime())
+		return
+	}
 	params := k.GetParams(ctx)
 	previousBlockTime, found := k.GetPreviousBlockTime(ctx)
 	if !found {
@@ -20,7 +28,28 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 		for _, dp := range params.DebtParams {
 			k.HandleNewDebt(ctx, cp.Denom, dp.Denom, timeElapsed)
 		}
+	}
+
+	if params.GlobalSettlement {
+		// settlement is all or nothing, if it fails it is attempted again in the next block
+		settlementCtx, writeCache := ctx.CacheContext()
+		err := k.StartGlobalSettlement(settlementCtx)
+		if err != nil {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					EventTypeBeginBlockerFatal,
+					sdk.NewAttribute(sdk.AttributeKeyModule, fmt.Sprintf("%s", ModuleName)),
+					sdk.NewAttribute(types.AttributeKeyError, fmt.Sprintf("%s", err)),
+				),
+			)
+		} else {
+			writeCache()
+		}
+		k.SetPreviousBlockTime(ctx, ctx.BlockTime())
+		return
+	}
 
+	for _, cp := range params.CollateralParams {
 		err := k.LiquidateCdps(ctx, cp.MarketID, cp.Denom, cp.LiquidationRatio)
 		if err != nil {
 			ctx.EventManager().EmitEvent(
@@ -32,7 +61,21 @@ func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
 			)
 		}
 	}
-	err := k.RunSurplusAndDebtAuctions(ctx)
+	// undercollateralized dust cdps are skipped by the sweep, as liquidations are capped per block and may not have reached them
+	if params.DustSweep {
+		k.SweepDust(ctx)
+	}
+	err := k.DistributeSavingsRate(ctx, timeElapsed)
+	if err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeBeginBlockerFatal,
+				sdk.NewAttribute(sdk.AttributeKeyModule, fmt.Sprintf("%s", ModuleName)),
+				sdk.NewAttribute(types.AttributeKeyError, fmt.Sprintf("%s", err)),
+			),
+		)
+	}
+	err = k.RunSurplusAndDebtAuctions(ctx)
 	if err != nil {
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
@@ -176,6 +176,30 @@ func (suite *ModuleTestSuite) TestSeizeSingleCdpWithFees() {
 	suite.NoError(err)
 }
 
+func (suite *ModuleTestSuite) TestGlobalSettlement() {
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[0], cs(c("xrp", 10000000000)), cs(c("usdx", 1000000000)))
+	suite.NoError(err)
+	suite.keeper.SetPreviousBlockTime(suite.ctx, suite.ctx.BlockTime())
+	params := suite.keeper.GetParams(suite.ctx)
+	params.GlobalSettlement = true
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 6))
+	cdp.BeginBlocker(suite.ctx, abci.RequestBeginBlock{Header: suite.ctx.BlockHeader()}, suite.keeper)
+	suite.True(suite.keeper.IsSettled(suite.ctx))
+	totalPrincipal := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx")
+
+	// fees stop accruing and cdps are no longer liquidated
+	suite.setPrice(d("0.01"), "xrp:usd")
+	for i := 0; i < 10; i++ {
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second * 6))
+		cdp.BeginBlocker(suite.ctx, abci.RequestBeginBlock{Header: suite.ctx.BlockHeader()}, suite.keeper)
+	}
+	suite.Equal(totalPrincipal, suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx"))
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp", 1)
+	suite.True(found)
+}
+
 func TestModuleTestSuite(t *testing.T) {
 	suite.Run(t, new(ModuleTestSuite))
 }
@@ -29,6 +29,13 @@ const (
 	CodeBelowDebtFloor              = types.CodeBelowDebtFloor
 	CodePaymentExceedsDebt          = types.CodePaymentExceedsDebt
 	CodeLoadingAugmentedCDP         = types.CodeLoadingAugmentedCDP
+	CodeCdpNotLiquidatable          = types.CodeCdpNotLiquidatable
+	CodeInvalidPrice                = types.CodeInvalidPrice
+	CodeSavingsDepositNotFound      = types.CodeSavingsDepositNotFound
+	CodeInvalidSavingsWithdraw      = types.CodeInvalidSavingsWithdraw
+	CodeSystemSettled               = types.CodeSystemSettled
+	CodeSystemNotSettled            = types.CodeSystemNotSettled
+	CodeInsufficientPayment         = types.CodeInsufficientPayment
 	EventTypeCreateCdp              = types.EventTypeCreateCdp
 	EventTypeCdpDeposit             = types.EventTypeCdpDeposit
 	EventTypeCdpDraw                = types.EventTypeCdpDraw
@@ -36,9 +43,28 @@ const (
 	EventTypeCdpClose               = types.EventTypeCdpClose
 	EventTypeCdpWithdrawal          = types.EventTypeCdpWithdrawal
 	EventTypeCdpLiquidation         = types.EventTypeCdpLiquidation
+	EventTypeKeeperLiquidation      = types.EventTypeKeeperLiquidation
+	EventTypeLiquidationBacklog     = types.EventTypeLiquidationBacklog
+	EventTypeLiquidationUnpriced    = types.EventTypeLiquidationUnpriced
 	EventTypeBeginBlockerFatal      = types.EventTypeBeginBlockerFatal
+	EventTypeSavingsDeposit         = types.EventTypeSavingsDeposit
+	EventTypeSavingsWithdrawal      = types.EventTypeSavingsWithdrawal
+	EventTypeSavingsRatePayout      = types.EventTypeSavingsRatePayout
+	EventTypeGlobalSettlement       = types.EventTypeGlobalSettlement
+	EventTypeDebtRedemption         = types.EventTypeDebtRedemption
+	EventTypeCollateralReclaim      = types.EventTypeCollateralReclaim
+	EventTypeCdpDustSweep           = types.EventTypeCdpDustSweep
+	EventTypeSurplusReserveSkip     = types.EventTypeSurplusReserveSkip
+	HistoryTypeFeeAccrual           = types.HistoryTypeFeeAccrual
 	AttributeKeyCdpID               = types.AttributeKeyCdpID
 	AttributeKeyDepositor           = types.AttributeKeyDepositor
+	AttributeKeyKeeper              = types.AttributeKeyKeeper
+	AttributeKeyReward              = types.AttributeKeyReward
+	AttributeKeyCollateralDenom     = types.AttributeKeyCollateralDenom
+	AttributeKeyLiquidated          = types.AttributeKeyLiquidated
+	AttributeKeyBacklog             = types.AttributeKeyBacklog
+	AttributeKeyRedeemer            = types.AttributeKeyRedeemer
+	AttributeKeyDebtDenom           = types.AttributeKeyDebtDenom
 	AttributeValueCategory          = types.AttributeValueCategory
 	AttributeKeyError               = types.AttributeKeyError
 	ModuleName                      = types.ModuleName
@@ -47,18 +73,50 @@ const (
 	QuerierRoute                    = types.QuerierRoute
 	DefaultParamspace               = types.DefaultParamspace
 	LiquidatorMacc                  = types.LiquidatorMacc
+	SavingsRateMacc                 = types.SavingsRateMacc
+	RepayModeExact                  = types.RepayModeExact
+	RepayModeFloor                  = types.RepayModeFloor
+	RepayModeAll                    = types.RepayModeAll
+	AuctionTypeCollateral           = types.AuctionTypeCollateral
+	AuctionTypeDutch                = types.AuctionTypeDutch
 	QueryGetCdp                     = types.QueryGetCdp
+	QueryGetCdpHealth               = types.QueryGetCdpHealth
+	QueryGetCdpHistory              = types.QueryGetCdpHistory
 	QueryGetCdps                    = types.QueryGetCdps
 	QueryGetCdpsByCollateralization = types.QueryGetCdpsByCollateralization
 	QueryGetParams                  = types.QueryGetParams
+	QueryGetDebtAssets              = types.QueryGetDebtAssets
+	QueryGetFeeRates                = types.QueryGetFeeRates
+	QueryGetSystemSurplus           = types.QueryGetSystemSurplus
+	QueryGetSavingsDeposit          = types.QueryGetSavingsDeposit
+	QueryGetSavingsPools            = types.QueryGetSavingsPools
+	QueryGetSettlement              = types.QueryGetSettlement
+	QueryGetStats                   = types.QueryGetStats
+	QueryGetLiquidationSimulation   = types.QueryGetLiquidationSimulation
 	RestOwner                       = types.RestOwner
 	RestCollateralDenom             = types.RestCollateralDenom
+	RestCdpID                       = types.RestCdpID
+	RestPrice                       = types.RestPrice
+	RestLiquidationRatio            = types.RestLiquidationRatio
 	RestRatio                       = types.RestRatio
+	RestDenom                       = types.RestDenom
+	RestMinID                       = types.RestMinID
+	RestMaxID                       = types.RestMaxID
+	RestSortBy                      = types.RestSortBy
+	SortByID                        = types.SortByID
+	SortByRatio                     = types.SortByRatio
+	SortByDebt                      = types.SortByDebt
+	SortByCollateral                = types.SortByCollateral
+	MaxConversionFactor             = types.MaxConversionFactor
 )
 
 var (
 	// functions aliases
 	NewCDP                      = types.NewCDP
+	NewCDPHealth                = types.NewCDPHealth
+	NewCdpHistoryEntry          = types.NewCdpHistoryEntry
+	NewLiquidationSimulation    = types.NewLiquidationSimulation
+	NewSimulatedAuction         = types.NewSimulatedAuction
 	RegisterCodec               = types.RegisterCodec
 	NewDeposit                  = types.NewDeposit
 	ErrCdpAlreadyExists         = types.ErrCdpAlreadyExists
@@ -78,18 +136,37 @@ var (
 	ErrBelowDebtFloor           = types.ErrBelowDebtFloor
 	ErrPaymentExceedsDebt       = types.ErrPaymentExceedsDebt
 	ErrLoadingAugmentedCDP      = types.ErrLoadingAugmentedCDP
+	ErrCdpNotLiquidatable       = types.ErrCdpNotLiquidatable
+	ErrInvalidPrice             = types.ErrInvalidPrice
+	ErrSavingsDepositNotFound   = types.ErrSavingsDepositNotFound
+	ErrInvalidSavingsWithdraw   = types.ErrInvalidSavingsWithdraw
+	ErrSystemSettled            = types.ErrSystemSettled
+	ErrSystemNotSettled         = types.ErrSystemNotSettled
+	ErrInsufficientPayment      = types.ErrInsufficientPayment
+	NewDebtAsset                = types.NewDebtAsset
+	NewSystemSurplus            = types.NewSystemSurplus
+	NewStats                    = types.NewStats
+	NewCollateralStat           = types.NewCollateralStat
+	NewRatioBucket              = types.NewRatioBucket
+	NewRatioBuckets             = types.NewRatioBuckets
+	NewDebtFeeRate              = types.NewDebtFeeRate
+	NewCollateralFeeRates       = types.NewCollateralFeeRates
 	NewGenesisState             = types.NewGenesisState
 	DefaultGenesisState         = types.DefaultGenesisState
 	GetCdpIDBytes               = types.GetCdpIDBytes
 	GetCdpIDFromBytes           = types.GetCdpIDFromBytes
 	CdpKey                      = types.CdpKey
 	SplitCdpKey                 = types.SplitCdpKey
+	CdpHistoryKey               = types.CdpHistoryKey
+	CdpHistoryTimeKey           = types.CdpHistoryTimeKey
+	SplitCdpHistoryTimeKey      = types.SplitCdpHistoryTimeKey
 	DenomIterKey                = types.DenomIterKey
 	SplitDenomIterKey           = types.SplitDenomIterKey
 	DepositKey                  = types.DepositKey
 	SplitDepositKey             = types.SplitDepositKey
 	DepositIterKey              = types.DepositIterKey
 	SplitDepositIterKey         = types.SplitDepositIterKey
+	SavingsDepositKey           = types.SavingsDepositKey
 	CollateralRatioBytes        = types.CollateralRatioBytes
 	CollateralRatioKey          = types.CollateralRatioKey
 	SplitCollateralRatioKey     = types.SplitCollateralRatioKey
@@ -100,21 +177,53 @@ var (
 	NewMsgWithdraw              = types.NewMsgWithdraw
 	NewMsgDrawDebt              = types.NewMsgDrawDebt
 	NewMsgRepayDebt             = types.NewMsgRepayDebt
+	NewMsgLiquidate             = types.NewMsgLiquidate
+	NewMsgDepositSavings        = types.NewMsgDepositSavings
+	NewMsgWithdrawSavings       = types.NewMsgWithdrawSavings
+	NewMsgRedeemDebt            = types.NewMsgRedeemDebt
+	NewMsgReclaimCollateral     = types.NewMsgReclaimCollateral
 	NewParams                   = types.NewParams
+	NewScheduledChange          = types.NewScheduledChange
+	NewDebtAuctionParam         = types.NewDebtAuctionParam
 	DefaultParams               = types.DefaultParams
 	ParamKeyTable               = types.ParamKeyTable
 	NewQueryCdpsParams          = types.NewQueryCdpsParams
 	NewQueryCdpParams           = types.NewQueryCdpParams
+	NewQueryCdpHistoryParams    = types.NewQueryCdpHistoryParams
+	NewQuerySimulateParams      = types.NewQuerySimulateParams
 	NewQueryCdpsByRatioParams   = types.NewQueryCdpsByRatioParams
+	NewQueryFeeRatesParams      = types.NewQueryFeeRatesParams
+	NewQuerySavingsParams       = types.NewQuerySavingsParams
+	NewSavingsDeposit           = types.NewSavingsDeposit
+	NewSavingsPool              = types.NewSavingsPool
+	NewSettlement               = types.NewSettlement
+	NewAuctionDebt              = types.NewAuctionDebt
+	NewSettlementPrice          = types.NewSettlementPrice
 	ValidSortableDec            = types.ValidSortableDec
 	SortableDecBytes            = types.SortableDecBytes
 	ParseDecBytes               = types.ParseDecBytes
 	RelativePow                 = types.RelativePow
 	NewKeeper                   = keeper.NewKeeper
 	NewQuerier                  = keeper.NewQuerier
+	RegisterInvariants          = keeper.RegisterInvariants
+	SavingsPayoutsInvariant     = keeper.SavingsPayoutsInvariant
+	SavingsDepositsInvariant    = keeper.SavingsDepositsInvariant
 
 	// variable aliases
 	ModuleCdc                  = types.ModuleCdc
+	BasketCdpIndexPrefix       = types.BasketCdpIndexPrefix
+	SavingsDepositKeyPrefix    = types.SavingsDepositKeyPrefix
+	SavingsRateIndexKeyPrefix  = types.SavingsRateIndexKeyPrefix
+	TotalSavingsKeyPrefix      = types.TotalSavingsKeyPrefix
+	SavingsDistributedPrefix   = types.SavingsDistributedPrefix
+	SurplusCollectedPrefix     = types.SurplusCollectedPrefix
+	SettlementKey              = types.SettlementKey
+	CdpHistoryKeyPrefix        = types.CdpHistoryKeyPrefix
+	CdpHistoryTimeIndexPrefix  = types.CdpHistoryTimeIndexPrefix
+	CdpHistorySequenceKey      = types.CdpHistorySequenceKey
+	RecoveredDebtPrefix        = types.RecoveredDebtPrefix
+	RealizedLossPrefix         = types.RealizedLossPrefix
+	AuctionDebtKeyPrefix       = types.AuctionDebtKeyPrefix
 	CdpIDKeyPrefix             = types.CdpIDKeyPrefix
 	CdpKeyPrefix               = types.CdpKeyPrefix
 	CollateralRatioIndexPrefix = types.CollateralRatioIndexPrefix
@@ -129,15 +238,28 @@ var (
 	KeyDebtParams              = types.KeyDebtParams
 	KeyCircuitBreaker          = types.KeyCircuitBreaker
 	KeyDebtThreshold           = types.KeyDebtThreshold
+	KeyDebtAuctionParam        = types.KeyDebtAuctionParam
 	KeySurplusThreshold        = types.KeySurplusThreshold
+	KeySurplusBuffer           = types.KeySurplusBuffer
+	KeyGlobalSettlement        = types.KeyGlobalSettlement
+	KeyHistoryRetention        = types.KeyHistoryRetention
+	KeyDustSweep               = types.KeyDustSweep
+	KeySurplusReserve          = types.KeySurplusReserve
+	RatioBucketBounds          = types.RatioBucketBounds
 	DefaultGlobalDebt          = types.DefaultGlobalDebt
 	DefaultCircuitBreaker      = types.DefaultCircuitBreaker
+	DefaultGlobalSettlement    = types.DefaultGlobalSettlement
+	DefaultHistoryRetention    = types.DefaultHistoryRetention
+	DefaultDustSweep           = types.DefaultDustSweep
+	DefaultSurplusReserve      = types.DefaultSurplusReserve
 	DefaultCollateralParams    = types.DefaultCollateralParams
 	DefaultDebtParams          = types.DefaultDebtParams
 	DefaultCdpStartingID       = types.DefaultCdpStartingID
 	DefaultDebtDenom           = types.DefaultDebtDenom
 	DefaultGovDenom            = types.DefaultGovDenom
 	DefaultSurplusThreshold    = types.DefaultSurplusThreshold
+	DefaultSurplusBuffer       = types.DefaultSurplusBuffer
+	DefaultDebtAuctionParam    = types.DefaultDebtAuctionParam
 	DefaultDebtThreshold       = types.DefaultDebtThreshold
 	DefaultPreviousBlockTime   = types.DefaultPreviousBlockTime
 	MaxSortableDec             = types.MaxSortableDec
@@ -148,6 +270,29 @@ type (
 	CDPs                   = types.CDPs
 	AugmentedCDP           = types.AugmentedCDP
 	AugmentedCDPs          = types.AugmentedCDPs
+	CDPHealth              = types.CDPHealth
+	CdpHistoryEntry        = types.CdpHistoryEntry
+	CdpHistory             = types.CdpHistory
+	LiquidationSimulation  = types.LiquidationSimulation
+	SimulatedAuction       = types.SimulatedAuction
+	SimulatedAuctions      = types.SimulatedAuctions
+	DebtAsset              = types.DebtAsset
+	DebtAssets             = types.DebtAssets
+	SystemSurplus          = types.SystemSurplus
+	AuctionDebt            = types.AuctionDebt
+	AuctionDebts           = types.AuctionDebts
+	Stats                  = types.Stats
+	CollateralStat         = types.CollateralStat
+	CollateralStats        = types.CollateralStats
+	RatioBucket            = types.RatioBucket
+	RatioBuckets           = types.RatioBuckets
+	SavingsDeposit         = types.SavingsDeposit
+	SavingsDeposits        = types.SavingsDeposits
+	SavingsPool            = types.SavingsPool
+	SavingsPools           = types.SavingsPools
+	Settlement             = types.Settlement
+	SettlementPrice        = types.SettlementPrice
+	SettlementPrices       = types.SettlementPrices
 	Deposit                = types.Deposit
 	Deposits               = types.Deposits
 	SupplyKeeper           = types.SupplyKeeper
@@ -158,13 +303,28 @@ type (
 	MsgWithdraw            = types.MsgWithdraw
 	MsgDrawDebt            = types.MsgDrawDebt
 	MsgRepayDebt           = types.MsgRepayDebt
+	MsgLiquidate           = types.MsgLiquidate
+	MsgDepositSavings      = types.MsgDepositSavings
+	MsgWithdrawSavings     = types.MsgWithdrawSavings
+	MsgRedeemDebt          = types.MsgRedeemDebt
+	MsgReclaimCollateral   = types.MsgReclaimCollateral
 	Params                 = types.Params
 	CollateralParam        = types.CollateralParam
 	CollateralParams       = types.CollateralParams
 	DebtParam              = types.DebtParam
 	DebtParams             = types.DebtParams
+	DebtAuctionParam       = types.DebtAuctionParam
+	ScheduledChange        = types.ScheduledChange
+	ScheduledChanges       = types.ScheduledChanges
+	DebtFeeRate            = types.DebtFeeRate
+	DebtFeeRates           = types.DebtFeeRates
+	CollateralFeeRates     = types.CollateralFeeRates
 	QueryCdpsParams        = types.QueryCdpsParams
 	QueryCdpParams         = types.QueryCdpParams
+	QueryCdpHistoryParams  = types.QueryCdpHistoryParams
+	QuerySimulateParams    = types.QuerySimulateParams
 	QueryCdpsByRatioParams = types.QueryCdpsByRatioParams
+	QueryFeeRatesParams    = types.QueryFeeRatesParams
+	QuerySavingsParams     = types.QuerySavingsParams
 	Keeper                 = keeper.Keeper
 )
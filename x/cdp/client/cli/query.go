@@ -2,9 +2,11 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -15,6 +17,21 @@ import (
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
+// Flags for cdp listing queries
+const (
+	flagOwner = "owner"
+	flagMinID = "min-id"
+	flagMaxID = "max-id"
+	flagSort  = "sort"
+	flagPage  = "page"
+	flagLimit = "limit"
+)
+
+// Flags for the liquidation simulation query
+const (
+	flagLiquidationRatio = "liquidation-ratio"
+)
+
 // GetQueryCmd returns the cli query commands for this module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	// Group nameservice queries under a subcommand
@@ -25,10 +42,20 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	cdpQueryCmd.AddCommand(client.GetCommands(
 		QueryCdpCmd(queryRoute, cdc),
+		QueryCdpHealthCmd(queryRoute, cdc),
+		QueryCdpHistoryCmd(queryRoute, cdc),
 		QueryCdpsByDenomCmd(queryRoute, cdc),
 		QueryCdpsByDenomAndRatioCmd(queryRoute, cdc),
 		QueryCdpDepositsCmd(queryRoute, cdc),
 		QueryParamsCmd(queryRoute, cdc),
+		QueryDebtAssetsCmd(queryRoute, cdc),
+		QueryFeeRatesCmd(queryRoute, cdc),
+		QuerySystemSurplusCmd(queryRoute, cdc),
+		QueryStatsCmd(queryRoute, cdc),
+		QueryLiquidationSimulationCmd(queryRoute, cdc),
+		QuerySavingsDepositCmd(queryRoute, cdc),
+		QuerySavingsPoolsCmd(queryRoute, cdc),
+		QuerySettlementCmd(queryRoute, cdc),
 	)...)
 
 	return cdpQueryCmd
@@ -77,23 +104,114 @@ $ %s query %s cdp kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw uatom
 	}
 }
 
+// QueryCdpHistoryCmd returns the command handler for querying the history of a cdp
+func QueryCdpHistoryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cdp-history [cdp-id]",
+		Short: "get the history of a cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the actions that changed a CDP, oldest first, by the CDP id. The history of closed and liquidated CDPs is kept until it is pruned.
+
+Example:
+$ %s query %s cdp-history 5
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			cdpID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("cdp-id %s not a valid uint, please input a valid cdp-id", args[0])
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryCdpHistoryParams(cdpID))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetCdpHistory)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var history types.CdpHistory
+			cdc.MustUnmarshalJSON(res, &history)
+			return cliCtx.PrintOutput(history)
+		},
+	}
+}
+
+// QueryCdpHealthCmd returns the command handler for querying the liquidation price and borrowing capacity of a cdp
+func QueryCdpHealthCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cdp-health [owner-addr] [collateral-name]",
+		Short: "get the liquidation price and borrowing capacity of a cdp",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the live fees, liquidation price, collateral available to withdraw, debt available to draw
+and time to liquidation at current fee rates of a CDP by the owner address and the collateral name.
+Each amount available to withdraw or draw assumes nothing else is withdrawn or drawn.
+
+Example:
+$ %s query %s cdp-health kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw uatom
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			ownerAddress, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.QueryCdpParams{
+				CollateralDenom: args[1],
+				Owner:           ownerAddress,
+			})
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetCdpHealth)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var health types.CDPHealth
+			cdc.MustUnmarshalJSON(res, &health)
+			return cliCtx.PrintOutput(health)
+		},
+	}
+}
+
 // QueryCdpsByDenomCmd returns the command handler for querying cdps for a collateral type
 func QueryCdpsByDenomCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "cdps [collateral-name]",
 		Short: "query CDPs by collateral",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`List all CDPs collateralized with the specified asset.
+CDPs can be filtered by owner and ID range, sorted by id, ratio, debt or collateral, and paginated.
 
 Example:
 $ %s query %s cdps uatom
-`, version.ClientName, types.ModuleName)),
+$ %s query %s cdps uatom --sort debt --page 2 --limit 50
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName)),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 
 			// Prepare params for querier
-			bz, err := cdc.MarshalJSON(types.QueryCdpsParams{CollateralDenom: args[0]})
+			params, err := parseCdpsListFlags(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(params)
 			if err != nil {
 				return err
 			}
@@ -111,21 +229,25 @@ $ %s query %s cdps uatom
 			return cliCtx.PrintOutput(cdps)
 		},
 	}
+	addCdpsListFlags(cmd)
+	return cmd
 }
 
 // QueryCdpsByDenomAndRatioCmd returns the command handler for querying cdps
 // that are under the specified collateral ratio
 func QueryCdpsByDenomAndRatioCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "cdps-by-ratio [collateral-name] [collateralization-ratio]",
 		Short: "get cdps under a collateralization ratio",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`List all CDPs under a specified collateralization ratio.
 Collateralization ratio is: collateral * price / debt.
+CDPs can be filtered by owner and ID range, sorted by ratio, id, debt or collateral, and paginated.
 
 Example:
 $ %s query %s cdps-by-ratio uatom 1.5
-`, version.ClientName, types.ModuleName)),
+$ %s query %s cdps-by-ratio uatom 1.5 --page 1 --limit 100
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName)),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -135,10 +257,12 @@ $ %s query %s cdps-by-ratio uatom 1.5
 			if errSdk != nil {
 				return fmt.Errorf(errSdk.Error())
 			}
-			bz, err := cdc.MarshalJSON(types.QueryCdpsByRatioParams{
-				CollateralDenom: args[0],
-				Ratio:           ratio,
-			})
+			listParams, err := parseCdpsListFlags(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQueryCdpsByRatioParams(args[0], ratio, listParams.Owner,
+				listParams.MinID, listParams.MaxID, listParams.SortBy, listParams.Page, listParams.Limit))
 			if err != nil {
 				return err
 			}
@@ -156,6 +280,8 @@ $ %s query %s cdps-by-ratio uatom 1.5
 			return cliCtx.PrintOutput(cdps)
 		},
 	}
+	addCdpsListFlags(cmd)
+	return cmd
 }
 
 // QueryCdpDepositsCmd returns the command handler for querying the deposits of a particular cdp
@@ -225,3 +351,279 @@ func QueryParamsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		},
 	}
 }
+
+// QueryDebtAssetsCmd returns the command handler for querying the state of each debt asset
+func QueryDebtAssetsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "debt-assets",
+		Short: "get the state of each debt asset",
+		Long:  "Get the debt denom, fee rate, total principal, and liquidator surplus and debt of each debt asset.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetDebtAssets)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var out types.DebtAssets
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// QueryFeeRatesCmd returns the command handler for querying the stability fees of a collateral type
+func QueryFeeRatesCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fee-rates [collateral-name]",
+		Short: "get the stability fees of a collateral type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the stability fee currently charged on each debt asset drawn against a collateral type, and the fee changes scheduled for it.
+
+Example:
+$ %s query %s fee-rates uatom
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			bz, err := cdc.MarshalJSON(types.NewQueryFeeRatesParams(args[0]))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetFeeRates)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var out types.CollateralFeeRates
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// QuerySystemSurplusCmd returns the command handler for querying the system surplus and bad debt
+func QuerySystemSurplusCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "system-surplus",
+		Short: "get the system surplus and bad debt",
+		Long:  "Get the surplus buffer, the surplus and bad debt held by the liquidator, the debt recovered and lost by its collateral auctions, and the number of pending liquidator auctions.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetSystemSurplus)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var out types.SystemSurplus
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// QueryStatsCmd returns the command handler for querying the aggregate collateral, debt and risk of the cdp system
+func QueryStatsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "stats",
+		Short: "get statistics of the cdp system",
+		Long:  "Get the total collateral, principal and debt limit utilization of each collateral type and of the whole system, the surplus and bad debt held by the liquidator, and the number of CDPs in each collateralization ratio bucket.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetStats)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var out types.Stats
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// QueryLiquidationSimulationCmd returns the command handler for simulating the liquidation of a collateral type at a hypothetical price
+func QueryLiquidationSimulationCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-liquidations [collateral-name] [price]",
+		Short: "simulate liquidations at a hypothetical collateral price",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the CDPs of a collateral type that would be liquidated at a hypothetical price, the collateral and debt they would seize,
+and the collateral auctions that would be started. The current liquidation ratio is used unless another one is set. No state is changed.
+
+Example:
+$ %s query %s simulate-liquidations uatom 2.5 --liquidation-ratio 1.75
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			price, sdkErr := sdk.NewDecFromStr(args[1])
+			if sdkErr != nil {
+				return sdkErr
+			}
+			liquidationRatio := sdk.ZeroDec()
+			if ratio := viper.GetString(flagLiquidationRatio); len(ratio) != 0 {
+				liquidationRatio, sdkErr = sdk.NewDecFromStr(ratio)
+				if sdkErr != nil {
+					return sdkErr
+				}
+			}
+			bz, err := cdc.MarshalJSON(types.NewQuerySimulateParams(args[0], price, liquidationRatio))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetLiquidationSimulation)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var out types.LiquidationSimulation
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+	cmd.Flags().String(flagLiquidationRatio, "", "(optional) hypothetical liquidation ratio. Defaults to the current liquidation ratio")
+	return cmd
+}
+
+// QuerySavingsDepositCmd returns the command handler for querying a savings deposit
+func QuerySavingsDepositCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "savings-deposit [depositor-addr] [denom]",
+		Short: "get a savings deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Get the savings deposit of a debt asset, including the savings earned so far.
+
+Example:
+$ %s query %s savings-deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw usdx
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Prepare params for querier
+			depositor, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			bz, err := cdc.MarshalJSON(types.NewQuerySavingsParams(depositor, args[1]))
+			if err != nil {
+				return err
+			}
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetSavingsDeposit)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var out types.SavingsDeposit
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// QuerySavingsPoolsCmd returns the command handler for querying the savings deposits of each debt asset
+func QuerySavingsPoolsCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "savings-pools",
+		Short: "get the savings deposits of each debt asset",
+		Long:  "Get the savings rate, total savings deposits, and savings paid from the liquidator surplus of each debt asset.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetSavingsPools)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var out types.SavingsPools
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// QuerySettlementCmd returns the command handler for querying the global settlement of the cdp system
+func QuerySettlementCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "settlement",
+		Short: "get the global settlement of the cdp system",
+		Long:  "Get the frozen collateral prices, and the collateral redeemable for debt assets, of a globally settled cdp system.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// Query
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryGetSettlement)
+			res, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			// Decode and print results
+			var out types.Settlement
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// addCdpsListFlags adds the filter, sort and pagination flags of cdp listing queries to the input command
+func addCdpsListFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagOwner, "", "(optional) filter by cdps belonging to owner")
+	cmd.Flags().Uint64(flagMinID, 0, "(optional) filter by cdps with an id of at least min-id")
+	cmd.Flags().Uint64(flagMaxID, 0, "(optional) filter by cdps with an id of at most max-id")
+	cmd.Flags().String(flagSort, "", "(optional) sort cdps by id, ratio (riskiest first), debt or collateral (largest first)")
+	cmd.Flags().Int(flagPage, 1, "(optional) page of cdps to query")
+	cmd.Flags().Int(flagLimit, 0, "(optional) limit the number of cdps per page. Defaults to all cdps")
+}
+
+// parseCdpsListFlags returns the cdp listing query params of the input collateral denom set by the command flags
+func parseCdpsListFlags(collateralDenom string) (types.QueryCdpsParams, error) {
+	var owner sdk.AccAddress
+	bechOwnerAddr := viper.GetString(flagOwner)
+	if len(bechOwnerAddr) != 0 {
+		ownerAddr, err := sdk.AccAddressFromBech32(bechOwnerAddr)
+		if err != nil {
+			return types.QueryCdpsParams{}, err
+		}
+		owner = ownerAddr
+	}
+	return types.NewQueryCdpsParams(collateralDenom, owner, viper.GetUint64(flagMinID), viper.GetUint64(flagMaxID),
+		viper.GetString(flagSort), viper.GetInt(flagPage), viper.GetInt(flagLimit)), nil
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -17,6 +18,11 @@ import (
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
+// Flags for withdrawing collateral and repaying debt
+const (
+	flagMode = "mode"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 	cdpTxCmd := &cobra.Command{
@@ -30,6 +36,11 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		GetCmdWithdraw(cdc),
 		GetCmdDraw(cdc),
 		GetCmdRepay(cdc),
+		GetCmdLiquidate(cdc),
+		GetCmdDepositSavings(cdc),
+		GetCmdWithdrawSavings(cdc),
+		GetCmdRedeemDebt(cdc),
+		GetCmdReclaimCollateral(cdc),
 	)...)
 
 	return cdpTxCmd
@@ -72,15 +83,17 @@ $ %s tx %s create 10000000uatom 1000usdx --from myKeyName
 // GetCmdDeposit cli command for depositing to a cdp.
 func GetCmdDeposit(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "deposit [owner-addr] [collateral]",
+		Use:   "deposit [owner-addr] [collateral] [cdp-denom]",
 		Short: "deposit collateral to an existing cdp",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Add collateral to an existing cdp.
+			fmt.Sprintf(`Add collateral to an existing cdp. The cdp denom selects the collateral type of the cdp
+and defaults to the denom of the collateral. It must be provided when depositing other collateral denoms into a cdp.
 
 Example:
 $ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom --from myKeyName
-`, version.ClientName, types.ModuleName)),
-		Args: cobra.ExactArgs(2),
+$ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 1000000pbnb uatom --from myKeyName
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName)),
+		Args: cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -93,7 +106,11 @@ $ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom --f
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgDeposit(owner, cliCtx.GetFromAddress(), collateral)
+			cdpDenom := ""
+			if len(args) == 3 {
+				cdpDenom = args[2]
+			}
+			msg := types.NewMsgDeposit(owner, cliCtx.GetFromAddress(), collateral, cdpDenom)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -105,16 +122,21 @@ $ %s tx %s deposit kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom --f
 
 // GetCmdWithdraw cli command for withdrawing from a cdp.
 func GetCmdWithdraw(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
-		Use:   "withdraw [owner-addr] [collateral]",
+	cmd := &cobra.Command{
+		Use:   "withdraw [owner-addr] [collateral] [cdp-denom]",
 		Short: "withdraw collateral from an existing cdp",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Remove collateral from an existing cdp.
+			fmt.Sprintf(`Remove collateral from an existing cdp. The cdp denom selects the collateral type of the cdp
+and defaults to the denom of the collateral.
+With --mode floor, the withdrawal of each collateral denom is lowered to the most that leaves the cdp at its liquidation ratio.
+With --mode all, the withdrawal of each collateral denom is lowered to the deposit, so the whole deposit is withdrawn.
 
 Example:
 $ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom --from myKeyName
-`, version.ClientName, types.ModuleName)),
-		Args: cobra.ExactArgs(2),
+$ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 1000000pbnb uatom --from myKeyName
+$ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom --mode floor --from myKeyName
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName)),
+		Args: cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
@@ -127,7 +149,11 @@ $ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom --
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgWithdraw(owner, cliCtx.GetFromAddress(), collateral)
+			cdpDenom := ""
+			if len(args) == 3 {
+				cdpDenom = args[2]
+			}
+			msg := types.NewMsgWithdraw(owner, cliCtx.GetFromAddress(), collateral, cdpDenom, viper.GetString(flagMode))
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -135,6 +161,8 @@ $ %s tx %s withdraw kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw 10000000uatom --
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+	cmd.Flags().String(flagMode, types.WithdrawModeExact, "(optional) withdrawal mode: floor to stop at the liquidation ratio, all to withdraw up to the whole deposit")
+	return cmd
 }
 
 // GetCmdDraw cli command for depositing to a cdp.
@@ -169,25 +197,184 @@ $ %s tx %s draw uatom 1000usdx --from myKeyName
 
 // GetCmdRepay cli command for depositing to a cdp.
 func GetCmdRepay(cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "repay [collateral-name] [debt]",
 		Short: "repay debt to an existing cdp",
 		Long: strings.TrimSpace(
 			fmt.Sprintf(`Cancel out debt in an existing cdp.
+With --mode floor, any payment that would leave debt below the debt floor is lowered to leave the debt floor.
+With --mode all, all the debt including fees is repaid and the collateral is returned; the debt argument, if given, is the maximum payment.
 
 Example:
 $ %s tx %s repay uatom 1000usdx --from myKeyName
+$ %s tx %s repay uatom --mode all --from myKeyName
+`, version.ClientName, types.ModuleName, version.ClientName, types.ModuleName)),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			payment := sdk.NewCoins()
+			if len(args) > 1 {
+				var err error
+				payment, err = sdk.ParseCoins(args[1])
+				if err != nil {
+					return err
+				}
+			}
+			msg := types.NewMsgRepayDebt(cliCtx.GetFromAddress(), args[0], payment, viper.GetString(flagMode))
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+	cmd.Flags().String(flagMode, types.RepayModeExact, "(optional) repayment mode: floor to leave the debt floor, all to repay all the debt")
+	return cmd
+}
+
+// GetCmdLiquidate cli command for liquidating an undercollateralized cdp.
+func GetCmdLiquidate(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "liquidate [owner-addr] [collateral-name]",
+		Short: "liquidate a cdp that is below the liquidation ratio",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Liquidate an undercollateralized cdp. The sender receives a share of the liquidation penalty as a reward.
+
+Example:
+$ %s tx %s liquidate kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw uatom --from myKeyName
 `, version.ClientName, types.ModuleName)),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
 			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
 
-			payment, err := sdk.ParseCoins(args[1])
+			borrower, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgLiquidate(cliCtx.GetFromAddress(), borrower, args[1])
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdDepositSavings cli command for depositing a debt asset to earn the savings rate.
+func GetCmdDepositSavings(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deposit-savings [amount]",
+		Short: "deposit a debt asset to earn the savings rate",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Lock a debt asset in a savings deposit, where it earns the savings rate paid from the system surplus.
+
+Example:
+$ %s tx %s deposit-savings 1000000usdx --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDepositSavings(cliCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdWithdrawSavings cli command for withdrawing a debt asset from a savings deposit.
+func GetCmdWithdrawSavings(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdraw-savings [amount]",
+		Short: "withdraw a debt asset from a savings deposit",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw a debt asset, including the savings earned on it, from a savings deposit.
+
+Example:
+$ %s tx %s withdraw-savings 1000000usdx --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgWithdrawSavings(cliCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRedeemDebt returns the command handler for redeeming a debt asset for collateral after a global settlement
+func GetCmdRedeemDebt(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "redeem-debt [amount]",
+		Short: "redeem a debt asset for collateral after a global settlement",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Burn a debt asset in exchange for a pro-rata share of the collateral backing all cdps at the global settlement.
+
+Example:
+$ %s tx %s redeem-debt 1000000usdx --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			amount, err := sdk.ParseCoin(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRedeemDebt(cliCtx.GetFromAddress(), amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdReclaimCollateral returns the command handler for reclaiming the excess collateral of a deposit after a global settlement
+func GetCmdReclaimCollateral(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "reclaim-collateral [owner-addr] [collateral-name]",
+		Short: "reclaim collateral from a cdp after a global settlement",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reclaim the collateral of a deposit that was not needed to cover the debt of the cdp at the global settlement.
+
+Example:
+$ %s tx %s reclaim-collateral kava15qdefkmwswysgg4qxgqpqr35k3m49pkx2jdfnw uatom --from myKeyName
+`, version.ClientName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgRepayDebt(cliCtx.GetFromAddress(), args[0], payment)
+			msg := types.NewMsgReclaimCollateral(owner, cliCtx.GetFromAddress(), args[1])
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -28,6 +28,7 @@ type PostDepositReq struct {
 	Owner      sdk.AccAddress `json:"owner" yaml:"owner"`
 	Depositor  sdk.AccAddress `json:"depositor" yaml:"depositor"`
 	Collateral sdk.Coins      `json:"collateral" yaml:"collateral"`
+	CdpDenom   string         `json:"cdp_denom" yaml:"cdp_denom"`
 }
 
 // PostWithdrawalReq defines the properties of cdp request's body.
@@ -36,6 +37,8 @@ type PostWithdrawalReq struct {
 	Owner      sdk.AccAddress `json:"owner" yaml:"owner"`
 	Depositor  sdk.AccAddress `json:"depositor" yaml:"depositor"`
 	Collateral sdk.Coins      `json:"collateral" yaml:"collateral"`
+	CdpDenom   string         `json:"cdp_denom" yaml:"cdp_denom"`
+	Mode       string         `json:"mode" yaml:"mode"`
 }
 
 // PostDrawReq defines the properties of cdp request's body.
@@ -52,4 +55,33 @@ type PostRepayReq struct {
 	Owner   sdk.AccAddress `json:"owner" yaml:"owner"`
 	Denom   string         `json:"denom" yaml:"denom"`
 	Payment sdk.Coins      `json:"payment" yaml:"payment"`
+	Mode    string         `json:"mode" yaml:"mode"`
+}
+
+// PostLiquidateReq defines the properties of a liquidation request's body. The cdp owner and collateral denom are taken from the path.
+type PostLiquidateReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Keeper  sdk.AccAddress `json:"keeper" yaml:"keeper"`
+}
+
+// PostSavingsReq defines the properties of a savings deposit or withdrawal request's body.
+type PostSavingsReq struct {
+	BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// PostRedeemDebtReq defines the properties of a debt redemption request's body.
+type PostRedeemDebtReq struct {
+	BaseReq rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Sender  sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount  sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// PostReclaimCollateralReq defines the properties of a collateral reclaim request's body.
+type PostReclaimCollateralReq struct {
+	BaseReq   rest.BaseReq   `json:"base_req" yaml:"base_req"`
+	Owner     sdk.AccAddress `json:"owner" yaml:"owner"`
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Denom     string         `json:"denom" yaml:"denom"`
 }
@@ -1,6 +1,7 @@
 package rest
 
 import (
+	"fmt"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -19,6 +20,11 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/cdp/{owner}/{denom}/withdraw", postWithdrawHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/cdp/{owner}/{denom}/draw", postDrawHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/cdp/{owner}/{denom}/repay", postRepayHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/cdp/{%s}/{%s}/liquidate", types.RestOwner, types.RestCollateralDenom), postLiquidateHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/cdp/savings/deposit", postDepositSavingsHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/cdp/savings/withdraw", postWithdrawSavingsHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/cdp/settlement/redeem", postRedeemDebtHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc("/cdp/{owner}/{denom}/reclaim", postReclaimCollateralHandlerFn(cliCtx)).Methods("POST")
 
 }
 
@@ -61,6 +67,7 @@ func postDepositHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			requestBody.Owner,
 			requestBody.Depositor,
 			requestBody.Collateral,
+			requestBody.CdpDenom,
 		)
 		utils.WriteGenerateStdTxResponse(w, cliCtx, requestBody.BaseReq, []sdk.Msg{msg})
 	}
@@ -83,6 +90,8 @@ func postWithdrawHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			requestBody.Owner,
 			requestBody.Depositor,
 			requestBody.Collateral,
+			requestBody.CdpDenom,
+			requestBody.Mode,
 		)
 		utils.WriteGenerateStdTxResponse(w, cliCtx, requestBody.BaseReq, []sdk.Msg{msg})
 	}
@@ -127,6 +136,123 @@ func postRepayHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			requestBody.Owner,
 			requestBody.Denom,
 			requestBody.Payment,
+			requestBody.Mode,
+		)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, requestBody.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postLiquidateHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Get the cdp from the path
+		vars := mux.Vars(r)
+		borrower, err := sdk.AccAddressFromBech32(vars[types.RestOwner])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		collateralDenom := vars[types.RestCollateralDenom]
+
+		// Decode POST request body
+		var requestBody PostLiquidateReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &requestBody) {
+			return
+		}
+		requestBody.BaseReq = requestBody.BaseReq.Sanitize()
+		if !requestBody.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// Create and return msg
+		msg := types.NewMsgLiquidate(
+			requestBody.Keeper,
+			borrower,
+			collateralDenom,
+		)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, requestBody.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postDepositSavingsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Decode POST request body
+		var requestBody PostSavingsReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &requestBody) {
+			return
+		}
+		requestBody.BaseReq = requestBody.BaseReq.Sanitize()
+		if !requestBody.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// Create and return msg
+		msg := types.NewMsgDepositSavings(
+			requestBody.Depositor,
+			requestBody.Amount,
+		)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, requestBody.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postWithdrawSavingsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Decode POST request body
+		var requestBody PostSavingsReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &requestBody) {
+			return
+		}
+		requestBody.BaseReq = requestBody.BaseReq.Sanitize()
+		if !requestBody.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// Create and return msg
+		msg := types.NewMsgWithdrawSavings(
+			requestBody.Depositor,
+			requestBody.Amount,
+		)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, requestBody.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postRedeemDebtHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Decode POST request body
+		var requestBody PostRedeemDebtReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &requestBody) {
+			return
+		}
+		requestBody.BaseReq = requestBody.BaseReq.Sanitize()
+		if !requestBody.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// Create and return msg
+		msg := types.NewMsgRedeemDebt(
+			requestBody.Sender,
+			requestBody.Amount,
+		)
+		utils.WriteGenerateStdTxResponse(w, cliCtx, requestBody.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postReclaimCollateralHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// Decode POST request body
+		var requestBody PostReclaimCollateralReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &requestBody) {
+			return
+		}
+		requestBody.BaseReq = requestBody.BaseReq.Sanitize()
+		if !requestBody.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// Create and return msg
+		msg := types.NewMsgReclaimCollateral(
+			requestBody.Owner,
+			requestBody.Depositor,
+			requestBody.Denom,
 		)
 		utils.WriteGenerateStdTxResponse(w, cliCtx, requestBody.BaseReq, []sdk.Msg{msg})
 	}
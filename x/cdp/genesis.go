@@ -26,6 +26,21 @@ func InitGenesis(ctx sdk.Context, k Keeper, pk PricefeedKeeper, gs GenesisState)
 			panic(fmt.Sprintf("%s collateral not found in pricefeed", col.Denom))
 		}
 	}
+	for _, dp := range gs.Params.DebtParams {
+		if dp.MarketID == "" {
+			continue
+		}
+		_, found := collateralMap[dp.MarketID]
+		if !found {
+			panic(fmt.Sprintf("%s debt asset market not found in pricefeed", dp.Denom))
+		}
+	}
+	if gs.Params.DebtAuctionParam.MarketID != "" {
+		_, found := collateralMap[gs.Params.DebtAuctionParam.MarketID]
+		if !found {
+			panic(fmt.Sprintf("%s governance token market not found in pricefeed", gs.Params.DebtAuctionParam.MarketID))
+		}
+	}
 
 	k.SetParams(ctx, gs.Params)
 
@@ -41,11 +56,10 @@ func InitGenesis(ctx sdk.Context, k Keeper, pk PricefeedKeeper, gs GenesisState)
 		if cdp.ID == gs.StartingCdpID {
 			panic(fmt.Sprintf("starting cdp id is assigned to an existing cdp: %s", cdp))
 		}
-		k.SetCDP(ctx, cdp)
 		k.IndexCdpByOwner(ctx, cdp)
 		ratio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
-		k.IndexCdpByCollateralRatio(ctx, cdp.Collateral[0].Denom, cdp.ID, ratio)
-		k.IncrementTotalPrincipal(ctx, cdp.Collateral[0].Denom, cdp.Principal)
+		k.SetCdpAndCollateralRatioIndex(ctx, cdp, ratio)
+		k.IncrementTotalPrincipal(ctx, cdp.Type, cdp.Principal)
 	}
 
 	k.SetNextCdpID(ctx, gs.StartingCdpID)
@@ -55,6 +69,43 @@ func InitGenesis(ctx sdk.Context, k Keeper, pk PricefeedKeeper, gs GenesisState)
 	for _, d := range gs.Deposits {
 		k.SetDeposit(ctx, d)
 	}
+	for _, sd := range gs.SavingsDeposits {
+		sd.Index = k.GetSavingsRateIndex(ctx, sd.Amount.Denom)
+		k.SetSavingsDeposit(ctx, sd)
+		k.SetTotalSavings(ctx, sd.Amount.Denom, k.GetTotalSavings(ctx, sd.Amount.Denom).Add(sd.Amount.Amount))
+	}
+
+	for _, sc := range gs.SurplusCollected {
+		k.SetSurplusCollected(ctx, sc.Denom, sc.Amount)
+	}
+	for _, sd := range gs.SavingsDistributed {
+		k.SetSavingsDistributed(ctx, sd.Denom, sd.Amount)
+	}
+	for _, rd := range gs.RecoveredDebt {
+		k.SetRecoveredDebt(ctx, rd.Denom, rd.Amount)
+	}
+	for _, rl := range gs.RealizedLoss {
+		k.SetRealizedLoss(ctx, rl.Denom, rl.Amount)
+	}
+	for _, ad := range gs.AuctionDebts {
+		k.SetAuctionDebt(ctx, ad)
+	}
+
+	// the next history entry is sequenced after all entries in the genesis state
+	nextHistorySequence := uint64(0)
+	for _, e := range gs.CdpHistory {
+		k.SetCdpHistoryEntry(ctx, e)
+		if e.Sequence >= nextHistorySequence {
+			nextHistorySequence = e.Sequence + 1
+		}
+	}
+	k.SetNextCdpHistorySequence(ctx, nextHistorySequence)
+
+	// a settled cdp system stays frozen
+	if gs.Settlement.IsActive() {
+		k.SetSettlement(ctx, gs.Settlement)
+	}
+
 	// only set the previous block time if it's different than default
 	if !gs.PreviousBlockTime.Equal(DefaultPreviousBlockTime) {
 		k.SetPreviousBlockTime(ctx, gs.PreviousBlockTime)
@@ -85,5 +136,32 @@ func ExportGenesis(ctx sdk.Context, k Keeper) GenesisState {
 		previousBlockTime = DefaultPreviousBlockTime
 	}
 
-	return NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousBlockTime)
+	// savings deposits are exported with the savings earned so far added to their amount
+	savingsDeposits := SavingsDeposits{}
+	k.IterateSavingsDeposits(ctx, func(deposit SavingsDeposit) (stop bool) {
+		deposit = k.SyncSavingsDeposit(ctx, deposit)
+		deposit.Index = sdk.OneDec()
+		savingsDeposits = append(savingsDeposits, deposit)
+		return false
+	})
+
+	settlement, _ := k.GetSettlement(ctx)
+
+	cdpHistory := CdpHistory{}
+	k.IterateCdpHistory(ctx, func(entry CdpHistoryEntry) (stop bool) {
+		cdpHistory = append(cdpHistory, entry)
+		return false
+	})
+
+	surplusCollected := k.GetAllSurplusCollected(ctx)
+	savingsDistributed := k.GetAllSavingsDistributed(ctx)
+	recoveredDebt := k.GetAllRecoveredDebt(ctx)
+	realizedLoss := k.GetAllRealizedLoss(ctx)
+	auctionDebts := AuctionDebts{}
+	k.IterateAuctionDebts(ctx, func(ad AuctionDebt) (stop bool) {
+		auctionDebts = append(auctionDebts, ad)
+		return false
+	})
+
+	return NewGenesisState(params, cdps, deposits, cdpID, debtDenom, govDenom, previousBlockTime, savingsDeposits, settlement, cdpHistory, surplusCollected, savingsDistributed, recoveredDebt, realizedLoss, auctionDebts)
 }
@@ -8,6 +8,8 @@ import (
 	"github.com/kava-labs/kava/x/cdp"
 
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
 )
 
 type GenesisTestSuite struct {
@@ -56,6 +58,39 @@ func (suite *GenesisTestSuite) TestValidGenState() {
 
 }
 
+func (suite *GenesisTestSuite) TestExportGenesis() {
+	tApp := app.NewTestApp()
+	cdpGS := NewCDPGenStateMulti()
+	gs := cdp.GenesisState{}
+	cdp.ModuleCdc.MustUnmarshalJSON(cdpGS["cdp"], &gs)
+	gs.SurplusCollected = sdk.NewCoins(sdk.NewInt64Coin("susd", 500), sdk.NewInt64Coin("usdx", 1000))
+	gs.SavingsDistributed = sdk.NewCoins(sdk.NewInt64Coin("usdx", 400))
+	gs.RecoveredDebt = sdk.NewCoins(sdk.NewInt64Coin("debt", 700))
+	gs.RealizedLoss = sdk.NewCoins(sdk.NewInt64Coin("debt", 300))
+	gs.AuctionDebts = cdp.AuctionDebts{cdp.NewAuctionDebt(4, sdk.NewInt64Coin("debt", 200))}
+	tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateMulti(),
+		app.GenesisState{cdp.ModuleName: cdp.ModuleCdc.MustMarshalJSON(gs)},
+	)
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+
+	exported := cdp.ExportGenesis(ctx, tApp.GetCDPKeeper())
+	suite.Equal(gs.SurplusCollected, exported.SurplusCollected)
+	suite.Equal(gs.SavingsDistributed, exported.SavingsDistributed)
+	suite.Equal(gs.RecoveredDebt, exported.RecoveredDebt)
+	suite.Equal(gs.RealizedLoss, exported.RealizedLoss)
+	suite.Equal(gs.AuctionDebts, exported.AuctionDebts)
+
+	// the exported state initializes a chain with the same state
+	tApp = app.NewTestApp()
+	tApp.InitializeFromGenesisStates(
+		NewPricefeedGenStateMulti(),
+		app.GenesisState{cdp.ModuleName: cdp.ModuleCdc.MustMarshalJSON(exported)},
+	)
+	ctx = tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	suite.True(exported.Equal(cdp.ExportGenesis(ctx, tApp.GetCDPKeeper())))
+}
+
 func TestGenesisTestSuite(t *testing.T) {
 	suite.Run(t, new(GenesisTestSuite))
 }
@@ -140,6 +140,7 @@ func NewCDPGenStateMulti() app.GenesisState {
 					ReferenceAsset:   "usd",
 					ConversionFactor: i(6),
 					DebtFloor:        i(10000000),
+					DebtDenom:        "susddebt",
 				},
 			},
 		},
@@ -207,6 +208,28 @@ func badGenStates() []badGenState {
 	g13 := baseGenState()
 	g13.GovDenom = ""
 
+	g14 := baseGenState()
+	g14.Params.HistoryRetention = -time.Hour
+
+	g15 := baseGenState()
+	g15.CdpHistory = cdp.CdpHistory{
+		cdp.NewCdpHistoryEntry(1, 0, cdp.EventTypeCreateCdp, nil, nil, 1, time.Unix(100, 0)),
+		cdp.NewCdpHistoryEntry(2, 0, cdp.EventTypeCreateCdp, nil, nil, 1, time.Unix(100, 0)),
+	}
+
+	g16 := baseGenState()
+	g16.Params.CollateralParams[0].AuctionType = "english"
+
+	g17 := baseGenState()
+	g17.Params.CollateralParams[0].ReserveDiscount = d("1.0")
+
+	g18 := baseGenState()
+	g18.Params.SurplusReserveDiscount = d("0.1")
+
+	g19 := baseGenState()
+	g19.SurplusCollected = sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000))
+	g19.SavingsDistributed = sdk.NewCoins(sdk.NewInt64Coin("usdx", 1001))
+
 	return []badGenState{
 		badGenState{Genesis: g1, Reason: "duplicate collateral denom"},
 		badGenState{Genesis: g2, Reason: "duplicate collateral prefix"},
@@ -220,6 +243,12 @@ func badGenStates() []badGenState {
 		badGenState{Genesis: g11, Reason: "negative auction size"},
 		badGenState{Genesis: g12, Reason: "invalid liquidation penalty"},
 		badGenState{Genesis: g13, Reason: "gov denom not set"},
+		badGenState{Genesis: g14, Reason: "negative history retention"},
+		badGenState{Genesis: g15, Reason: "duplicate cdp history sequence"},
+		badGenState{Genesis: g16, Reason: "invalid auction type"},
+		badGenState{Genesis: g17, Reason: "invalid reserve discount"},
+		badGenState{Genesis: g18, Reason: "surplus reserve without debt auction market"},
+		badGenState{Genesis: g19, Reason: "savings distributed exceed surplus collected"},
 	}
 }
 
@@ -261,6 +290,7 @@ func baseGenState() cdp.GenesisState {
 					ReferenceAsset:   "usd",
 					ConversionFactor: i(6),
 					DebtFloor:        i(10000000),
+					DebtDenom:        "susddebt",
 				},
 			},
 		},
@@ -2,11 +2,12 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
 const (
-	// factor for setting the initial value of gov tokens to sell at debt auctions -- assuming stable token is ~1 usd, this starts the auction with a price of $0.01 KAVA
+	// factor for setting the initial value of gov tokens to sell at debt auctions when there is no gov token market -- assuming stable token is ~1 usd, this starts the auction with a price of $0.01 KAVA
 	dump = 100
 )
 
@@ -109,6 +110,96 @@ func (k Keeper) AuctionCollateral(ctx sdk.Context, deposits types.Deposits, debt
 	return nil
 }
 
+// AuctionBasketCollateral creates auctions for each denom in the input collateral.
+// The debt is split between collateral denoms in proportion to their value, and each denom is auctioned separately.
+func (k Keeper) AuctionBasketCollateral(ctx sdk.Context, deposits types.Deposits, collateral sdk.Coins, debt sdk.Int, bidDenom string) sdk.Error {
+	if len(collateral) == 1 {
+		return k.AuctionCollateral(ctx, deposits, debt, bidDenom)
+	}
+	values := make([]sdk.Dec, len(collateral))
+	totalValue := sdk.ZeroDec()
+	for i, cc := range collateral {
+		value, err := k.calculateCollateralValue(ctx, cc)
+		if err != nil {
+			return err
+		}
+		values[i] = value
+		totalValue = totalValue.Add(value)
+	}
+	remainingDebt := debt
+	for i, cc := range collateral {
+		// the last denom takes the remaining debt so that rounding does not lose any
+		denomDebt := remainingDebt
+		if i < len(collateral)-1 {
+			if totalValue.IsZero() {
+				denomDebt = debt.QuoRaw(int64(len(collateral)))
+			} else {
+				denomDebt = sdk.NewDecFromInt(debt).Mul(values[i]).Quo(totalValue).TruncateInt()
+			}
+		}
+		remainingDebt = remainingDebt.Sub(denomDebt)
+
+		denomDeposits := types.Deposits{}
+		for _, dep := range deposits {
+			amount := dep.Amount.AmountOf(cc.Denom)
+			if amount.IsPositive() {
+				denomDeposits = append(denomDeposits, types.NewDeposit(dep.CdpID, dep.Depositor, sdk.NewCoins(sdk.NewCoin(cc.Denom, amount))))
+			}
+		}
+		err := k.AuctionCollateral(ctx, denomDeposits, denomDebt, bidDenom)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AuctionCollateralForDebt creates collateral auctions that raise each of the input debt assets.
+// When a cdp owes more than one debt asset, its collateral is split between them in proportion to the amount owed
+// and each share is auctioned for the corresponding debt asset.
+func (k Keeper) AuctionCollateralForDebt(ctx sdk.Context, deposits types.Deposits, collateral sdk.Coins, debt sdk.Coins) sdk.Error {
+	if len(debt) == 1 {
+		return k.AuctionBasketCollateral(ctx, deposits, collateral, debt[0].Amount, debt[0].Denom)
+	}
+	totalDebt := sdk.ZeroInt()
+	for _, dc := range debt {
+		totalDebt = totalDebt.Add(dc.Amount)
+	}
+	remaining := make(types.Deposits, len(deposits))
+	copy(remaining, deposits)
+	for i, dc := range debt {
+		shareDeposits := types.Deposits{}
+		shareCollateral := sdk.NewCoins()
+		for j, dep := range remaining {
+			// the last debt asset takes the remaining collateral so that rounding does not lose any
+			share := dep.Amount
+			if i < len(debt)-1 {
+				share = sdk.NewCoins()
+				for _, cc := range deposits[j].Amount {
+					amount := cc.Amount.QuoRaw(int64(len(debt)))
+					if totalDebt.IsPositive() {
+						amount = cc.Amount.Mul(dc.Amount).Quo(totalDebt)
+					}
+					share = share.Add(sdk.NewCoins(sdk.NewCoin(cc.Denom, amount)))
+				}
+			}
+			remaining[j].Amount = dep.Amount.Sub(share)
+			if !share.IsZero() {
+				shareDeposits = append(shareDeposits, types.NewDeposit(dep.CdpID, dep.Depositor, share))
+				shareCollateral = shareCollateral.Add(share)
+			}
+		}
+		if shareCollateral.IsZero() {
+			continue
+		}
+		err := k.AuctionBasketCollateral(ctx, shareDeposits, shareCollateral, dc.Amount, dc.Denom)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // CreateAuctionsFromDeposit creates auctions from the input deposit until there is less than auctionSize left on the deposit
 func (k Keeper) CreateAuctionsFromDeposit(ctx sdk.Context, dep types.Deposit, debt sdk.Int, totalCollateral sdk.Int, auctionSize sdk.Int, principalDenom string) (debtChange sdk.Int, collateralChange sdk.Int, err sdk.Error) {
 	debtChange = sdk.ZeroInt()
@@ -120,9 +211,9 @@ func (k Keeper) CreateAuctionsFromDeposit(ctx sdk.Context, dep types.Deposit, de
 		depositDebtAmount := (sdk.NewDecFromInt(auctionSize).Quo(sdk.NewDecFromInt(totalCollateral))).Mul(sdk.NewDecFromInt(debt)).RoundInt()
 		penalty := k.ApplyLiquidationPenalty(ctx, depositDenom, depositDebtAmount)
 		// start an auction for one lot, attempting to raise depositDebtAmount plus the liquidation penalty
-		_, err := k.auctionKeeper.StartCollateralAuction(
-			ctx, types.LiquidatorMacc, sdk.NewCoin(depositDenom, auctionSize), sdk.NewCoin(principalDenom, depositDebtAmount.Add(penalty)), []sdk.AccAddress{dep.Depositor},
-			[]sdk.Int{auctionSize}, sdk.NewCoin(k.GetDebtDenom(ctx), depositDebtAmount))
+		err := k.startCollateralAuction(
+			ctx, sdk.NewCoin(depositDenom, auctionSize), sdk.NewCoin(principalDenom, depositDebtAmount.Add(penalty)), []sdk.AccAddress{dep.Depositor},
+			[]sdk.Int{auctionSize}, sdk.NewCoin(k.GetInternalDebtDenom(ctx, principalDenom), depositDebtAmount))
 		if err != nil {
 			return sdk.ZeroInt(), sdk.ZeroInt(), err
 		}
@@ -148,7 +239,7 @@ func (k Keeper) CreateAuctionFromPartialDeposits(ctx sdk.Context, partialDeps pa
 		returnWeights = append(returnWeights, pd.DebtShare)
 	}
 	penalty := k.ApplyLiquidationPenalty(ctx, depositDenom, partialDeps.SumDebt())
-	_, err = k.auctionKeeper.StartCollateralAuction(ctx, types.LiquidatorMacc, sdk.NewCoin(partialDeps[0].Amount[0].Denom, auctionSize), sdk.NewCoin(bidDenom, partialDeps.SumDebt().Add(penalty)), returnAddrs, returnWeights, sdk.NewCoin(k.GetDebtDenom(ctx), partialDeps.SumDebt()))
+	err = k.startCollateralAuction(ctx, sdk.NewCoin(partialDeps[0].Amount[0].Denom, auctionSize), sdk.NewCoin(bidDenom, partialDeps.SumDebt().Add(penalty)), returnAddrs, returnWeights, sdk.NewCoin(k.GetInternalDebtDenom(ctx, bidDenom), partialDeps.SumDebt()))
 	if err != nil {
 		return sdk.ZeroInt(), sdk.ZeroInt(), err
 	}
@@ -157,35 +248,94 @@ func (k Keeper) CreateAuctionFromPartialDeposits(ctx sdk.Context, partialDeps pa
 	return debtChange, collateralChange, nil
 }
 
-// NetSurplusAndDebt burns surplus and debt coins equal to the minimum of surplus and debt balances held by the liquidator module account
-// for example, if there is 1000 debt and 100 surplus, 100 surplus and 100 debt are burned, netting to 900 debt
-func (k Keeper) NetSurplusAndDebt(ctx sdk.Context) sdk.Error {
-	totalSurplus := k.GetTotalSurplus(ctx, types.LiquidatorMacc)
-	debt := k.GetTotalDebt(ctx, types.LiquidatorMacc)
-	netAmount := sdk.MinInt(totalSurplus, debt)
-	if netAmount.IsZero() {
-		return nil
-	}
-	err := k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(k.GetDebtDenom(ctx), netAmount)))
+// startCollateralAuction starts an auction of seized collateral with the auction type set in the collateral's params.
+// Dutch auctions start from the current price of the collateral, in units of the debt asset.
+// Collateral auctions are reserved at the current price less the collateral's reserve discount, if it has one.
+func (k Keeper) startCollateralAuction(ctx sdk.Context, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) sdk.Error {
+	cp, _ := k.GetCollateral(ctx, lot.Denom)
+	price, err := k.collateralAuctionPrice(ctx, lot, maxBid.Denom)
 	if err != nil {
 		return err
 	}
-	for netAmount.GT(sdk.ZeroInt()) {
-		for _, dp := range k.GetParams(ctx).DebtParams {
-			balance := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins().AmountOf(dp.Denom)
-			if balance.LT(netAmount) {
-				err = k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, balance)))
-				if err != nil {
-					return err
-				}
-				netAmount = netAmount.Sub(balance)
-			} else {
-				err = k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, netAmount)))
-				if err != nil {
-					return err
-				}
-				netAmount = sdk.ZeroInt()
-			}
+	if cp.AuctionType != types.AuctionTypeDutch {
+		_, err = k.auctionKeeper.StartCollateralAuction(ctx, types.LiquidatorMacc, lot, maxBid, lotReturnAddrs, lotReturnWeights, debt, price)
+		return err
+	}
+	_, err = k.auctionKeeper.StartDutchAuction(ctx, types.LiquidatorMacc, lot, maxBid, price, lotReturnAddrs, lotReturnWeights, debt)
+	return err
+}
+
+// collateralAuctionPrice returns the price a collateral auction of the lot for the debt denom is started with, the market price
+// for dutch auctions and the reserve price for other auctions.
+func (k Keeper) collateralAuctionPrice(ctx sdk.Context, lot sdk.Coin, debtDenom string) (sdk.Dec, sdk.Error) {
+	cp, _ := k.GetCollateral(ctx, lot.Denom)
+	if cp.AuctionType == types.AuctionTypeDutch {
+		return k.collateralMarketPrice(ctx, lot, debtDenom)
+	}
+	return k.collateralReservePrice(ctx, lot, debtDenom)
+}
+
+// collateralReservePrice returns the reserve price of collateral auctions selling the lot for the debt denom, the current collateral
+// price less the reserve discount of the collateral type, or zero if the collateral type has no reserve.
+func (k Keeper) collateralReservePrice(ctx sdk.Context, lot sdk.Coin, debtDenom string) (sdk.Dec, sdk.Error) {
+	cp, _ := k.GetCollateral(ctx, lot.Denom)
+	if !cp.HasReserve() {
+		return sdk.ZeroDec(), nil
+	}
+	marketPrice, err := k.collateralMarketPrice(ctx, lot, debtDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return marketPrice.Mul(sdk.OneDec().Sub(cp.ReserveDiscount)), nil
+}
+
+// ReservePrice returns the current reserve price of a surplus or collateral auction started by the liquidator. The auction module
+// calls it to reprice auctions it relists after they end without a bid at their reserve price. Other auctions have no reserve.
+func (k Keeper) ReservePrice(ctx sdk.Context, auction auctiontypes.Auction) (sdk.Dec, sdk.Error) {
+	switch a := auction.(type) {
+	case auctiontypes.SurplusAuction:
+		return k.SurplusReservePrice(ctx, a.Lot.Denom)
+	case auctiontypes.CollateralAuction:
+		return k.collateralReservePrice(ctx, a.Lot, a.MaxBid.Denom)
+	}
+	return sdk.ZeroDec(), nil
+}
+
+// collateralMarketPrice returns the current price of one unit of the lot in units of the debt asset.
+// The collateral value is converted from the market quote asset into the debt asset at the debt asset's market price.
+func (k Keeper) collateralMarketPrice(ctx sdk.Context, lot sdk.Coin, debtDenom string) (sdk.Dec, sdk.Error) {
+	value, err := k.calculateCollateralValue(ctx, lot)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	dp, _ := k.GetDebtParam(ctx, debtDenom)
+	debtPrice, err := k.getDebtPrice(ctx, dp)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return value.Quo(debtPrice).MulInt(sdk.NewIntWithDecimal(1, int(dp.ConversionFactor.Int64()))).QuoInt(lot.Amount), nil
+}
+
+// NetSurplusAndDebt burns surplus and debt coins equal to the minimum of surplus and debt balances held by the liquidator module account
+// for example, if there is 1000 debt and 100 surplus, 100 surplus and 100 debt are burned, netting to 900 debt.
+// Each internal debt denom is netted against the surplus of the debt asset it tracks.
+// Surplus retained in the surplus buffer is netted too, so the buffer absorbs bad debt before any debt auctions start.
+func (k Keeper) NetSurplusAndDebt(ctx sdk.Context) sdk.Error {
+	debtDenoms, debtParams := k.getDebtParamsByDebtDenom(ctx)
+	for _, debtDenom := range debtDenoms {
+		liquidatorCoins := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins()
+		surplusDenom := debtParams[debtDenom].Denom
+		netAmount := sdk.MinInt(liquidatorCoins.AmountOf(surplusDenom), liquidatorCoins.AmountOf(debtDenom))
+		if netAmount.IsZero() {
+			continue
+		}
+		err := k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(debtDenom, netAmount)))
+		if err != nil {
+			return err
+		}
+		err = k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(surplusDenom, netAmount)))
+		if err != nil {
+			return err
 		}
 	}
 	return nil
@@ -202,29 +352,112 @@ func (k Keeper) GetTotalSurplus(ctx sdk.Context, accountName string) sdk.Int {
 	return totalSurplus
 }
 
-// GetTotalDebt returns the total amount of debt tokens held by the liquidator module account
+// GetTotalDebt returns the total amount of debt tokens, across all internal debt denoms, held by the liquidator module account
 func (k Keeper) GetTotalDebt(ctx sdk.Context, accountName string) sdk.Int {
 	acc := k.supplyKeeper.GetModuleAccount(ctx, accountName)
-	debt := acc.GetCoins().AmountOf(k.GetDebtDenom(ctx))
+	debtDenoms, _ := k.getDebtParamsByDebtDenom(ctx)
+	debt := sdk.ZeroInt()
+	for _, debtDenom := range debtDenoms {
+		debt = debt.Add(acc.GetCoins().AmountOf(debtDenom))
+	}
 	return debt
 }
 
-// RunSurplusAndDebtAuctions nets the surplus and debt balances and then creates surplus or debt auctions if the remaining balance is above the auction threshold parameter
+// GetSystemSurplus returns the surplus buffer, the surplus and bad debt held by the liquidator module account,
+// and the number of auctions started by the liquidator module account that have not closed yet along with the debt they escrow
+func (k Keeper) GetSystemSurplus(ctx sdk.Context) types.SystemSurplus {
+	params := k.GetParams(ctx)
+	coins := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins()
+	surplus := sdk.NewCoins()
+	for _, dp := range params.DebtParams {
+		surplus = surplus.Add(sdk.NewCoins(sdk.NewCoin(dp.Denom, coins.AmountOf(dp.Denom))))
+	}
+	badDebt := sdk.NewCoins()
+	debtDenoms, _ := k.getDebtParamsByDebtDenom(ctx)
+	for _, debtDenom := range debtDenoms {
+		badDebt = badDebt.Add(sdk.NewCoins(sdk.NewCoin(debtDenom, coins.AmountOf(debtDenom))))
+	}
+	auctionDebt := sdk.NewCoins()
+	var surplusAuctions, debtAuctions, collateralAuctions uint64
+	k.auctionKeeper.IterateAuctions(ctx, func(a auctiontypes.Auction) bool {
+		if a.GetInitiator() != types.LiquidatorMacc {
+			return false
+		}
+		var debt sdk.Coin
+		switch auc := a.(type) {
+		case auctiontypes.SurplusAuction:
+			surplusAuctions++
+		case auctiontypes.DebtAuction:
+			debtAuctions++
+			debt = auc.CorrespondingDebt
+		case auctiontypes.CollateralAuction:
+			collateralAuctions++
+			debt = auc.CorrespondingDebt
+		case auctiontypes.DutchAuction:
+			collateralAuctions++
+			debt = auc.CorrespondingDebt
+		case auctiontypes.SealedBidAuction:
+			if auc.Kind == auctiontypes.SealedBidKindDebt {
+				debtAuctions++
+			} else {
+				surplusAuctions++
+			}
+			debt = auc.CorrespondingDebt
+		}
+		if debt.IsValid() && debt.IsPositive() {
+			auctionDebt = auctionDebt.Add(sdk.NewCoins(debt))
+		}
+		return false
+	})
+	return types.NewSystemSurplus(params.SurplusBuffer, surplus, badDebt, auctionDebt, k.GetAllRecoveredDebt(ctx), k.GetAllRealizedLoss(ctx), surplusAuctions, debtAuctions, collateralAuctions)
+}
+
+// RunSurplusAndDebtAuctions nets the surplus and debt balances and then creates surplus or debt auctions if the remaining balance is above the auction threshold parameter.
+// Debt auctions are run separately for each internal debt denom, and surplus auctions for each debt asset.
+// Surplus auctions only sell the surplus held above the surplus buffer. If the reserve price of a surplus auction can not be priced,
+// the auction is started without a reserve.
 func (k Keeper) RunSurplusAndDebtAuctions(ctx sdk.Context) sdk.Error {
-	k.NetSurplusAndDebt(ctx)
-	remainingDebt := k.GetTotalDebt(ctx, types.LiquidatorMacc)
+	err := k.NetSurplusAndDebt(ctx)
+	if err != nil {
+		return err
+	}
 	params := k.GetParams(ctx)
-	if remainingDebt.GTE(params.DebtAuctionThreshold) {
-		_, err := k.auctionKeeper.StartDebtAuction(ctx, types.LiquidatorMacc, sdk.NewCoin("usdx", remainingDebt), sdk.NewCoin(k.GetGovDenom(ctx), remainingDebt.Mul(sdk.NewInt(dump))), sdk.NewCoin(k.GetDebtDenom(ctx), remainingDebt))
-		if err != nil {
-			return err
+	debtDenoms, debtParams := k.getDebtParamsByDebtDenom(ctx)
+	for _, debtDenom := range debtDenoms {
+		remainingDebt := k.getModAccountDebt(ctx, types.LiquidatorMacc, debtDenom)
+		if remainingDebt.GTE(params.DebtAuctionThreshold) {
+			bidDenom := debtParams[debtDenom].Denom
+			lot, auctionDebt, err := k.CalculateDebtAuctionLot(ctx, sdk.NewCoin(bidDenom, remainingDebt))
+			if err != nil {
+				return err
+			}
+			if auctionDebt.IsZero() {
+				continue
+			}
+			_, err = k.auctionKeeper.StartDebtAuction(ctx, types.LiquidatorMacc, sdk.NewCoin(bidDenom, auctionDebt), sdk.NewCoin(k.GetGovDenom(ctx), lot), sdk.NewCoin(debtDenom, auctionDebt))
+			if err != nil {
+				return err
+			}
 		}
 	}
-	remainingSurplus := k.GetTotalSurplus(ctx, types.LiquidatorMacc)
-	if remainingSurplus.GTE(params.SurplusAuctionThreshold) {
-		for _, dp := range params.DebtParams {
-			surplusLot := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins().AmountOf(dp.Denom)
-			_, err := k.auctionKeeper.StartSurplusAuction(ctx, types.LiquidatorMacc, sdk.NewCoin(dp.Denom, surplusLot), k.GetGovDenom(ctx))
+	for _, dp := range params.DebtParams {
+		surplus := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins().AmountOf(dp.Denom)
+		surplusLot := surplus.Sub(k.GetSurplusBuffer(ctx, dp.Denom))
+		if surplusLot.GTE(params.SurplusAuctionThreshold) {
+			reservePrice, err := k.SurplusReservePrice(ctx, dp.Denom)
+			if err != nil {
+				// start the auction without a reserve rather than holding the surplus back while the price is unavailable
+				reservePrice = sdk.ZeroDec()
+				ctx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeSurplusReserveSkip,
+						sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+						sdk.NewAttribute(types.AttributeKeyDebtDenom, dp.Denom),
+						sdk.NewAttribute(types.AttributeKeyError, err.Result().Log),
+					),
+				)
+			}
+			_, err = k.auctionKeeper.StartSurplusAuction(ctx, types.LiquidatorMacc, sdk.NewCoin(dp.Denom, surplusLot), k.GetGovDenom(ctx), reservePrice)
 			if err != nil {
 				return err
 			}
@@ -232,3 +465,70 @@ func (k Keeper) RunSurplusAndDebtAuctions(ctx sdk.Context) sdk.Error {
 	}
 	return nil
 }
+
+// CalculateDebtAuctionLot returns the initial lot of governance tokens for a debt auction raising the input debt, and the amount of debt the auction covers.
+// The lot is worth the debt, at the current prices of the debt asset and the governance token, multiplied by the lot multiplier.
+// If the lot is above the max lot, it is capped and the auction only covers the matching share of the debt; the rest is auctioned in later blocks.
+// Without a governance token market, the lot is a fixed multiple of the debt.
+func (k Keeper) CalculateDebtAuctionLot(ctx sdk.Context, debt sdk.Coin) (lot sdk.Int, auctionDebt sdk.Int, err sdk.Error) {
+	dap := k.GetParams(ctx).DebtAuctionParam
+	if dap.MarketID == "" {
+		lot = debt.Amount.Mul(sdk.NewInt(dump))
+	} else {
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, dap.MarketID)
+		if err != nil {
+			return sdk.ZeroInt(), sdk.ZeroInt(), err
+		}
+		if !price.Price.IsPositive() {
+			return sdk.ZeroInt(), sdk.ZeroInt(), types.ErrInvalidPrice(k.codespace, dap.MarketID, price.Price)
+		}
+		debtValue, err := k.calculateDebtValue(ctx, sdk.NewCoins(debt))
+		if err != nil {
+			return sdk.ZeroInt(), sdk.ZeroInt(), err
+		}
+		govBaseUnits := debtValue.Mul(dap.GetLotMultiplier()).Quo(price.Price)
+		lot = govBaseUnits.Mul(sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, int(dap.ConversionFactor.Int64())))).TruncateInt()
+	}
+	if dap.HasMaxLot() && lot.GT(dap.MaxLot) {
+		auctionDebt = debt.Amount.Mul(dap.MaxLot).Quo(lot)
+		return dap.MaxLot, auctionDebt, nil
+	}
+	return lot, debt.Amount, nil
+}
+
+// SurplusReservePrice returns the reserve price of surplus auctions of the input debt asset, in governance tokens per unit of the debt asset.
+// It is the current price of the debt asset in governance tokens less the surplus reserve discount, or 0 if surplus auctions have no reserve.
+func (k Keeper) SurplusReservePrice(ctx sdk.Context, debtDenom string) (sdk.Dec, sdk.Error) {
+	params := k.GetParams(ctx)
+	dap := params.DebtAuctionParam
+	if !params.HasSurplusReserve() || dap.MarketID == "" {
+		return sdk.ZeroDec(), nil
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, dap.MarketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !price.Price.IsPositive() {
+		return sdk.Dec{}, types.ErrInvalidPrice(k.codespace, dap.MarketID, price.Price)
+	}
+	debtValue, err := k.calculateDebtValue(ctx, sdk.NewCoins(sdk.NewCoin(debtDenom, sdk.OneInt())))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	govBaseUnits := debtValue.Quo(price.Price)
+	marketPrice := govBaseUnits.Mul(sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, int(dap.ConversionFactor.Int64()))))
+	return marketPrice.Mul(sdk.OneDec().Sub(params.SurplusReserveDiscount)), nil
+}
+
+// getDebtParamsByDebtDenom returns the internal debt denoms, in the order of the debt params, and the debt param each of them tracks.
+// Params validation ensures each debt asset has its own internal debt denom.
+func (k Keeper) getDebtParamsByDebtDenom(ctx sdk.Context) ([]string, map[string]types.DebtParam) {
+	var debtDenoms []string
+	debtParams := make(map[string]types.DebtParam)
+	for _, dp := range k.GetParams(ctx).DebtParams {
+		debtDenom := k.GetInternalDebtDenom(ctx, dp.Denom)
+		debtDenoms = append(debtDenoms, debtDenom)
+		debtParams[debtDenom] = dp
+	}
+	return debtDenoms, debtParams
+}
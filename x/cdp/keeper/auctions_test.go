@@ -2,12 +2,14 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/auction"
 	"github.com/kava-labs/kava/x/cdp/keeper"
 	"github.com/kava-labs/kava/x/cdp/types"
+	"github.com/kava-labs/kava/x/pricefeed"
 
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -60,6 +62,36 @@ func (suite *AuctionTestSuite) TestSurplusAuction() {
 	suite.Equal(cs(c("usdx", 9000000000)), acc.GetCoins())
 }
 
+func (suite *AuctionTestSuite) TestSurplusBuffer() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SurplusBuffer = cs(c("usdx", 5000000000))
+	suite.keeper.SetParams(suite.ctx, params)
+	sk := suite.app.GetSupplyKeeper()
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 10000000000), c("debt", 1000000000)))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.RunSurplusAndDebtAuctions(suite.ctx))
+	acc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(cs(c("usdx", 4000000000)), acc.GetCoins())
+	acc = sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc)
+	suite.Equal(cs(c("usdx", 5000000000)), acc.GetCoins())
+
+	// surplus above the buffer is below the auction threshold
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 500000000)))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.RunSurplusAndDebtAuctions(suite.ctx))
+	acc = sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc)
+	suite.Equal(cs(c("usdx", 5500000000)), acc.GetCoins())
+
+	// the buffer absorbs bad debt
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 2000000000)))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.RunSurplusAndDebtAuctions(suite.ctx))
+	acc = sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc)
+	suite.Equal(cs(c("usdx", 3500000000)), acc.GetCoins())
+	acc = sk.GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(cs(c("usdx", 4000000000)), acc.GetCoins())
+}
+
 func (suite *AuctionTestSuite) TestDebtAuction() {
 	sk := suite.app.GetSupplyKeeper()
 	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 1000000000)))
@@ -71,6 +103,192 @@ func (suite *AuctionTestSuite) TestDebtAuction() {
 	suite.Equal(cs(c("debt", 9000000000)), acc.GetCoins())
 }
 
+func (suite *AuctionTestSuite) TestNetDebtSurplusPerDebtDenom() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParams[1].DebtDenom = "susddebt"
+	suite.keeper.SetParams(suite.ctx, params)
+	sk := suite.app.GetSupplyKeeper()
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 100), c("susddebt", 50), c("usdx", 10), c("susd", 80)))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.NetSurplusAndDebt(suite.ctx))
+	acc := sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc)
+	suite.Equal(cs(c("debt", 90), c("susd", 30)), acc.GetCoins())
+	suite.Equal(i(90), suite.keeper.GetTotalDebt(suite.ctx, types.LiquidatorMacc))
+}
+
+func (suite *AuctionTestSuite) TestDebtAuctionPerDebtDenom() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParams[1].DebtDenom = "susddebt"
+	suite.keeper.SetParams(suite.ctx, params)
+	sk := suite.app.GetSupplyKeeper()
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("susddebt", 10000000000), c("usdx", 1000000000)))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.RunSurplusAndDebtAuctions(suite.ctx))
+	acc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(cs(c("susddebt", 10000000000), c("usdx", 1000000000)), acc.GetCoins())
+
+	var bidDenoms []string
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		if da, ok := a.(auction.DebtAuction); ok {
+			bidDenoms = append(bidDenoms, da.Bid.Denom)
+		}
+		return false
+	})
+	suite.Equal([]string{"susd"}, bidDenoms)
+}
+
+func (suite *AuctionTestSuite) TestCalculateDebtAuctionLot() {
+	lot, auctionDebt, err := suite.keeper.CalculateDebtAuctionLot(suite.ctx, c("usdx", 9000000000))
+	suite.NoError(err)
+	suite.Equal(i(900000000000), lot)
+	suite.Equal(i(9000000000), auctionDebt)
+
+	suite.setDebtAuctionParam(types.NewDebtAuctionParam("kava:usd", i(6), d("1.2"), sdk.ZeroInt()))
+	lot, auctionDebt, err = suite.keeper.CalculateDebtAuctionLot(suite.ctx, c("usdx", 9000000000))
+	suite.NoError(err)
+	suite.Equal(i(21600000000), lot)
+	suite.Equal(i(9000000000), auctionDebt)
+
+	suite.setDebtAuctionParam(types.NewDebtAuctionParam("kava:usd", i(6), d("1.2"), i(10800000000)))
+	lot, auctionDebt, err = suite.keeper.CalculateDebtAuctionLot(suite.ctx, c("usdx", 9000000000))
+	suite.NoError(err)
+	suite.Equal(i(10800000000), lot)
+	suite.Equal(i(4500000000), auctionDebt)
+}
+
+func (suite *AuctionTestSuite) TestDebtAuctionLotDebtPrice() {
+	suite.setDebtAuctionParam(types.NewDebtAuctionParam("kava:usd", i(6), d("1.2"), sdk.ZeroInt()))
+	pk := suite.app.GetPriceFeedKeeper()
+	pfParams := pk.GetParams(suite.ctx)
+	pfParams.Markets = append(pfParams.Markets, pricefeed.Market{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true})
+	pk.SetParams(suite.ctx, pfParams)
+	_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, "usdx:usd", d("2"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.NoError(err)
+	suite.NoError(pk.SetCurrentPrices(suite.ctx, "usdx:usd"))
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParams[0].MarketID = "usdx:usd"
+	params.SurplusReserveDiscount = d("0.25")
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// 9000 usdx at 2 usd is worth 36000 kava at 0.5 usd, times the lot multiplier
+	lot, auctionDebt, err := suite.keeper.CalculateDebtAuctionLot(suite.ctx, c("usdx", 9000000000))
+	suite.NoError(err)
+	suite.Equal(i(43200000000), lot)
+	suite.Equal(i(9000000000), auctionDebt)
+
+	// one usdx is worth 4 kava, less the discount
+	reservePrice, err := suite.keeper.SurplusReservePrice(suite.ctx, "usdx")
+	suite.NoError(err)
+	suite.Equal(d("3"), reservePrice)
+}
+
+func (suite *AuctionTestSuite) TestDebtAuctionMaxLot() {
+	suite.setDebtAuctionParam(types.NewDebtAuctionParam("kava:usd", i(6), d("1.2"), i(10800000000)))
+	sk := suite.app.GetSupplyKeeper()
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 1000000000), c("debt", 10000000000)))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.RunSurplusAndDebtAuctions(suite.ctx))
+	acc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(cs(c("debt", 4500000000)), acc.GetCoins())
+	acc = sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc)
+	suite.Equal(cs(c("debt", 4500000000)), acc.GetCoins())
+
+	var lots sdk.Coins
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		if da, ok := a.(auction.DebtAuction); ok {
+			lots = lots.Add(sdk.NewCoins(da.Lot))
+		}
+		return false
+	})
+	suite.Equal(cs(c("ukava", 10800000000)), lots)
+}
+
+func (suite *AuctionTestSuite) TestDebtAuctionConversionFactor() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtAuctionParam = types.NewDebtAuctionParam("kava:usd", i(types.MaxConversionFactor), d("1.2"), sdk.ZeroInt())
+	suite.NoError(params.Validate())
+	params.DebtAuctionParam.ConversionFactor = i(types.MaxConversionFactor + 1)
+	suite.Error(params.Validate())
+}
+
+func (suite *AuctionTestSuite) TestSurplusAuctionReserve() {
+	reservePrice, err := suite.keeper.SurplusReservePrice(suite.ctx, "usdx")
+	suite.NoError(err)
+	suite.Equal(sdk.ZeroDec(), reservePrice)
+
+	// the reserve is the usdx price of 2 kava, less the discount
+	suite.setDebtAuctionParam(types.NewDebtAuctionParam("kava:usd", i(6), d("1.2"), sdk.ZeroInt()))
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SurplusReserveDiscount = d("0.25")
+	suite.keeper.SetParams(suite.ctx, params)
+	reservePrice, err = suite.keeper.SurplusReservePrice(suite.ctx, "usdx")
+	suite.NoError(err)
+	suite.Equal(d("1.5"), reservePrice)
+
+	sk := suite.app.GetSupplyKeeper()
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 10000000000), c("debt", 1000000000)))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.RunSurplusAndDebtAuctions(suite.ctx))
+	var reservePrices []sdk.Dec
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		if sa, ok := a.(auction.SurplusAuction); ok {
+			reservePrices = append(reservePrices, sa.ReservePrice)
+		}
+		return false
+	})
+	suite.Equal([]sdk.Dec{d("1.5")}, reservePrices)
+
+	// auctions relisted without a bid are repriced at the current kava price
+	pk := suite.app.GetPriceFeedKeeper()
+	_, err = pk.SetPrice(suite.ctx, sdk.AccAddress{}, "kava:usd", d("0.25"), suite.ctx.BlockTime().Add(72*time.Hour))
+	suite.NoError(err)
+	suite.NoError(pk.SetCurrentPrices(suite.ctx, "kava:usd"))
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(auction.DefaultMaxAuctionDuration))
+	suite.app.GetAuctionKeeper().CloseExpiredAuctions(ctx)
+	suite.app.GetAuctionKeeper().IterateAuctions(ctx, func(a auction.Auction) bool {
+		sa, ok := a.(auction.SurplusAuction)
+		suite.Require().True(ok)
+		suite.Equal(d("3"), sa.ReservePrice)
+		suite.Equal(uint64(1), sa.Relists)
+		return false
+	})
+
+	// without a kava price, surplus auctions start without a reserve
+	ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(73 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	suite.Error(pk.SetCurrentPrices(ctx, "kava:usd"))
+	err = sk.MintCoins(ctx, types.LiquidatorMacc, cs(c("usdx", 10000000000)))
+	suite.NoError(err)
+	suite.NoError(suite.keeper.RunSurplusAndDebtAuctions(ctx))
+	reservePrices = nil
+	suite.app.GetAuctionKeeper().IterateAuctions(ctx, func(a auction.Auction) bool {
+		reservePrices = append(reservePrices, a.(auction.SurplusAuction).ReservePrice)
+		return false
+	})
+	suite.Equal([]sdk.Dec{d("3"), sdk.ZeroDec()}, reservePrices)
+	skipped := false
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type == types.EventTypeSurplusReserveSkip {
+			skipped = true
+		}
+	}
+	suite.True(skipped)
+}
+
+func (suite *AuctionTestSuite) setDebtAuctionParam(dap types.DebtAuctionParam) {
+	pk := suite.app.GetPriceFeedKeeper()
+	pfParams := pk.GetParams(suite.ctx)
+	if _, found := pk.GetMarket(suite.ctx, "kava:usd"); !found {
+		pfParams.Markets = append(pfParams.Markets, pricefeed.Market{MarketID: "kava:usd", BaseAsset: "kava", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true})
+		pk.SetParams(suite.ctx, pfParams)
+		_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, "kava:usd", d("0.5"), time.Now().Add(1*time.Hour))
+		suite.NoError(err)
+		suite.NoError(pk.SetCurrentPrices(suite.ctx, "kava:usd"))
+	}
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtAuctionParam = dap
+	suite.keeper.SetParams(suite.ctx, params)
+}
+
 func TestAuctionTestSuite(t *testing.T) {
 	suite.Run(t, new(AuctionTestSuite))
 }
@@ -3,6 +3,8 @@ package keeper
 import (
 	"bytes"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,9 +14,21 @@ import (
 // BaseDigitFactor is 10**18, used during coin calculations
 const BaseDigitFactor = 1000000000000000000
 
+// MaxTimeToLiquidation is the longest time, in seconds, that fees are projected forward when calculating the time to liquidation of a cdp
+const MaxTimeToLiquidation = 100 * 365 * 24 * 60 * 60
+
+// MaxFeeGrowth is the largest multiple of its current value that the debt of a cdp is projected to grow to when calculating the time to liquidation
+const MaxFeeGrowth = 1000000000
+
 // AddCdp adds a cdp for a specific owner and collateral type
 func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coins, principal sdk.Coins) sdk.Error {
+	if k.IsSettled(ctx) {
+		return types.ErrSystemSettled(k.codespace)
+	}
 	// validation
+	if len(collateral) != 1 {
+		return types.ErrInvalidCollateralLength(k.codespace, len(collateral))
+	}
 	err := k.ValidateCollateral(ctx, collateral)
 	if err != nil {
 		return err
@@ -27,6 +41,10 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 	if err != nil {
 		return err
 	}
+	err = k.ValidateDebtLimit(ctx, collateral[0].Denom, principal)
+	if err != nil {
+		return err
+	}
 	err = k.ValidateCollateralizationRatio(ctx, collateral, principal, sdk.NewCoins())
 	if err != nil {
 		return err
@@ -52,7 +70,7 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 	}
 
 	// mint the corresponding amount of debt coins
-	err = k.MintDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), principal)
+	err = k.MintDebtCoinsForPrincipal(ctx, types.ModuleName, principal)
 	if err != nil {
 		panic(err)
 	}
@@ -80,7 +98,7 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 	)
 
 	// update total principal for input collateral type
-	k.IncrementTotalPrincipal(ctx, collateral[0].Denom, principal)
+	k.IncrementTotalPrincipal(ctx, cdp.Type, principal)
 
 	// set the cdp, deposit, and indexes in the store
 	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, collateral, principal)
@@ -88,13 +106,22 @@ func (k Keeper) AddCdp(ctx sdk.Context, owner sdk.AccAddress, collateral sdk.Coi
 	k.IndexCdpByOwner(ctx, cdp)
 	k.SetDeposit(ctx, deposit)
 	k.SetNextCdpID(ctx, id+1)
+
+	k.RecordCdpHistory(ctx, cdp.ID, types.EventTypeCreateCdp, owner, collateral)
+	k.RecordCdpHistory(ctx, cdp.ID, types.EventTypeCdpDraw, owner, principal)
 	return nil
 }
 
-// SetCdpAndCollateralRatioIndex sets the cdp and collateral ratio index in the store
+// SetCdpAndCollateralRatioIndex sets the cdp and collateral ratio index in the store.
+// Cdps holding a basket of collateral denoms are indexed in the basket index instead of by collateral ratio.
 func (k Keeper) SetCdpAndCollateralRatioIndex(ctx sdk.Context, cdp types.CDP, ratio sdk.Dec) {
 	k.SetCDP(ctx, cdp)
-	k.IndexCdpByCollateralRatio(ctx, cdp.Collateral[0].Denom, cdp.ID, ratio)
+	if cdp.IsBasket() {
+		k.IndexBasketCdp(ctx, cdp.Type, cdp.ID)
+		return
+	}
+	k.RemoveBasketCdpIndex(ctx, cdp.Type, cdp.ID)
+	k.IndexCdpByCollateralRatio(ctx, cdp.Type, cdp.ID, ratio)
 }
 
 // MintDebtCoins mints debt coins in the cdp module account
@@ -110,6 +137,17 @@ func (k Keeper) MintDebtCoins(ctx sdk.Context, moduleAccount string, denom strin
 	return nil
 }
 
+// MintDebtCoinsForPrincipal mints the internal debt coins tracking each of the input principal coins in the module account
+func (k Keeper) MintDebtCoinsForPrincipal(ctx sdk.Context, moduleAccount string, principalCoins sdk.Coins) sdk.Error {
+	for _, pc := range principalCoins {
+		err := k.MintDebtCoins(ctx, moduleAccount, k.GetInternalDebtDenom(ctx, pc.Denom), sdk.NewCoins(pc))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // BurnDebtCoins burns debt coins from the cdp module account
 func (k Keeper) BurnDebtCoins(ctx sdk.Context, moduleAccount string, denom string, paymentCoins sdk.Coins) sdk.Error {
 	coinsToBurn := sdk.NewCoins()
@@ -187,7 +225,7 @@ func (k Keeper) GetCDP(ctx sdk.Context, collateralDenom string, cdpID uint64) (t
 // SetCDP sets a cdp in the store
 func (k Keeper) SetCDP(ctx sdk.Context, cdp types.CDP) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpKeyPrefix)
-	db, _ := k.GetDenomPrefix(ctx, cdp.Collateral[0].Denom)
+	db, _ := k.GetDenomPrefix(ctx, cdp.Type)
 	bz := k.cdc.MustMarshalBinaryLengthPrefixed(cdp)
 	store.Set(types.CdpKey(db, cdp.ID), bz)
 	return
@@ -196,7 +234,7 @@ func (k Keeper) SetCDP(ctx sdk.Context, cdp types.CDP) {
 // DeleteCDP deletes a cdp from the store
 func (k Keeper) DeleteCDP(ctx sdk.Context, cdp types.CDP) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpKeyPrefix)
-	db, _ := k.GetDenomPrefix(ctx, cdp.Collateral[0].Denom)
+	db, _ := k.GetDenomPrefix(ctx, cdp.Type)
 	store.Delete(types.CdpKey(db, cdp.ID))
 
 }
@@ -298,6 +336,20 @@ func (k Keeper) RemoveCdpCollateralRatioIndex(ctx sdk.Context, denom string, id
 	store.Delete(types.CollateralRatioKey(db, id, collateralRatio))
 }
 
+// IndexBasketCdp sets the cdp id in the store's index of cdps holding a basket of collateral denoms
+func (k Keeper) IndexBasketCdp(ctx sdk.Context, denom string, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BasketCdpIndexPrefix)
+	db, _ := k.GetDenomPrefix(ctx, denom)
+	store.Set(types.CdpKey(db, id), types.GetCdpIDBytes(id))
+}
+
+// RemoveBasketCdpIndex deletes the cdp id from the store's index of cdps holding a basket of collateral denoms
+func (k Keeper) RemoveBasketCdpIndex(ctx sdk.Context, denom string, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BasketCdpIndexPrefix)
+	db, _ := k.GetDenomPrefix(ctx, denom)
+	store.Delete(types.CdpKey(db, id))
+}
+
 // GetDebtDenom returns the denom of debt in the system
 func (k Keeper) GetDebtDenom(ctx sdk.Context) (denom string) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.DebtDenomKey)
@@ -306,6 +358,16 @@ func (k Keeper) GetDebtDenom(ctx sdk.Context) (denom string) {
 	return
 }
 
+// GetInternalDebtDenom returns the denom of the internal debt coin that tracks debt drawn in the input debt asset.
+// A debt asset without its own debt denom uses the system debt denom, params validation allows one such debt asset.
+func (k Keeper) GetInternalDebtDenom(ctx sdk.Context, principalDenom string) string {
+	dp, found := k.GetDebtParam(ctx, principalDenom)
+	if !found || dp.DebtDenom == "" {
+		return k.GetDebtDenom(ctx)
+	}
+	return dp.DebtDenom
+}
+
 // GetGovDenom returns the denom of debt in the system
 func (k Keeper) GetGovDenom(ctx sdk.Context) (denom string) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.GovDenomKey)
@@ -334,14 +396,16 @@ func (k Keeper) SetGovDenom(ctx sdk.Context, denom string) {
 	return
 }
 
-// ValidateCollateral validates that a collateral is valid for use in cdps
+// ValidateCollateral validates that each collateral denom is valid for use in cdps
 func (k Keeper) ValidateCollateral(ctx sdk.Context, collateral sdk.Coins) sdk.Error {
-	if len(collateral) != 1 {
+	if collateral.Empty() {
 		return types.ErrInvalidCollateralLength(k.codespace, len(collateral))
 	}
-	_, found := k.GetCollateral(ctx, collateral[0].Denom)
-	if !found {
-		return types.ErrCollateralNotSupported(k.codespace, collateral[0].Denom)
+	for _, cc := range collateral {
+		_, found := k.GetCollateral(ctx, cc.Denom)
+		if !found {
+			return types.ErrCollateralNotSupported(k.codespace, cc.Denom)
+		}
 	}
 	return nil
 }
@@ -371,13 +435,26 @@ func (k Keeper) ValidatePrincipalDraw(ctx sdk.Context, principal sdk.Coins) sdk.
 	return nil
 }
 
-// ValidateDebtLimit validates that the input debt amount does not exceed the global debt limit
+// ValidateDebtLimit validates that the input debt amount does not exceed the debt limit of the collateral type or the global debt limit
 func (k Keeper) ValidateDebtLimit(ctx sdk.Context, collateralDenom string, principal sdk.Coins) sdk.Error {
+	params := k.GetParams(ctx)
+	_, found := k.GetCollateral(ctx, collateralDenom)
+	if !found {
+		return types.ErrCollateralNotSupported(k.codespace, collateralDenom)
+	}
 	for _, dc := range principal {
 		totalPrincipal := k.GetTotalPrincipal(ctx, collateralDenom, dc.Denom).Add(dc.Amount)
-		globalLimit := k.GetParams(ctx).GlobalDebtLimit.AmountOf(dc.Denom)
-		if totalPrincipal.GT(globalLimit) {
-			return types.ErrExceedsDebtLimit(k.codespace, sdk.NewCoins(sdk.NewCoin(dc.Denom, totalPrincipal)), sdk.NewCoins(sdk.NewCoin(dc.Denom, globalLimit)))
+		collateralLimit := k.GetDebtLimit(ctx, collateralDenom).AmountOf(dc.Denom)
+		if totalPrincipal.GT(collateralLimit) {
+			return types.ErrExceedsDebtLimit(k.codespace, sdk.NewCoins(sdk.NewCoin(dc.Denom, totalPrincipal)), sdk.NewCoins(sdk.NewCoin(dc.Denom, collateralLimit)))
+		}
+		globalPrincipal := dc.Amount
+		for _, p := range params.CollateralParams {
+			globalPrincipal = globalPrincipal.Add(k.GetTotalPrincipal(ctx, p.Denom, dc.Denom))
+		}
+		globalLimit := params.GlobalDebtLimit.AmountOf(dc.Denom)
+		if globalPrincipal.GT(globalLimit) {
+			return types.ErrExceedsDebtLimit(k.codespace, sdk.NewCoins(sdk.NewCoin(dc.Denom, globalPrincipal)), sdk.NewCoins(sdk.NewCoin(dc.Denom, globalLimit)))
 		}
 	}
 	return nil
@@ -385,14 +462,20 @@ func (k Keeper) ValidateDebtLimit(ctx sdk.Context, collateralDenom string, princ
 
 // ValidateCollateralizationRatio validate that adding the input principal doesn't put the cdp below the liquidation ratio
 func (k Keeper) ValidateCollateralizationRatio(ctx sdk.Context, collateral sdk.Coins, principal sdk.Coins, fees sdk.Coins) sdk.Error {
-	//
 	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, collateral, principal, fees)
 	if err != nil {
 		return err
 	}
-	liquidationRatio := k.getLiquidationRatio(ctx, collateral[0].Denom)
+	liquidationRatio, err := k.CalculateLiquidationRatio(ctx, collateral)
+	if err != nil {
+		return err
+	}
 	if collateralizationRatio.LT(liquidationRatio) {
-		return types.ErrInvalidCollateralRatio(k.codespace, collateral[0].Denom, collateralizationRatio, liquidationRatio)
+		denoms := make([]string, len(collateral))
+		for i, cc := range collateral {
+			denoms[i] = cc.Denom
+		}
+		return types.ErrInvalidCollateralRatio(k.codespace, strings.Join(denoms, ","), collateralizationRatio, liquidationRatio)
 	}
 	return nil
 }
@@ -417,7 +500,7 @@ func (k Keeper) CalculateCollateralToDebtRatio(ctx sdk.Context, collateral sdk.C
 func (k Keeper) LoadAugmentedCDP(ctx sdk.Context, cdp types.CDP) (types.AugmentedCDP, sdk.Error) {
 	// calculate additional fees
 	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
-	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Collateral[0].Denom)
+	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Type)
 	totalFees := cdp.AccumulatedFees.Add(fees)
 
 	// calculate collateralization ratio
@@ -445,30 +528,193 @@ func (k Keeper) LoadAugmentedCDP(ctx sdk.Context, cdp types.CDP) (types.Augmente
 	return augmentedCDP, nil
 }
 
+// LoadCDPHealth returns the live fees, liquidation price and borrowing capacity of a cdp at current prices and fee rates
+func (k Keeper) LoadCDPHealth(ctx sdk.Context, cdp types.CDP) (types.CDPHealth, sdk.Error) {
+	augmentedCDP, err := k.LoadAugmentedCDP(ctx, cdp)
+	if err != nil {
+		return types.CDPHealth{}, err
+	}
+	liquidationRatio, err := k.CalculateLiquidationRatio(ctx, cdp.Collateral)
+	if err != nil {
+		return types.CDPHealth{}, err
+	}
+	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
+	feesAccrued := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Type)
+	debt := cdp.Principal.Add(cdp.AccumulatedFees).Add(feesAccrued)
+	debtValue, err := k.calculateDebtValue(ctx, debt)
+	if err != nil {
+		return types.CDPHealth{}, err
+	}
+
+	// the cdp is liquidated once its debt exceeds the sum of each collateral value divided by its liquidation ratio
+	prices := make(map[string]sdk.Dec)
+	maxDebtValue := sdk.ZeroDec()
+	otherMaxDebtValue := sdk.ZeroDec()
+	for _, cc := range cdp.Collateral {
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, k.getMarketID(ctx, cc.Denom))
+		if err != nil {
+			return types.CDPHealth{}, err
+		}
+		prices[cc.Denom] = price.Price
+		backedValue := k.convertCollateralToBaseUnits(ctx, cc).Mul(price.Price).Quo(k.getLiquidationRatio(ctx, cc.Denom))
+		maxDebtValue = maxDebtValue.Add(backedValue)
+		if cc.Denom != cdp.Type {
+			otherMaxDebtValue = otherMaxDebtValue.Add(backedValue)
+		}
+	}
+
+	// liquidation price of the cdp's collateral type, holding the prices of any other collateral denoms constant
+	liquidationPrice := sdk.ZeroDec()
+	typeBaseUnits := k.convertCollateralToBaseUnits(ctx, sdk.NewCoin(cdp.Type, cdp.Collateral.AmountOf(cdp.Type)))
+	if typeBaseUnits.IsPositive() && debtValue.GT(otherMaxDebtValue) {
+		liquidationPrice = debtValue.Sub(otherMaxDebtValue).Mul(k.getLiquidationRatio(ctx, cdp.Type)).Quo(typeBaseUnits)
+	}
+
+	availableToWithdraw := sdk.NewCoins()
+	availableToDraw := sdk.NewCoins()
+	timeToLiquidation := int64(0)
+	// headroom is valued like the collateralization checks on draws and withdrawals, with debt at its market price
+	headroom := maxDebtValue.Sub(debtValue)
+	if headroom.IsPositive() {
+		for _, cc := range cdp.Collateral {
+			if !prices[cc.Denom].IsPositive() {
+				continue
+			}
+			cp, _ := k.GetCollateral(ctx, cc.Denom)
+			amount := headroom.Mul(k.getLiquidationRatio(ctx, cc.Denom)).Quo(prices[cc.Denom]).
+				MulInt(sdk.NewIntWithDecimal(1, int(cp.ConversionFactor.Int64()))).TruncateInt()
+			availableToWithdraw = availableToWithdraw.Add(sdk.NewCoins(sdk.NewCoin(cc.Denom, sdk.MinInt(amount, cc.Amount))))
+		}
+		for _, dp := range k.GetParams(ctx).DebtParams {
+			debtPrice, err := k.getDebtPrice(ctx, dp)
+			if err != nil {
+				return types.CDPHealth{}, err
+			}
+			amount := headroom.Quo(debtPrice).MulInt(sdk.NewIntWithDecimal(1, int(dp.ConversionFactor.Int64()))).TruncateInt()
+			amount = sdk.MinInt(amount, k.getAvailableDebt(ctx, cdp.Type, dp.Denom))
+			availableToDraw = availableToDraw.Add(sdk.NewCoins(sdk.NewCoin(dp.Denom, amount)))
+		}
+		timeToLiquidation = k.calculateTimeToLiquidation(ctx, cdp.Type, debt, maxDebtValue)
+	}
+
+	return types.NewCDPHealth(augmentedCDP, feesAccrued, liquidationRatio, liquidationPrice, availableToWithdraw, availableToDraw, timeToLiquidation), nil
+}
+
+// getAvailableDebt returns the amount of the input debt asset that can be drawn against the input collateral
+// without exceeding the debt limit of the collateral type or the global debt limit
+func (k Keeper) getAvailableDebt(ctx sdk.Context, collateralDenom string, debtDenom string) sdk.Int {
+	params := k.GetParams(ctx)
+	available := k.GetDebtLimit(ctx, collateralDenom).AmountOf(debtDenom).Sub(k.GetTotalPrincipal(ctx, collateralDenom, debtDenom))
+	globalAvailable := params.GlobalDebtLimit.AmountOf(debtDenom)
+	for _, cp := range params.CollateralParams {
+		globalAvailable = globalAvailable.Sub(k.GetTotalPrincipal(ctx, cp.Denom, debtDenom))
+	}
+	available = sdk.MinInt(available, globalAvailable)
+	if available.IsNegative() {
+		return sdk.ZeroInt()
+	}
+	return available
+}
+
+// calculateTimeToLiquidation returns the seconds until fees accruing on the input debt take its value above the input max debt value.
+// Returns -1 if that takes longer than MaxTimeToLiquidation, or if the debt would have to grow by more than MaxFeeGrowth,
+// which bounds the fee calculation. Debt is valued at current debt asset prices, which are held constant.
+func (k Keeper) calculateTimeToLiquidation(ctx sdk.Context, collateralDenom string, debt sdk.Coins, maxDebtValue sdk.Dec) int64 {
+	debtValue, err := k.calculateDebtValue(ctx, debt)
+	if err != nil || debtValue.IsZero() || maxDebtValue.GT(debtValue.MulInt64(MaxFeeGrowth)) {
+		return -1
+	}
+	exceeds := func(seconds int64) bool {
+		fees := k.calculateFeesBetween(ctx, debt, ctx.BlockTime(), ctx.BlockTime().Add(time.Duration(seconds)*time.Second), collateralDenom)
+		// fees are in the debt assets already priced above
+		value, _ := k.calculateDebtValue(ctx, debt.Add(fees))
+		return value.GT(maxDebtValue)
+	}
+	// double the time until the debt exceeds the max so the accumulated fees stay bounded, then search between the last two times
+	low, high := int64(0), int64(1)
+	for !exceeds(high) {
+		if high >= MaxTimeToLiquidation {
+			return -1
+		}
+		low = high
+		high *= 2
+		if high > MaxTimeToLiquidation {
+			high = MaxTimeToLiquidation
+		}
+	}
+	for low+1 < high {
+		mid := low + (high-low)/2
+		if exceeds(mid) {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+	return high
+}
+
+// calculateDebtValue returns the value of the input debt in base units of the pricefeed quote asset, pricing each debt asset at its market price
+func (k Keeper) calculateDebtValue(ctx sdk.Context, debt sdk.Coins) (sdk.Dec, sdk.Error) {
+	debtValue := sdk.ZeroDec()
+	for _, dc := range debt {
+		dp, _ := k.GetDebtParam(ctx, dc.Denom)
+		price, err := k.getDebtPrice(ctx, dp)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		debtValue = debtValue.Add(k.convertDebtToBaseUnits(ctx, dc).Mul(price))
+	}
+	return debtValue, nil
+}
+
 // CalculateCollateralizationRatio returns the collateralization ratio of the input collateral to the input debt plus fees
 func (k Keeper) CalculateCollateralizationRatio(ctx sdk.Context, collateral sdk.Coins, principal sdk.Coins, fees sdk.Coins) (sdk.Dec, sdk.Error) {
 	if collateral.IsZero() {
 		return sdk.ZeroDec(), nil
 	}
-	marketID := k.getMarketID(ctx, collateral[0].Denom)
-	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	collateralValue := sdk.ZeroDec()
+	for _, cc := range collateral {
+		value, err := k.calculateCollateralValue(ctx, cc)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		collateralValue = collateralValue.Add(value)
+	}
+
+	debtValue, err := k.calculateDebtValue(ctx, principal.Add(fees))
 	if err != nil {
 		return sdk.Dec{}, err
 	}
-	collateralBaseUnits := k.convertCollateralToBaseUnits(ctx, collateral[0])
-	collateralValue := collateralBaseUnits.Mul(price.Price)
+	collateralRatio := collateralValue.Quo(debtValue)
+	return collateralRatio, nil
+}
 
-	principalTotal := sdk.ZeroDec()
-	for _, pc := range principal {
-		prinicpalBaseUnits := k.convertDebtToBaseUnits(ctx, pc)
-		principalTotal = principalTotal.Add(prinicpalBaseUnits)
+// CalculateLiquidationRatio returns the liquidation ratio for the input collateral.
+// For a basket of collateral denoms this is the value weighted harmonic mean of each denom's liquidation ratio,
+// so that a cdp is liquidated once its debt exceeds the sum of each collateral value divided by its liquidation ratio.
+func (k Keeper) CalculateLiquidationRatio(ctx sdk.Context, collateral sdk.Coins) (sdk.Dec, sdk.Error) {
+	if len(collateral) == 1 {
+		return k.getLiquidationRatio(ctx, collateral[0].Denom), nil
+	}
+	totalValue := sdk.ZeroDec()
+	weightedValue := sdk.ZeroDec()
+	maxRatio := sdk.ZeroDec()
+	for _, cc := range collateral {
+		liquidationRatio := k.getLiquidationRatio(ctx, cc.Denom)
+		if liquidationRatio.GT(maxRatio) {
+			maxRatio = liquidationRatio
+		}
+		value, err := k.calculateCollateralValue(ctx, cc)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		totalValue = totalValue.Add(value)
+		weightedValue = weightedValue.Add(value.Quo(liquidationRatio))
 	}
-	for _, fc := range fees {
-		feeBaseUnits := k.convertDebtToBaseUnits(ctx, fc)
-		principalTotal = principalTotal.Add(feeBaseUnits)
+	if weightedValue.IsZero() {
+		return maxRatio, nil
 	}
-	collateralRatio := collateralValue.Quo(principalTotal)
-	return collateralRatio, nil
+	return totalValue.Quo(weightedValue), nil
 }
 
 // CalculateCollateralizationRatioFromAbsoluteRatio takes a coin's denom and an absolute ratio and returns the respective collateralization ratio
@@ -484,6 +730,31 @@ func (k Keeper) CalculateCollateralizationRatioFromAbsoluteRatio(ctx sdk.Context
 	return respectiveCollateralRatio, nil
 }
 
+// calculateCollateralValue returns the value of the input collateral in base units of the pricefeed quote asset
+func (k Keeper) calculateCollateralValue(ctx sdk.Context, collateral sdk.Coin) (sdk.Dec, sdk.Error) {
+	marketID := k.getMarketID(ctx, collateral.Denom)
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return k.convertCollateralToBaseUnits(ctx, collateral).Mul(price.Price), nil
+}
+
+// getDebtPrice returns the price of the input debt asset from its pricefeed market, or 1 if it has no market
+func (k Keeper) getDebtPrice(ctx sdk.Context, dp types.DebtParam) (sdk.Dec, sdk.Error) {
+	if dp.MarketID == "" {
+		return sdk.OneDec(), nil
+	}
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, dp.MarketID)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !price.Price.IsPositive() {
+		return sdk.Dec{}, types.ErrInvalidPrice(k.codespace, dp.MarketID, price.Price)
+	}
+	return price.Price, nil
+}
+
 // converts the input collateral to base units (ie multiplies the input by 10^(-ConversionFactor))
 func (k Keeper) convertCollateralToBaseUnits(ctx sdk.Context, collateral sdk.Coin) (baseUnits sdk.Dec) {
 	cp, _ := k.GetCollateral(ctx, collateral.Denom)
@@ -8,6 +8,7 @@ import (
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp/keeper"
 	"github.com/kava-labs/kava/x/cdp/types"
+	"github.com/kava-labs/kava/x/pricefeed"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -88,6 +89,79 @@ func (suite *CdpTestSuite) TestAddCdp() {
 	suite.Equal(types.CodeCdpAlreadyExists, err.Result().Code)
 }
 
+func (suite *CdpTestSuite) TestAddCdpMultipleDebtAssets() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParams[1].DebtDenom = "susddebt"
+	params.DebtParams[1].StabilityFee = d("1.000000001547125958")
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.Equal("debt", suite.keeper.GetInternalDebtDenom(suite.ctx, "usdx"))
+	suite.Equal("susddebt", suite.keeper.GetInternalDebtDenom(suite.ctx, "susd"))
+
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	ak := suite.app.GetAccountKeeper()
+	acc := ak.NewAccountWithAddress(suite.ctx, addrs[0])
+	acc.SetCoins(cs(c("xrp", 200000000), c("btc", 500000000)))
+	ak.SetAccount(suite.ctx, acc)
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], cs(c("xrp", 200000000)), cs(c("usdx", 10000000), c("susd", 10000000)))
+	suite.NoError(err)
+	suite.Equal(i(10000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx"))
+	suite.Equal(i(10000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "susd"))
+	macc := suite.app.GetSupplyKeeper().GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(cs(c("debt", 10000000), c("susddebt", 10000000), c("xrp", 200000000)), macc.GetCoins())
+
+	// susd accrues the debt stability fee on top of the collateral stability fee
+	fees := suite.keeper.CalculateFees(suite.ctx, cs(c("usdx", 1000000000), c("susd", 1000000000)), i(31536000), "xrp")
+	suite.True(fees.AmountOf("susd").GT(fees.AmountOf("usdx")))
+
+	err = suite.keeper.AddCdp(suite.ctx, addrs[0], cs(c("btc", 500000000)), cs(c("usdx", 1000000000001)))
+	suite.Equal(types.CodeExceedsDebtLimit, err.Result().Code)
+}
+
+func (suite *CdpTestSuite) TestValidateInternalDebtDenoms() {
+	type args struct {
+		debtDenom string
+	}
+	testCases := []struct {
+		name       string
+		args       args
+		expectPass bool
+	}{
+		{"valid", args{"susddebt"}, true},
+		{"invalid denom", args{"X"}, false},
+		{"debt asset", args{"usdx"}, false},
+		{"collateral denom", args{"xrp"}, false},
+		{"duplicate", args{"debt2"}, false},
+	}
+	for _, tc := range testCases {
+		params := suite.keeper.GetParams(suite.ctx)
+		params.DebtParams[0].DebtDenom = "debt2"
+		params.DebtParams[1].DebtDenom = tc.args.debtDenom
+		if tc.expectPass {
+			suite.NoError(params.Validate(), tc.name)
+		} else {
+			suite.Error(params.Validate(), tc.name)
+		}
+	}
+
+	// only one debt asset can use the system debt denom, whether by default or by name
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParams[0].DebtDenom = ""
+	params.DebtParams[1].DebtDenom = ""
+	suite.Error(params.Validate())
+	params.DebtParams[1].DebtDenom = "debt"
+	suite.NoError(params.Validate())
+	suite.Error(params.ValidateDenoms("debt", "ukava"))
+	params.DebtParams[1].DebtDenom = "susddebt"
+	suite.NoError(params.ValidateDenoms("debt", "ukava"))
+	suite.Error(params.ValidateDenoms("debt", "susddebt"))
+	suite.Error(params.ValidateDenoms("ukava", "ukava"))
+
+	gs := types.DefaultGenesisState()
+	gs.Params = suite.keeper.GetParams(suite.ctx)
+	gs.Params.DebtParams[0].DebtDenom = gs.GovDenom
+	suite.Error(gs.Validate())
+}
+
 func (suite *CdpTestSuite) TestGetSetDenomByte() {
 	_, found := suite.keeper.GetDenomPrefix(suite.ctx, "lol")
 	suite.False(found)
@@ -240,8 +314,13 @@ func (suite *CdpTestSuite) TestValidateCollateral() {
 	c = sdk.NewCoins(sdk.NewCoin("lol", sdk.NewInt(1)))
 	err = suite.keeper.ValidateCollateral(suite.ctx, c)
 	suite.Equal(types.CodeCollateralNotSupported, err.Result().Code)
+	c = sdk.NewCoins(sdk.NewCoin("btc", sdk.NewInt(1)), sdk.NewCoin("xrp", sdk.NewInt(1)))
+	err = suite.keeper.ValidateCollateral(suite.ctx, c)
+	suite.NoError(err)
 	c = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1)), sdk.NewCoin("xrp", sdk.NewInt(1)))
 	err = suite.keeper.ValidateCollateral(suite.ctx, c)
+	suite.Equal(types.CodeCollateralNotSupported, err.Result().Code)
+	err = suite.keeper.ValidateCollateral(suite.ctx, sdk.NewCoins())
 	suite.Equal(types.CodeCollateralLengthInvalid, err.Result().Code)
 }
 
@@ -297,6 +376,82 @@ func (suite *CdpTestSuite) TestMintBurnDebtCoins() {
 	suite.Equal(sdk.Coins(nil), acc.GetCoins())
 }
 
+func (suite *CdpTestSuite) TestLoadCDPHealth() {
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	ak := suite.app.GetAccountKeeper()
+	acc := ak.NewAccountWithAddress(suite.ctx, addrs[0])
+	acc.SetCoins(cs(c("xrp", 400000000)))
+	ak.SetAccount(suite.ctx, acc)
+	// $100 of xrp backing $40 of debt at a liquidation ratio of 2
+	suite.NoError(suite.keeper.AddCdp(suite.ctx, addrs[0], cs(c("xrp", 400000000)), cs(c("usdx", 40000000))))
+	cdp, _ := suite.keeper.GetCdpByOwnerAndDenom(suite.ctx, addrs[0], "xrp")
+
+	health, err := suite.keeper.LoadCDPHealth(suite.ctx, cdp)
+	suite.NoError(err)
+	suite.Equal(sdk.Coins(nil), health.FeesAccrued)
+	suite.Equal(d("2.0"), health.LiquidationRatio)
+	suite.Equal(d("0.2"), health.LiquidationPrice)
+	suite.Equal(cs(c("xrp", 80000000)), health.AvailableToWithdraw)
+	suite.Equal(cs(c("susd", 10000000), c("usdx", 10000000)), health.AvailableToDraw)
+
+	// fees at 5% apr take the debt past $50 after about four and a half years
+	seconds := health.TimeToLiquidation
+	suite.True(seconds > 4*365*24*60*60 && seconds < 5*365*24*60*60)
+	debt := cdp.Principal
+	fees := suite.keeper.CalculateFees(suite.ctx, debt, i(seconds), "xrp")
+	suite.True(debt.Add(fees).AmountOf("usdx").GT(i(50000000)))
+	fees = suite.keeper.CalculateFees(suite.ctx, debt, i(seconds-1), "xrp")
+	suite.True(debt.Add(fees).AmountOf("usdx").LTE(i(50000000)))
+
+	// fees accrue from the last update
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 24 * 365))
+	health, err = suite.keeper.LoadCDPHealth(ctx, cdp)
+	suite.NoError(err)
+	suite.True(health.FeesAccrued.AmountOf("usdx").IsPositive())
+	suite.True(health.LiquidationPrice.GT(d("0.2")))
+	suite.True(health.AvailableToWithdraw.AmountOf("xrp").LT(i(80000000)))
+	suite.Equal(seconds-365*24*60*60, health.TimeToLiquidation)
+
+	// debt assets with a market are valued at their market price, $20 of debt leaves $30 to draw
+	pk := suite.app.GetPriceFeedKeeper()
+	pfParams := pk.GetParams(suite.ctx)
+	pfParams.Markets = append(pfParams.Markets, pricefeed.Market{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true})
+	pk.SetParams(suite.ctx, pfParams)
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParams[0].MarketID = "usdx:usd"
+	suite.keeper.SetParams(suite.ctx, params)
+	_, err = suite.keeper.LoadCDPHealth(suite.ctx, cdp)
+	suite.Error(err)
+	_, err = pk.SetPrice(suite.ctx, sdk.AccAddress{}, "usdx:usd", d("0.5"), suite.ctx.BlockTime().Add(time.Hour*3))
+	suite.NoError(err)
+	suite.NoError(pk.SetCurrentPrices(suite.ctx, "usdx:usd"))
+	health, err = suite.keeper.LoadCDPHealth(suite.ctx, cdp)
+	suite.NoError(err)
+	suite.Equal(cs(c("susd", 30000000), c("usdx", 60000000)), health.AvailableToDraw)
+	suite.Equal(d("0.1"), health.LiquidationPrice)
+
+	// the amount available to draw is exactly what the cdp can draw
+	drawCtx, _ := suite.ctx.CacheContext()
+	err = suite.keeper.AddPrincipal(drawCtx, addrs[0], "xrp", cs(c("usdx", 60000001)))
+	suite.Equal(types.CodeInvalidCollateralRatio, err.Result().Code)
+	suite.NoError(suite.keeper.AddPrincipal(drawCtx, addrs[0], "xrp", cs(c("usdx", 60000000))))
+	drawn, _ := suite.keeper.GetCDP(drawCtx, "xrp", cdp.ID)
+	health, err = suite.keeper.LoadCDPHealth(drawCtx, drawn)
+	suite.NoError(err)
+	suite.True(health.AvailableToDraw.IsZero())
+
+	// a cdp below the liquidation ratio has no capacity left
+	_, err = pk.SetPrice(suite.ctx, sdk.AccAddress{}, "xrp:usd", d("0.09"), suite.ctx.BlockTime().Add(time.Hour*3))
+	suite.NoError(err)
+	suite.NoError(pk.SetCurrentPrices(suite.ctx, "xrp:usd"))
+	health, err = suite.keeper.LoadCDPHealth(suite.ctx, cdp)
+	suite.NoError(err)
+	suite.Equal(d("0.1"), health.LiquidationPrice)
+	suite.True(health.AvailableToWithdraw.IsZero())
+	suite.True(health.AvailableToDraw.IsZero())
+	suite.Equal(int64(0), health.TimeToLiquidation)
+}
+
 func TestCdpTestSuite(t *testing.T) {
 	suite.Run(t, new(CdpTestSuite))
 }
@@ -8,15 +8,19 @@ import (
 	"github.com/kava-labs/kava/x/cdp/types"
 )
 
-// DepositCollateral adds collateral to a cdp
-func (k Keeper) DepositCollateral(ctx sdk.Context, owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coins) sdk.Error {
+// DepositCollateral adds collateral to the cdp with the input collateral type.
+// The collateral may contain any supported collateral denom, not only the cdp's collateral type.
+func (k Keeper) DepositCollateral(ctx sdk.Context, owner sdk.AccAddress, depositor sdk.AccAddress, denom string, collateral sdk.Coins) sdk.Error {
+	if k.IsSettled(ctx) {
+		return types.ErrSystemSettled(k.codespace)
+	}
 	err := k.ValidateCollateral(ctx, collateral)
 	if err != nil {
 		return err
 	}
-	cdp, found := k.GetCdpByOwnerAndDenom(ctx, owner, collateral[0].Denom)
+	cdp, found := k.GetCdpByOwnerAndDenom(ctx, owner, denom)
 	if !found {
-		return types.ErrCdpNotFound(k.codespace, owner, collateral[0].Denom)
+		return types.ErrCdpNotFound(k.codespace, owner, denom)
 	}
 
 	deposit, found := k.GetDeposit(ctx, cdp.ID, depositor)
@@ -40,46 +44,51 @@ func (k Keeper) DepositCollateral(ctx sdk.Context, owner sdk.AccAddress, deposit
 	k.SetDeposit(ctx, deposit)
 
 	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
-	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Collateral[0].Denom)
+	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Type)
 	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
-	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Collateral[0].Denom, cdp.ID, oldCollateralToDebtRatio)
+	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
 
 	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(fees)
 	cdp.FeesUpdated = ctx.BlockTime()
 	cdp.Collateral = cdp.Collateral.Add(collateral)
 	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
 	k.SetCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+
+	k.recordFeeAccrual(ctx, cdp.ID, fees)
+	k.RecordCdpHistory(ctx, cdp.ID, types.EventTypeCdpDeposit, depositor, collateral)
 	return nil
 }
 
-// WithdrawCollateral removes collateral from a cdp if it does not put the cdp below the liquidation ratio
-func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coins) sdk.Error {
+// WithdrawCollateral removes collateral from the cdp with the input collateral type if it does not put the cdp below the liquidation ratio
+func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner sdk.AccAddress, depositor sdk.AccAddress, denom string, collateral sdk.Coins) sdk.Error {
+	if k.IsSettled(ctx) {
+		return types.ErrSystemSettled(k.codespace)
+	}
 	err := k.ValidateCollateral(ctx, collateral)
 	if err != nil {
 		return err
 	}
-	cdp, found := k.GetCdpByOwnerAndDenom(ctx, owner, collateral[0].Denom)
+	cdp, found := k.GetCdpByOwnerAndDenom(ctx, owner, denom)
 	if !found {
-		return types.ErrCdpNotFound(k.codespace, owner, collateral[0].Denom)
+		return types.ErrCdpNotFound(k.codespace, owner, denom)
 	}
 	deposit, found := k.GetDeposit(ctx, cdp.ID, depositor)
 	if !found {
 		return types.ErrDepositNotFound(k.codespace, depositor, cdp.ID)
 	}
-	if collateral.IsAnyGT(deposit.Amount) {
+	if !deposit.Amount.IsAllGTE(collateral) {
 		return types.ErrInvalidWithdrawAmount(k.codespace, collateral, deposit.Amount)
 	}
 
 	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
-	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Collateral[0].Denom)
-	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral.Sub(collateral), cdp.Principal, cdp.AccumulatedFees.Add(fees))
+	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Type)
+	if cdp.Collateral.Sub(collateral).IsZero() {
+		return types.ErrInvalidCollateralRatio(k.codespace, cdp.Type, sdk.ZeroDec(), k.getLiquidationRatio(ctx, cdp.Type))
+	}
+	err = k.ValidateCollateralizationRatio(ctx, cdp.Collateral.Sub(collateral), cdp.Principal, cdp.AccumulatedFees.Add(fees))
 	if err != nil {
 		return err
 	}
-	liquidationRatio := k.getLiquidationRatio(ctx, collateral[0].Denom)
-	if collateralizationRatio.LT(liquidationRatio) {
-		return types.ErrInvalidCollateralRatio(k.codespace, collateral[0].Denom, collateralizationRatio, liquidationRatio)
-	}
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeCdpWithdrawal,
@@ -93,7 +102,7 @@ func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner sdk.AccAddress, deposi
 		panic(err)
 	}
 	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
-	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Collateral[0].Denom, cdp.ID, oldCollateralToDebtRatio)
+	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
 
 	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(fees)
 	cdp.FeesUpdated = ctx.BlockTime()
@@ -107,9 +116,85 @@ func (k Keeper) WithdrawCollateral(ctx sdk.Context, owner sdk.AccAddress, deposi
 	} else {
 		k.SetDeposit(ctx, deposit)
 	}
+
+	k.recordFeeAccrual(ctx, cdp.ID, fees)
+	k.RecordCdpHistory(ctx, cdp.ID, types.EventTypeCdpWithdrawal, depositor, collateral)
 	return nil
 }
 
+// CalculateWithdrawal returns the collateral withdrawn from the deposit of a cdp in the input withdrawal mode.
+// WithdrawModeAll lowers the withdrawal of each collateral denom to the deposit, and WithdrawModeFloor also lowers it
+// to the most that leaves the cdp at its liquidation ratio, taking the denoms in order.
+func (k Keeper) CalculateWithdrawal(ctx sdk.Context, owner sdk.AccAddress, depositor sdk.AccAddress, denom string, collateral sdk.Coins, mode string) (sdk.Coins, sdk.Error) {
+	if mode == types.WithdrawModeExact {
+		return collateral, nil
+	}
+	if mode != types.WithdrawModeFloor && mode != types.WithdrawModeAll {
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid withdraw mode: %s", mode))
+	}
+	cdp, found := k.GetCdpByOwnerAndDenom(ctx, owner, denom)
+	if !found {
+		return nil, types.ErrCdpNotFound(k.codespace, owner, denom)
+	}
+	deposit, found := k.GetDeposit(ctx, cdp.ID, depositor)
+	if !found {
+		return nil, types.ErrDepositNotFound(k.codespace, depositor, cdp.ID)
+	}
+
+	// the cdp stays above its liquidation ratio while its debt is at most the sum of each collateral value divided by its liquidation ratio
+	headroom := sdk.ZeroDec()
+	if mode == types.WithdrawModeFloor {
+		periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
+		fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Type)
+		debtValue, err := k.calculateDebtValue(ctx, cdp.Principal.Add(cdp.AccumulatedFees).Add(fees))
+		if err != nil {
+			return nil, err
+		}
+		headroom = headroom.Sub(debtValue)
+		for _, cc := range cdp.Collateral {
+			value, err := k.calculateCollateralValue(ctx, cc)
+			if err != nil {
+				return nil, err
+			}
+			headroom = headroom.Add(value.Quo(k.getLiquidationRatio(ctx, cc.Denom)))
+		}
+	}
+
+	adjusted := sdk.NewCoins()
+	limited := false
+	for _, cc := range collateral {
+		amount := sdk.MinInt(cc.Amount, deposit.Amount.AmountOf(cc.Denom))
+		if mode == types.WithdrawModeFloor && amount.IsPositive() {
+			unitValue, err := k.calculateCollateralValue(ctx, sdk.NewCoin(cc.Denom, sdk.OneInt()))
+			if err != nil {
+				return nil, err
+			}
+			unitHeadroom := unitValue.Quo(k.getLiquidationRatio(ctx, cc.Denom))
+			if !headroom.IsPositive() {
+				amount = sdk.ZeroInt()
+			} else if unitHeadroom.IsPositive() {
+				amount = sdk.MinInt(amount, headroom.Quo(unitHeadroom).TruncateInt())
+			}
+			limited = limited || amount.IsZero()
+			headroom = headroom.Sub(unitHeadroom.MulInt(amount))
+		}
+		if amount.IsPositive() {
+			adjusted = adjusted.Add(sdk.NewCoins(sdk.NewCoin(cc.Denom, amount)))
+		}
+	}
+	if adjusted.IsZero() && limited {
+		collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Principal, cdp.AccumulatedFees)
+		if err != nil {
+			return nil, err
+		}
+		return nil, types.ErrInvalidCollateralRatio(k.codespace, cdp.Type, collateralizationRatio, k.getLiquidationRatio(ctx, cdp.Type))
+	}
+	if adjusted.IsZero() {
+		return nil, types.ErrInvalidWithdrawAmount(k.codespace, collateral, deposit.Amount)
+	}
+	return adjusted, nil
+}
+
 // GetDeposit returns the deposit of a depositor on a particular cdp from the store
 func (k Keeper) GetDeposit(ctx sdk.Context, cdpID uint64, depositor sdk.AccAddress) (deposit types.Deposit, found bool) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.DepositKeyPrefix)
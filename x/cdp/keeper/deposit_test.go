@@ -60,7 +60,7 @@ func (suite *DepositTestSuite) TestGetSetDeposit() {
 }
 
 func (suite *DepositTestSuite) TestDepositCollateral() {
-	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("xrp", 10000000)))
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 10000000)))
 	suite.NoError(err)
 	d, found := suite.keeper.GetDeposit(suite.ctx, uint64(1), suite.addrs[0])
 	suite.True(found)
@@ -75,13 +75,13 @@ func (suite *DepositTestSuite) TestDepositCollateral() {
 	acc := ak.GetAccount(suite.ctx, suite.addrs[0])
 	suite.Equal(i(90000000), acc.GetCoins().AmountOf("xrp"))
 
-	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("btc", 1)))
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "btc", cs(c("btc", 1)))
 	suite.Equal(types.CodeCdpNotFound, err.Result().Code)
 
-	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], cs(c("xrp", 1)))
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp", cs(c("xrp", 1)))
 	suite.Equal(types.CodeCdpNotFound, err.Result().Code)
 
-	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], cs(c("xrp", 10000000)))
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp", cs(c("xrp", 10000000)))
 	suite.NoError(err)
 	d, found = suite.keeper.GetDeposit(suite.ctx, uint64(1), suite.addrs[1])
 	suite.True(found)
@@ -93,20 +93,20 @@ func (suite *DepositTestSuite) TestDepositCollateral() {
 }
 
 func (suite *DepositTestSuite) TestWithdrawCollateral() {
-	err := suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("xrp", 400000000)))
+	err := suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 400000000)))
 	suite.Equal(types.CodeInvalidCollateralRatio, err.Result().Code)
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("xrp", 321000000)))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 321000000)))
 	suite.Equal(types.CodeInvalidCollateralRatio, err.Result().Code)
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], cs(c("xrp", 10000000)))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp", cs(c("xrp", 10000000)))
 	suite.Equal(types.CodeCdpNotFound, err.Result().Code)
 
 	cd, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
 	cd.AccumulatedFees = cs(c("usdx", 1))
 	suite.keeper.SetCDP(suite.ctx, cd)
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("xrp", 320000000)))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 320000000)))
 	suite.Equal(types.CodeInvalidCollateralRatio, err.Result().Code)
 
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], cs(c("xrp", 10000000)))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 10000000)))
 	suite.NoError(err)
 	dep, _ := suite.keeper.GetDeposit(suite.ctx, uint64(1), suite.addrs[0])
 	td := types.NewDeposit(uint64(1), suite.addrs[0], cs(c("xrp", 390000000)))
@@ -115,10 +115,92 @@ func (suite *DepositTestSuite) TestWithdrawCollateral() {
 	acc := ak.GetAccount(suite.ctx, suite.addrs[0])
 	suite.Equal(i(110000000), acc.GetCoins().AmountOf("xrp"))
 
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], cs(c("xrp", 10000000)))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp", cs(c("xrp", 10000000)))
 	suite.Equal(types.CodeDepositNotFound, err.Result().Code)
 }
 
+func (suite *DepositTestSuite) TestCalculateWithdrawal() {
+	// $100 of xrp backs $10 of debt at a liquidation ratio of 2, so $80 of xrp can be withdrawn
+	collateral, err := suite.keeper.CalculateWithdrawal(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 500000000)), types.WithdrawModeFloor)
+	suite.NoError(err)
+	suite.Equal(cs(c("xrp", 320000000)), collateral)
+	collateral, err = suite.keeper.CalculateWithdrawal(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 100000000)), types.WithdrawModeFloor)
+	suite.NoError(err)
+	suite.Equal(cs(c("xrp", 100000000)), collateral)
+	collateral, err = suite.keeper.CalculateWithdrawal(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 500000000)), types.WithdrawModeAll)
+	suite.NoError(err)
+	suite.Equal(cs(c("xrp", 400000000)), collateral)
+
+	collateral, err = suite.keeper.CalculateWithdrawal(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 500000000)), types.WithdrawModeFloor)
+	suite.NoError(err)
+	suite.NoError(suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", collateral))
+	_, err = suite.keeper.CalculateWithdrawal(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 1)), types.WithdrawModeFloor)
+	suite.Require().Error(err)
+	suite.Equal(types.CodeInvalidCollateralRatio, err.Result().Code)
+	_, err = suite.keeper.CalculateWithdrawal(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("btc", 1)), types.WithdrawModeAll)
+	suite.Require().Error(err)
+	suite.Equal(types.CodeInvalidWithdrawAmount, err.Result().Code)
+	_, err = suite.keeper.CalculateWithdrawal(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp", cs(c("xrp", 1)), types.WithdrawModeAll)
+	suite.Require().Error(err)
+	suite.Equal(types.CodeDepositNotFound, err.Result().Code)
+}
+
+func (suite *DepositTestSuite) TestBasketCollateral() {
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("btc", 1000000)))
+	suite.NoError(err)
+	cd, found := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.True(found)
+	suite.Equal("xrp", cd.Type)
+	suite.Equal(cs(c("btc", 1000000), c("xrp", 400000000)), cd.Collateral)
+	suite.True(cd.IsBasket())
+	dep, _ := suite.keeper.GetDeposit(suite.ctx, uint64(1), suite.addrs[0])
+	suite.Equal(cs(c("btc", 1000000), c("xrp", 400000000)), dep.Amount)
+
+	// basket cdps are moved from the collateral ratio index to the basket index
+	suite.Equal(0, len(suite.keeper.GetAllCdpsByDenomAndRatio(suite.ctx, "xrp", d("100.0"))))
+	basketCdps := types.CDPs{}
+	suite.keeper.IterateBasketCdps(suite.ctx, "xrp", func(cdp types.CDP) bool {
+		basketCdps = append(basketCdps, cdp)
+		return false
+	})
+	suite.Equal(1, len(basketCdps))
+
+	// $100 of xrp at 2.0 and $80 of btc at 1.5
+	lr, err := suite.keeper.CalculateLiquidationRatio(suite.ctx, cd.Collateral)
+	suite.NoError(err)
+	suite.Equal(d("180.0").Quo(d("50.0").Add(d("80.0").Quo(d("1.5")))), lr)
+	cr, err := suite.keeper.CalculateCollateralizationRatio(suite.ctx, cd.Collateral, cd.Principal, cd.AccumulatedFees)
+	suite.NoError(err)
+	suite.Equal(d("18.0"), cr)
+
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 400000000)))
+	suite.NoError(err)
+	cd, _ = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.Equal(cs(c("btc", 1000000)), cd.Collateral)
+	suite.True(cd.IsBasket())
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("btc", 1000000)))
+	suite.Equal(types.CodeInvalidCollateralRatio, err.Result().Code)
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 1)))
+	suite.Equal(types.CodeInvalidWithdrawAmount, err.Result().Code)
+
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 100000000)))
+	suite.NoError(err)
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("btc", 1000000)))
+	suite.NoError(err)
+	cd, _ = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.False(cd.IsBasket())
+	suite.Equal(1, len(suite.keeper.GetAllCdpsByDenomAndRatio(suite.ctx, "xrp", d("100.0"))))
+	basketCdps = types.CDPs{}
+	suite.keeper.IterateBasketCdps(suite.ctx, "xrp", func(cdp types.CDP) bool {
+		basketCdps = append(basketCdps, cdp)
+		return false
+	})
+	suite.Equal(0, len(basketCdps))
+
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("lol", 1)))
+	suite.Equal(types.CodeCollateralNotSupported, err.Result().Code)
+}
+
 func TestDepositTestSuite(t *testing.T) {
 	suite.Run(t, new(DepositTestSuite))
 }
@@ -9,6 +9,9 @@ import (
 
 // AddPrincipal adds debt to a cdp if the additional debt does not put the cdp below the liquidation ratio
 func (k Keeper) AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom string, principal sdk.Coins) sdk.Error {
+	if k.IsSettled(ctx) {
+		return types.ErrSystemSettled(k.codespace)
+	}
 	// validation
 	cdp, found := k.GetCdpByOwnerAndDenom(ctx, owner, denom)
 	if !found {
@@ -19,14 +22,14 @@ func (k Keeper) AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom string
 		return err
 	}
 
-	err = k.ValidateDebtLimit(ctx, cdp.Collateral[0].Denom, principal)
+	err = k.ValidateDebtLimit(ctx, cdp.Type, principal)
 	if err != nil {
 		return err
 	}
 
 	// fee calculation
 	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
-	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Collateral[0].Denom)
+	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Type)
 
 	err = k.ValidateCollateralizationRatio(ctx, cdp.Collateral, cdp.Principal.Add(principal), cdp.AccumulatedFees.Add(fees))
 	if err != nil {
@@ -44,7 +47,7 @@ func (k Keeper) AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom string
 	}
 
 	// mint the corresponding amount of debt coins in the cdp module account
-	err = k.MintDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), principal)
+	err = k.MintDebtCoinsForPrincipal(ctx, types.ModuleName, principal)
 	if err != nil {
 		panic(err)
 	}
@@ -68,18 +71,23 @@ func (k Keeper) AddPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom string
 	cdp.FeesUpdated = ctx.BlockTime()
 
 	// increment total principal for the input collateral type
-	k.IncrementTotalPrincipal(ctx, cdp.Collateral[0].Denom, principal)
+	k.IncrementTotalPrincipal(ctx, cdp.Type, principal)
 
 	// set cdp state and indexes in the store
 	collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
 	k.SetCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
 
+	k.recordFeeAccrual(ctx, cdp.ID, fees)
+	k.RecordCdpHistory(ctx, cdp.ID, types.EventTypeCdpDraw, owner, principal)
 	return nil
 }
 
 // RepayPrincipal removes debt from the cdp
 // If all debt is repaid, the collateral is returned to depositors and the cdp is removed from the store
 func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom string, payment sdk.Coins) sdk.Error {
+	if k.IsSettled(ctx) {
+		return types.ErrSystemSettled(k.codespace)
+	}
 	// validation
 	cdp, found := k.GetCdpByOwnerAndDenom(ctx, owner, denom)
 	if !found {
@@ -88,7 +96,7 @@ func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom stri
 
 	// calculate fees
 	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
-	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Collateral[0].Denom)
+	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Type)
 	err := k.ValidatePaymentCoins(ctx, cdp, payment, cdp.Principal.Add(cdp.AccumulatedFees).Add(fees))
 	if err != nil {
 		return err
@@ -109,19 +117,18 @@ func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom stri
 		panic(err)
 	}
 
-	// burn the corresponding amount of debt coins
-	cdpDebt := k.getModAccountDebt(ctx, types.ModuleName)
-	paymentAmount := sdk.ZeroInt()
-	for _, c := range feePayment.Add(principalPayment) {
-		paymentAmount = paymentAmount.Add(c.Amount)
-	}
-	coinsToBurn := sdk.NewCoins(sdk.NewCoin(k.GetDebtDenom(ctx), paymentAmount))
-	if paymentAmount.GT(cdpDebt) {
-		coinsToBurn = sdk.NewCoins(sdk.NewCoin(k.GetDebtDenom(ctx), cdpDebt))
-	}
-	err = k.BurnDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), coinsToBurn)
-	if err != nil {
-		panic(err)
+	// burn the corresponding amount of debt coins for each debt asset
+	for _, pc := range feePayment.Add(principalPayment) {
+		debtDenom := k.GetInternalDebtDenom(ctx, pc.Denom)
+		cdpDebt := k.getModAccountDebt(ctx, types.ModuleName, debtDenom)
+		coinsToBurn := sdk.NewCoins(pc)
+		if pc.Amount.GT(cdpDebt) {
+			coinsToBurn = sdk.NewCoins(sdk.NewCoin(pc.Denom, cdpDebt))
+		}
+		err = k.BurnDebtCoins(ctx, types.ModuleName, debtDenom, coinsToBurn)
+		if err != nil {
+			panic(err)
+		}
 	}
 
 	// emit repayment event
@@ -147,12 +154,16 @@ func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom stri
 	// decrement the total principal for the input collateral type
 	k.DecrementTotalPrincipal(ctx, denom, feePayment.Add(principalPayment))
 
+	k.recordFeeAccrual(ctx, cdp.ID, fees)
+	k.RecordCdpHistory(ctx, cdp.ID, types.EventTypeCdpRepay, owner, feePayment.Add(principalPayment))
+
 	// if the debt is fully paid, return collateral to depositors,
 	// and remove the cdp and indexes from the store
 	if cdp.Principal.IsZero() && cdp.AccumulatedFees.IsZero() {
 		k.ReturnCollateral(ctx, cdp)
 		k.DeleteCDP(ctx, cdp)
 		k.RemoveCdpOwnerIndex(ctx, cdp)
+		k.RemoveBasketCdpIndex(ctx, cdp.Type, cdp.ID)
 
 		// emit cdp close event
 		ctx.EventManager().EmitEvent(
@@ -161,6 +172,7 @@ func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom stri
 				sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
 			),
 		)
+		k.RecordCdpHistory(ctx, cdp.ID, types.EventTypeCdpClose, owner, cdp.Collateral)
 		return nil
 	}
 
@@ -170,6 +182,53 @@ func (k Keeper) RepayPrincipal(ctx sdk.Context, owner sdk.AccAddress, denom stri
 	return nil
 }
 
+// CalculateRepayment returns the payment that repays the debt of a cdp in the input repayment mode.
+// RepayModeFloor lowers the payment of each debt asset that would leave less than the debt floor so that the debt floor remains,
+// and RepayModeAll returns all the debt of the cdp including fees, which must not exceed the payment if one is set.
+func (k Keeper) CalculateRepayment(ctx sdk.Context, owner sdk.AccAddress, denom string, payment sdk.Coins, mode string) (sdk.Coins, sdk.Error) {
+	cdp, found := k.GetCdpByOwnerAndDenom(ctx, owner, denom)
+	if !found {
+		return nil, types.ErrCdpNotFound(k.codespace, owner, denom)
+	}
+	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
+	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Type)
+	debt := cdp.Principal.Add(cdp.AccumulatedFees).Add(fees)
+
+	switch mode {
+	case types.RepayModeExact:
+		return payment, nil
+	case types.RepayModeAll:
+		if !payment.IsZero() && !payment.IsAllGTE(debt) {
+			return nil, types.ErrInsufficientPayment(k.codespace, payment, debt)
+		}
+		return debt, nil
+	case types.RepayModeFloor:
+		adjusted := sdk.NewCoins()
+		for _, pc := range payment {
+			amount := pc.Amount
+			dp, found := k.GetDebtParam(ctx, pc.Denom)
+			// payments that repay all the debt of a debt asset are kept
+			if found && amount.LT(debt.AmountOf(pc.Denom)) {
+				proposedBalance := cdp.Principal.AmountOf(pc.Denom).Sub(amount)
+				if proposedBalance.IsPositive() && proposedBalance.LT(dp.DebtFloor) {
+					amount = cdp.Principal.AmountOf(pc.Denom).Sub(dp.DebtFloor)
+				}
+			}
+			if amount.IsPositive() {
+				adjusted = adjusted.Add(sdk.NewCoins(sdk.NewCoin(pc.Denom, amount)))
+			}
+		}
+		// the debt is already at or below the floor, so it can only be repaid in full
+		if adjusted.IsZero() && !payment.IsZero() {
+			dp, _ := k.GetDebtParam(ctx, payment[0].Denom)
+			return nil, types.ErrBelowDebtFloor(k.codespace, sdk.NewCoins(sdk.NewCoin(payment[0].Denom, cdp.Principal.AmountOf(payment[0].Denom))), dp.DebtFloor)
+		}
+		return adjusted, nil
+	default:
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid repay mode: %s", mode))
+	}
+}
+
 // ValidatePaymentCoins validates that the input coins are valid for repaying debt
 func (k Keeper) ValidatePaymentCoins(ctx sdk.Context, cdp types.CDP, payment sdk.Coins, debt sdk.Coins) sdk.Error {
 	subset := payment.DenomsSubsetOf(cdp.Principal)
@@ -79,7 +79,7 @@ func (suite *DrawTestSuite) TestAddRepayPrincipal() {
 	suite.Equal(i(10000000), tp)
 	sk = suite.app.GetSupplyKeeper()
 	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
-	suite.Equal(cs(c("xrp", 400000000), c("debt", 30000000)), acc.GetCoins())
+	suite.Equal(cs(c("xrp", 400000000), c("debt", 20000000), c("susddebt", 10000000)), acc.GetCoins())
 
 	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[1], "xrp", cs(c("usdx", 10000000)))
 	suite.Equal(types.CodeCdpNotFound, err.Result().Code)
@@ -103,7 +103,7 @@ func (suite *DrawTestSuite) TestAddRepayPrincipal() {
 	suite.Equal(i(10000000), tp)
 	sk = suite.app.GetSupplyKeeper()
 	acc = sk.GetModuleAccount(suite.ctx, types.ModuleName)
-	suite.Equal(cs(c("xrp", 400000000), c("debt", 20000000)), acc.GetCoins())
+	suite.Equal(cs(c("xrp", 400000000), c("debt", 10000000), c("susddebt", 10000000)), acc.GetCoins())
 
 	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp", cs(c("susd", 10000000)))
 	suite.NoError(err)
@@ -182,6 +182,74 @@ func (suite *DrawTestSuite) TestAddRepayPrincipalFees() {
 	suite.Equal(cs(c("usdx", 5000000)), t.AccumulatedFees)
 }
 
+func (suite *DrawTestSuite) TestCalculateRepaymentFloor() {
+	err := suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp", cs(c("usdx", 10000000)))
+	suite.NoError(err)
+	payment, err := suite.keeper.CalculateRepayment(suite.ctx, suite.addrs[0], "xrp", cs(c("usdx", 15000000)), types.RepayModeFloor)
+	suite.NoError(err)
+	suite.Equal(cs(c("usdx", 10000000)), payment)
+	payment, err = suite.keeper.CalculateRepayment(suite.ctx, suite.addrs[0], "xrp", cs(c("usdx", 5000000)), types.RepayModeFloor)
+	suite.NoError(err)
+	suite.Equal(cs(c("usdx", 5000000)), payment)
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp", cs(c("usdx", 10000000)))
+	suite.NoError(err)
+	t, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.Equal(cs(c("usdx", 10000000)), t.Principal)
+
+	_, err = suite.keeper.CalculateRepayment(suite.ctx, suite.addrs[0], "xrp", cs(c("usdx", 5000000)), types.RepayModeFloor)
+	suite.Require().Error(err)
+	suite.Equal(types.CodeBelowDebtFloor, err.Result().Code)
+}
+
+func (suite *DrawTestSuite) TestCalculateRepaymentAll() {
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 24))
+	payment, err := suite.keeper.CalculateRepayment(suite.ctx, suite.addrs[0], "xrp", sdk.Coins{}, types.RepayModeAll)
+	suite.NoError(err)
+	suite.True(payment.IsAllGT(cs(c("usdx", 10000000))))
+
+	_, err = suite.keeper.CalculateRepayment(suite.ctx, suite.addrs[0], "xrp", cs(c("usdx", 10000000)), types.RepayModeAll)
+	suite.Require().Error(err)
+	suite.Equal(types.CodeInsufficientPayment, err.Result().Code)
+
+	err = suite.keeper.RepayPrincipal(suite.ctx, suite.addrs[0], "xrp", payment)
+	suite.NoError(err)
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.False(found)
+}
+
+func (suite *DrawTestSuite) TestSweepDust() {
+	err := suite.keeper.AddCdp(suite.ctx, suite.addrs[2], cs(c("xrp", 1000000000000)), cs(c("usdx", 100000000)))
+	suite.NoError(err)
+	err = suite.keeper.AddCdp(suite.ctx, suite.addrs[1], cs(c("xrp", 200000000)), cs(c("usdx", 20000000)))
+	suite.NoError(err)
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParams[0].DebtFloor = i(50000000)
+	suite.keeper.SetParams(suite.ctx, params)
+	// the third cdp falls below its liquidation ratio
+	pk := suite.app.GetPriceFeedKeeper()
+	_, err = pk.SetPrice(suite.ctx, sdk.AccAddress{}, "xrp:usd", d("0.15"), suite.ctx.BlockTime().Add(time.Hour))
+	suite.NoError(err)
+	suite.NoError(pk.SetCurrentPrices(suite.ctx, "xrp:usd"))
+
+	suite.keeper.SweepDust(suite.ctx)
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(1))
+	suite.False(found)
+	_, found = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	suite.True(found)
+	// undercollateralized dust is left to be liquidated
+	_, found = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(3))
+	suite.True(found)
+
+	ak := suite.app.GetAccountKeeper()
+	acc := ak.GetAccount(suite.ctx, suite.addrs[0])
+	suite.Equal(i(500000000), acc.GetCoins().AmountOf("xrp"))
+	suite.Equal(i(10010000000), acc.GetCoins().AmountOf("usdx"))
+	sk := suite.app.GetSupplyKeeper()
+	liquidatorMacc := sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc)
+	suite.Equal(cs(c("debt", 10000000)), liquidatorMacc.GetCoins())
+	suite.Equal(i(120000000), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx"))
+}
+
 func (suite *DrawTestSuite) TestPricefeedFailure() {
 	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 2))
 	pfk := suite.app.GetPriceFeedKeeper()
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// SweepDust closes the cdps with debt below the debt floor of any of their debt assets, which are left behind when governance raises a debt floor.
+// The collateral of each cdp is returned to its depositors, and its debt, which the owner keeps, is moved to the liquidator module account as bad debt.
+// Cdps below their liquidation ratio, or whose collateral can not be priced, are left to be liquidated, so their collateral still covers their debt.
+func (k Keeper) SweepDust(ctx sdk.Context) {
+	var dust types.CDPs
+	k.IterateAllCdps(ctx, func(cdp types.CDP) bool {
+		if !k.isBelowDebtFloor(ctx, cdp.Principal) {
+			return false
+		}
+		ratio, err := k.calculateRatioToLiquidationRatio(ctx, cdp)
+		if err != nil || ratio.LT(sdk.OneDec()) {
+			return false
+		}
+		dust = append(dust, cdp)
+		return false
+	})
+	for _, cdp := range dust {
+		k.closeDustCdp(ctx, cdp)
+	}
+}
+
+// isBelowDebtFloor returns true if the principal of any debt asset is positive and below its debt floor
+func (k Keeper) isBelowDebtFloor(ctx sdk.Context, principal sdk.Coins) bool {
+	for _, pc := range principal {
+		dp, found := k.GetDebtParam(ctx, pc.Denom)
+		if found && pc.Amount.IsPositive() && pc.Amount.LT(dp.DebtFloor) {
+			return true
+		}
+	}
+	return false
+}
+
+// closeDustCdp returns the collateral of a cdp to its depositors, moves the debt coins of its debt to the liquidator module account,
+// and removes the cdp and its indexes from the store
+func (k Keeper) closeDustCdp(ctx sdk.Context, cdp types.CDP) {
+	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
+	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
+	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Type)
+	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(fees)
+	cdp.FeesUpdated = ctx.BlockTime()
+
+	debt := cdp.Principal.Add(cdp.AccumulatedFees)
+	for _, dc := range debt {
+		debtDenom := k.GetInternalDebtDenom(ctx, dc.Denom)
+		amount := sdk.MinInt(dc.Amount, k.getModAccountDebt(ctx, types.ModuleName, debtDenom))
+		if !amount.IsPositive() {
+			continue
+		}
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(debtDenom, amount)))
+		if err != nil {
+			panic(err)
+		}
+	}
+	k.DecrementTotalPrincipal(ctx, cdp.Type, debt)
+	k.ReturnCollateral(ctx, cdp)
+	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
+	k.RemoveBasketCdpIndex(ctx, cdp.Type, cdp.ID)
+	k.RemoveCdpOwnerIndex(ctx, cdp)
+	k.DeleteCDP(ctx, cdp)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpDustSweep,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, debt.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+	k.recordFeeAccrual(ctx, cdp.ID, fees)
+	k.RecordCdpHistory(ctx, cdp.ID, types.EventTypeCdpDustSweep, nil, debt)
+}
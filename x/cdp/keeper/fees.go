@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -9,24 +10,59 @@ import (
 )
 
 // CalculateFees returns the fees accumulated since fees were last calculated based on
-// the input amount of outstanding debt (principal) and the number of periods (seconds) that have passed
+// the input amount of outstanding debt (principal) and the number of periods (seconds) that have passed.
+// Each principal denom accrues at the collateral stability fee in effect for it multiplied by the fee rate of its debt param.
+// The periods are taken to end at the current block time, and fees accrue piecewise at the rate in effect between each scheduled change that falls inside them.
 func (k Keeper) CalculateFees(ctx sdk.Context, principal sdk.Coins, periods sdk.Int, denom string) sdk.Coins {
+	end := ctx.BlockTime()
+	start := end.Add(-time.Duration(periods.Int64()) * time.Second)
+	return k.calculateFeesBetween(ctx, principal, start, end, denom)
+}
+
+// calculateFeesBetween returns the fees accumulated on the input principal from start to end, compounding at the rate in effect
+// during each interval between the scheduled changes for the input collateral. The utilization part of the rate is evaluated
+// against the current total principal, as the history of total principal is not stored.
+func (k Keeper) calculateFeesBetween(ctx sdk.Context, principal sdk.Coins, start time.Time, end time.Time, denom string) sdk.Coins {
+	cp, found := k.GetCollateral(ctx, denom)
+	if !found {
+		panic(fmt.Sprintf("could not get fee rate for %s, collateral not found", denom))
+	}
+	boundaries := []time.Time{start}
+	for _, sc := range cp.Schedule {
+		if sc.Time.After(start) && sc.Time.Before(end) {
+			boundaries = append(boundaries, sc.Time)
+		}
+	}
+	boundaries = append(boundaries, end)
+
 	newFees := sdk.NewCoins()
 	for _, pc := range principal {
-		// how fees are calculated:
-		// feesAccumulated = (outstandingDebt * (feeRate^periods)) - outstandingDebt
-		// Note that since we can't do x^y using sdk.Decimal, we are converting to int and using RelativePow
-		feePerSecond := k.getFeeRate(ctx, denom)
-		scalar := sdk.NewInt(1000000000000000000)
-		feeRateInt := feePerSecond.Mul(sdk.NewDecFromInt(scalar)).TruncateInt()
-		accumulator := sdk.NewDecFromInt(types.RelativePow(feeRateInt, periods, scalar)).Mul(sdk.SmallestDec())
-		feesAccumulated := (sdk.NewDecFromInt(pc.Amount).Mul(accumulator)).Sub(sdk.NewDecFromInt(pc.Amount))
+		debt := sdk.NewDecFromInt(pc.Amount)
+		for i := 0; i < len(boundaries)-1; i++ {
+			periods := int64(boundaries[i+1].Sub(boundaries[i]).Seconds())
+			if periods <= 0 {
+				continue
+			}
+			feePerSecond := k.GetStabilityFee(ctx.WithBlockTime(boundaries[i]), denom, pc.Denom).Mul(k.getDebtFeeRate(ctx, pc.Denom))
+			debt = debt.Mul(feeAccumulator(feePerSecond, sdk.NewInt(periods)))
+		}
+		feesAccumulated := debt.Sub(sdk.NewDecFromInt(pc.Amount))
 		// TODO this will always round down, causing precision loss between the sum of all fees in CDPs and surplus coins in liquidator account
 		newFees = newFees.Add(sdk.NewCoins(sdk.NewCoin(pc.Denom, feesAccumulated.TruncateInt())))
 	}
 	return newFees
 }
 
+// feeAccumulator returns the factor debt grows by when compounding at the input per second fee rate for the input number of periods
+func feeAccumulator(feePerSecond sdk.Dec, periods sdk.Int) sdk.Dec {
+	// how fees are calculated:
+	// feesAccumulated = (outstandingDebt * (feeRate^periods)) - outstandingDebt
+	// Note that since we can't do x^y using sdk.Decimal, we are converting to int and using RelativePow
+	scalar := sdk.NewInt(1000000000000000000)
+	feeRateInt := feePerSecond.Mul(sdk.NewDecFromInt(scalar)).TruncateInt()
+	return sdk.NewDecFromInt(types.RelativePow(feeRateInt, periods, scalar)).Mul(sdk.SmallestDec())
+}
+
 // IncrementTotalPrincipal increments the total amount of debt that has been drawn with that collateral type
 func (k Keeper) IncrementTotalPrincipal(ctx sdk.Context, collateralDenom string, principal sdk.Coins) {
 	for _, pc := range principal {
@@ -83,3 +119,43 @@ func (k Keeper) SetPreviousBlockTime(ctx sdk.Context, blockTime time.Time) {
 	store := prefix.NewStore(ctx.KVStore(k.key), types.PreviousBlockTimeKey)
 	store.Set([]byte{}, k.cdc.MustMarshalBinaryLengthPrefixed(blockTime))
 }
+
+// GetDebtAssets returns a summary of each debt asset: its internal debt denom, fee rate, total principal drawn across all collateral types,
+// and the surplus and seized debt held by the liquidator module account
+func (k Keeper) GetDebtAssets(ctx sdk.Context) types.DebtAssets {
+	params := k.GetParams(ctx)
+	liquidatorCoins := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins()
+	debtAssets := types.DebtAssets{}
+	for _, dp := range params.DebtParams {
+		totalPrincipal := sdk.ZeroInt()
+		for _, cp := range params.CollateralParams {
+			totalPrincipal = totalPrincipal.Add(k.GetTotalPrincipal(ctx, cp.Denom, dp.Denom))
+		}
+		debtDenom := k.GetInternalDebtDenom(ctx, dp.Denom)
+		debtAssets = append(debtAssets, types.NewDebtAsset(
+			dp.Denom, debtDenom, k.getDebtFeeRate(ctx, dp.Denom), totalPrincipal, params.GlobalDebtLimit.AmountOf(dp.Denom),
+			liquidatorCoins.AmountOf(dp.Denom), liquidatorCoins.AmountOf(debtDenom),
+		))
+	}
+	return debtAssets
+}
+
+// GetCollateralFeeRates returns the stability fees currently charged for the input collateral and the changes scheduled for it
+func (k Keeper) GetCollateralFeeRates(ctx sdk.Context, collateralDenom string) (types.CollateralFeeRates, bool) {
+	cp, found := k.GetCollateral(ctx, collateralDenom)
+	if !found {
+		return types.CollateralFeeRates{}, false
+	}
+	rates := types.DebtFeeRates{}
+	for _, dp := range k.GetParams(ctx).DebtParams {
+		fee := k.GetStabilityFee(ctx, collateralDenom, dp.Denom).Mul(k.getDebtFeeRate(ctx, dp.Denom))
+		rates = append(rates, types.NewDebtFeeRate(dp.Denom, k.GetUtilization(ctx, collateralDenom, dp.Denom), fee))
+	}
+	var scheduled types.ScheduledChanges
+	for _, sc := range cp.Schedule {
+		if sc.Time.After(ctx.BlockTime()) {
+			scheduled = append(scheduled, sc)
+		}
+	}
+	return types.NewCollateralFeeRates(collateralDenom, k.getScheduledStabilityFee(ctx, cp), k.GetDebtLimit(ctx, collateralDenom), rates, scheduled), true
+}
@@ -3,12 +3,14 @@ package keeper_test
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"github.com/kava-labs/kava/app"
 	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmtime "github.com/tendermint/tendermint/types/time"
@@ -90,6 +92,98 @@ func (suite *FeeTestSuite) TestGetSetPreviousBlockTime() {
 
 }
 
+func (suite *FeeTestSuite) TestStabilityFeeSchedule() {
+	params := suite.keeper.GetParams(suite.ctx)
+	base := params.CollateralParams[0].StabilityFee
+	start := suite.ctx.BlockTime()
+	params.CollateralParams[0].Schedule = types.ScheduledChanges{
+		types.NewScheduledChange(start.Add(time.Hour), d("1.000000003"), sdk.Coins{}),
+		types.NewScheduledChange(start.Add(2*time.Hour), sdk.ZeroDec(), cs(c("usdx", 100000000), c("susd", 100000000))),
+		types.NewScheduledChange(start.Add(3*time.Hour), d("1.000000002"), sdk.Coins{}),
+	}
+	suite.NoError(params.Validate())
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.Equal(base, suite.keeper.GetStabilityFee(suite.ctx, "xrp", "usdx"))
+	suite.Equal(params.CollateralParams[0].DebtLimit, suite.keeper.GetDebtLimit(suite.ctx, "xrp"))
+
+	ctx := suite.ctx.WithBlockTime(start.Add(time.Hour))
+	suite.Equal(d("1.000000003"), suite.keeper.GetStabilityFee(ctx, "xrp", "usdx"))
+	suite.Equal(params.CollateralParams[0].DebtLimit, suite.keeper.GetDebtLimit(ctx, "xrp"))
+
+	// the scheduled debt limit is enforced once it is in effect
+	ctx = suite.ctx.WithBlockTime(start.Add(150 * time.Minute))
+	suite.Equal(d("1.000000003"), suite.keeper.GetStabilityFee(ctx, "xrp", "usdx"))
+	suite.Equal(cs(c("usdx", 100000000), c("susd", 100000000)), suite.keeper.GetDebtLimit(ctx, "xrp"))
+	err := suite.keeper.ValidateDebtLimit(ctx, "xrp", cs(c("usdx", 100000001)))
+	suite.Equal(types.CodeExceedsDebtLimit, err.Result().Code)
+
+	ctx = suite.ctx.WithBlockTime(start.Add(4 * time.Hour))
+	suite.Equal(d("1.000000002"), suite.keeper.GetStabilityFee(ctx, "xrp", "usdx"))
+	feeRates, found := suite.keeper.GetCollateralFeeRates(suite.ctx, "xrp")
+	suite.True(found)
+	suite.Equal(3, len(feeRates.Scheduled))
+	feeRates, _ = suite.keeper.GetCollateralFeeRates(ctx, "xrp")
+	suite.Equal(0, len(feeRates.Scheduled))
+	suite.Equal(d("1.000000002"), feeRates.StabilityFee)
+
+	params.CollateralParams[0].Schedule[2].Time = start
+	suite.Error(params.Validate())
+}
+
+func (suite *FeeTestSuite) TestCalculateFeesAcrossSchedule() {
+	params := suite.keeper.GetParams(suite.ctx)
+	start := suite.ctx.BlockTime()
+	params.CollateralParams[0].Schedule = types.ScheduledChanges{
+		types.NewScheduledChange(start.Add(time.Hour), d("1.000000011547125958"), sdk.Coins{}),
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	principal := cs(c("usdx", 1000000000000))
+
+	// fees before the change accrue at the base rate, fees after it at the scheduled rate
+	baseFees := suite.keeper.CalculateFees(suite.ctx.WithBlockTime(start.Add(time.Hour)), principal, i(7200), "xrp")
+	newRateFees := suite.keeper.CalculateFees(suite.ctx.WithBlockTime(start.Add(3*time.Hour)), principal, i(7200), "xrp")
+	fees := suite.keeper.CalculateFees(suite.ctx.WithBlockTime(start.Add(2*time.Hour)), principal, i(7200), "xrp")
+	suite.True(fees.AmountOf("usdx").GT(baseFees.AmountOf("usdx")))
+	suite.True(fees.AmountOf("usdx").LT(newRateFees.AmountOf("usdx")))
+
+	firstHour := suite.keeper.CalculateFees(suite.ctx.WithBlockTime(start.Add(time.Hour)), principal, i(3600), "xrp")
+	secondHour := suite.keeper.CalculateFees(suite.ctx.WithBlockTime(start.Add(2*time.Hour)), principal.Add(firstHour), i(3600), "xrp")
+	// accruing hour by hour only differs by rounding
+	diff := fees.AmountOf("usdx").Sub(firstHour.Add(secondHour).AmountOf("usdx"))
+	suite.True(diff.GTE(i(-2)) && diff.LTE(i(2)))
+}
+
+func (suite *FeeTestSuite) TestUtilizationCurve() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[0].UtilizationKink = d("0.8")
+	params.CollateralParams[0].MaxStabilityFee = d("1.000000011547125958")
+	suite.NoError(params.Validate())
+	suite.keeper.SetParams(suite.ctx, params)
+	base := params.CollateralParams[0].StabilityFee
+	debtLimit := params.CollateralParams[0].DebtLimit.AmountOf("usdx")
+
+	suite.Equal(base, suite.keeper.GetStabilityFee(suite.ctx, "xrp", "usdx"))
+
+	// below the kink the fee is unchanged
+	suite.keeper.SetTotalPrincipal(suite.ctx, "xrp", "usdx", debtLimit.QuoRaw(2))
+	suite.Equal(d("0.5"), suite.keeper.GetUtilization(suite.ctx, "xrp", "usdx"))
+	suite.Equal(base, suite.keeper.GetStabilityFee(suite.ctx, "xrp", "usdx"))
+
+	// half way between the kink and the debt limit the fee is half way to the max fee
+	suite.keeper.SetTotalPrincipal(suite.ctx, "xrp", "usdx", debtLimit.MulRaw(9).QuoRaw(10))
+	suite.Equal(d("1.000000006547125958"), suite.keeper.GetStabilityFee(suite.ctx, "xrp", "usdx"))
+	suite.Equal(base, suite.keeper.GetStabilityFee(suite.ctx, "xrp", "susd"))
+
+	suite.keeper.SetTotalPrincipal(suite.ctx, "xrp", "usdx", debtLimit.MulRaw(2))
+	suite.Equal(d("1.000000011547125958"), suite.keeper.GetStabilityFee(suite.ctx, "xrp", "usdx"))
+	fees := suite.keeper.CalculateFees(suite.ctx, cs(c("usdx", 1000000000), c("susd", 1000000000)), i(31536000), "xrp")
+	suite.True(fees.AmountOf("usdx").GT(fees.AmountOf("susd")))
+
+	params.CollateralParams[0].UtilizationKink = sdk.OneDec()
+	suite.Error(params.Validate())
+}
+
 func TestFeeTestSuite(t *testing.T) {
 	suite.Run(t, new(FeeTestSuite))
 }
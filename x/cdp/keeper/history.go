@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// RecordCdpHistory appends an entry to the history of a cdp, if the cdp history is enabled
+func (k Keeper) RecordCdpHistory(ctx sdk.Context, cdpID uint64, entryType string, address sdk.AccAddress, amount sdk.Coins) {
+	if k.GetParams(ctx).HistoryRetention == 0 {
+		return
+	}
+	sequence := k.GetNextCdpHistorySequence(ctx)
+	entry := types.NewCdpHistoryEntry(cdpID, sequence, entryType, address, amount, ctx.BlockHeight(), ctx.BlockTime())
+	k.SetCdpHistoryEntry(ctx, entry)
+	k.SetNextCdpHistorySequence(ctx, sequence+1)
+}
+
+// recordFeeAccrual appends the fees accrued by a cdp to its history, if they are non-zero
+func (k Keeper) recordFeeAccrual(ctx sdk.Context, cdpID uint64, fees sdk.Coins) {
+	if fees.IsZero() {
+		return
+	}
+	k.RecordCdpHistory(ctx, cdpID, types.HistoryTypeFeeAccrual, nil, fees)
+}
+
+// SetCdpHistoryEntry sets a cdp history entry and its time index in the store
+func (k Keeper) SetCdpHistoryEntry(ctx sdk.Context, entry types.CdpHistoryEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpHistoryKeyPrefix)
+	store.Set(types.CdpHistoryKey(entry.CdpID, entry.Sequence), k.cdc.MustMarshalBinaryLengthPrefixed(entry))
+	timeStore := prefix.NewStore(ctx.KVStore(k.key), types.CdpHistoryTimeIndexPrefix)
+	timeStore.Set(types.CdpHistoryTimeKey(entry.Time, entry.Sequence), types.GetCdpIDBytes(entry.CdpID))
+}
+
+// GetCdpHistory returns the history of a cdp, ordered from oldest to newest entry.
+// The history is kept after the cdp is closed or liquidated, until its entries are pruned.
+func (k Keeper) GetCdpHistory(ctx sdk.Context, cdpID uint64) types.CdpHistory {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpHistoryKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetCdpIDBytes(cdpID))
+	defer iterator.Close()
+
+	history := types.CdpHistory{}
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.CdpHistoryEntry
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &entry)
+		history = append(history, entry)
+	}
+	return history
+}
+
+// IterateCdpHistory iterates over the history entries of all cdps and performs a callback function
+func (k Keeper) IterateCdpHistory(ctx sdk.Context, cb func(entry types.CdpHistoryEntry) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpHistoryKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.CdpHistoryEntry
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &entry)
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+// PruneCdpHistory deletes the cdp history entries that are older than the history retention.
+// All entries are deleted when the cdp history is disabled.
+func (k Keeper) PruneCdpHistory(ctx sdk.Context) {
+	cutoff := ctx.BlockTime().Add(-k.GetParams(ctx).HistoryRetention)
+	timeStore := prefix.NewStore(ctx.KVStore(k.key), types.CdpHistoryTimeIndexPrefix)
+	iterator := timeStore.Iterator(nil, sdk.FormatTimeBytes(cutoff))
+
+	var timeKeys [][]byte
+	var entryKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		cdpID := types.GetCdpIDFromBytes(iterator.Value())
+		timeKeys = append(timeKeys, iterator.Key())
+		entryKeys = append(entryKeys, types.CdpHistoryKey(cdpID, types.SplitCdpHistoryTimeKey(iterator.Key())))
+	}
+	iterator.Close()
+
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpHistoryKeyPrefix)
+	for i := range timeKeys {
+		timeStore.Delete(timeKeys[i])
+		store.Delete(entryKeys[i])
+	}
+}
+
+// GetNextCdpHistorySequence returns the sequence of the next cdp history entry
+func (k Keeper) GetNextCdpHistorySequence(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpHistorySequenceKey)
+	bz := store.Get([]byte{})
+	if bz == nil {
+		return 0
+	}
+	return types.GetCdpIDFromBytes(bz)
+}
+
+// SetNextCdpHistorySequence sets the sequence of the next cdp history entry
+func (k Keeper) SetNextCdpHistorySequence(ctx sdk.Context, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CdpHistorySequenceKey)
+	store.Set([]byte{}, types.GetCdpIDBytes(sequence))
+}
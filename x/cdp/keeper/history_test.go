@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+type HistoryTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *HistoryTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	authGS := app.NewAuthGenState(
+		addrs,
+		[]sdk.Coins{
+			cs(c("xrp", 1000000000), c("usdx", 1000000)),
+			cs(c("xrp", 1000000000))})
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(),
+		NewCDPGenStateMulti(),
+	)
+	keeper := tApp.GetCDPKeeper()
+	params := keeper.GetParams(ctx)
+	params.HistoryRetention = time.Hour * 24
+	keeper.SetParams(ctx, params)
+	suite.app = tApp
+	suite.keeper = keeper
+	suite.ctx = ctx
+	suite.addrs = addrs
+}
+
+func (suite *HistoryTestSuite) historyTypes(history types.CdpHistory) []string {
+	var entryTypes []string
+	for _, e := range history {
+		entryTypes = append(entryTypes, e.Type)
+	}
+	return entryTypes
+}
+
+func (suite *HistoryTestSuite) TestHistoryDisabled() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.HistoryRetention = 0
+	suite.keeper.SetParams(suite.ctx, params)
+
+	suite.NoError(suite.keeper.AddCdp(suite.ctx, suite.addrs[0], cs(c("xrp", 400000000)), cs(c("usdx", 10000000))))
+	suite.Equal(types.CdpHistory{}, suite.keeper.GetCdpHistory(suite.ctx, 1))
+	suite.Equal(uint64(0), suite.keeper.GetNextCdpHistorySequence(suite.ctx))
+}
+
+func (suite *HistoryTestSuite) TestHistoryOfClosedCdp() {
+	suite.NoError(suite.keeper.AddCdp(suite.ctx, suite.addrs[0], cs(c("xrp", 400000000)), cs(c("usdx", 10000000))))
+	suite.NoError(suite.keeper.AddCdp(suite.ctx, suite.addrs[1], cs(c("xrp", 400000000)), cs(c("usdx", 10000000))))
+
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	suite.NoError(suite.keeper.DepositCollateral(ctx, suite.addrs[0], suite.addrs[1], "xrp", cs(c("xrp", 100000000))))
+	suite.NoError(suite.keeper.WithdrawCollateral(ctx, suite.addrs[0], suite.addrs[1], "xrp", cs(c("xrp", 50000000))))
+	suite.NoError(suite.keeper.AddPrincipal(ctx, suite.addrs[0], "xrp", cs(c("usdx", 5000000))))
+	cdp, found := suite.keeper.GetCdpByOwnerAndDenom(ctx, suite.addrs[0], "xrp")
+	suite.True(found)
+	debt := cdp.Principal.Add(cdp.AccumulatedFees)
+	suite.NoError(suite.keeper.RepayPrincipal(ctx, suite.addrs[0], "xrp", debt))
+	_, found = suite.keeper.GetCdpByOwnerAndDenom(ctx, suite.addrs[0], "xrp")
+	suite.False(found)
+
+	history := suite.keeper.GetCdpHistory(ctx, 1)
+	suite.Equal([]string{
+		types.EventTypeCreateCdp,
+		types.EventTypeCdpDraw,
+		types.HistoryTypeFeeAccrual,
+		types.EventTypeCdpDeposit,
+		types.EventTypeCdpWithdrawal,
+		types.EventTypeCdpDraw,
+		types.EventTypeCdpRepay,
+		types.EventTypeCdpClose,
+	}, suite.historyTypes(history))
+	for i, e := range history {
+		suite.Equal(uint64(1), e.CdpID)
+		if i > 0 {
+			suite.True(e.Sequence > history[i-1].Sequence)
+		}
+	}
+	suite.Equal(cs(c("xrp", 400000000)), history[0].Amount)
+	suite.Equal(suite.addrs[0], history[0].Address)
+	suite.Equal(suite.ctx.BlockTime(), history[0].Time)
+	suite.True(history[2].Amount.AmountOf("usdx").IsPositive())
+	suite.Equal(suite.addrs[1], history[3].Address)
+	suite.Equal(cs(c("xrp", 50000000)), history[4].Amount)
+	suite.Equal(debt, history[6].Amount)
+	suite.Equal(ctx.BlockTime(), history[7].Time)
+
+	// the history of the other cdp is kept separately
+	suite.Equal([]string{types.EventTypeCreateCdp, types.EventTypeCdpDraw}, suite.historyTypes(suite.keeper.GetCdpHistory(ctx, 2)))
+}
+
+func (suite *HistoryTestSuite) TestHistoryOfLiquidatedCdp() {
+	suite.NoError(suite.keeper.AddCdp(suite.ctx, suite.addrs[0], cs(c("xrp", 400000000)), cs(c("usdx", 10000000))))
+	cdp, found := suite.keeper.GetCdpByOwnerAndDenom(suite.ctx, suite.addrs[0], "xrp")
+	suite.True(found)
+
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+	suite.NoError(suite.keeper.SeizeCollateral(ctx, cdp))
+	_, found = suite.keeper.GetCDP(ctx, "xrp", 1)
+	suite.False(found)
+
+	history := suite.keeper.GetCdpHistory(ctx, 1)
+	suite.Equal([]string{
+		types.EventTypeCreateCdp,
+		types.EventTypeCdpDraw,
+		types.HistoryTypeFeeAccrual,
+		types.EventTypeCdpLiquidation,
+	}, suite.historyTypes(history))
+	suite.Equal(cs(c("xrp", 400000000)), history[3].Amount)
+	suite.True(history[3].Address.Empty())
+}
+
+func (suite *HistoryTestSuite) TestPruneCdpHistory() {
+	suite.NoError(suite.keeper.AddCdp(suite.ctx, suite.addrs[0], cs(c("xrp", 400000000)), cs(c("usdx", 10000000))))
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 12))
+	suite.NoError(suite.keeper.DepositCollateral(ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 100000000))))
+
+	// entries are kept for the history retention
+	ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 24))
+	suite.keeper.PruneCdpHistory(ctx)
+	suite.Len(suite.keeper.GetCdpHistory(ctx, 1), 4)
+
+	ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour * 25))
+	suite.keeper.PruneCdpHistory(ctx)
+	suite.Equal([]string{
+		types.HistoryTypeFeeAccrual,
+		types.EventTypeCdpDeposit,
+	}, suite.historyTypes(suite.keeper.GetCdpHistory(ctx, 1)))
+
+	// disabling the history prunes all entries
+	params := suite.keeper.GetParams(ctx)
+	params.HistoryRetention = 0
+	suite.keeper.SetParams(ctx, params)
+	suite.keeper.PruneCdpHistory(ctx)
+	suite.Equal(types.CdpHistory{}, suite.keeper.GetCdpHistory(ctx, 1))
+
+	// entries keep being sequenced after pruned entries
+	params.HistoryRetention = time.Hour
+	suite.keeper.SetParams(ctx, params)
+	suite.NoError(suite.keeper.DepositCollateral(ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 100000000))))
+	history := suite.keeper.GetCdpHistory(ctx, 1)
+	suite.Equal(uint64(4), history[0].Sequence)
+}
+
+func TestHistoryTestSuite(t *testing.T) {
+	suite.Run(t, new(HistoryTestSuite))
+}
@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// Hooks wrapper struct for the auction hooks of the cdp keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ auctiontypes.AuctionHooks = Hooks{}
+
+// Hooks returns the auction hooks that account for the debt recovered by liquidator auctions
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// AfterAuctionStarted records the debt escrowed by a new collateral auction
+func (h Hooks) AfterAuctionStarted(ctx sdk.Context, auction auctiontypes.Auction) {
+	debt, ok := collateralAuctionDebt(auction)
+	if !ok {
+		return
+	}
+	h.k.SetAuctionDebt(ctx, types.NewAuctionDebt(auction.GetID(), debt))
+}
+
+// AfterBidPlaced counts the debt returned to the liquidator by a bid on a collateral auction as recovered
+func (h Hooks) AfterBidPlaced(ctx sdk.Context, auction auctiontypes.Auction, bidder sdk.AccAddress, lot, bid sdk.Coin) {
+	debt, ok := collateralAuctionDebt(auction)
+	if !ok {
+		return
+	}
+	ad, found := h.k.GetAuctionDebt(ctx, auction.GetID())
+	if !found || ad.Debt.Denom != debt.Denom {
+		return
+	}
+	if recovered := ad.Debt.Amount.Sub(debt.Amount); recovered.IsPositive() {
+		h.k.IncrementRecoveredDebt(ctx, sdk.NewCoin(debt.Denom, recovered))
+	}
+	h.k.SetAuctionDebt(ctx, types.NewAuctionDebt(auction.GetID(), debt))
+}
+
+// AfterAuctionClosed counts the debt a collateral auction did not recover as a realized loss
+func (h Hooks) AfterAuctionClosed(ctx sdk.Context, closed auctiontypes.ClosedAuction) {
+	ad, found := h.k.GetAuctionDebt(ctx, closed.ID)
+	if !found {
+		return
+	}
+	if ad.Debt.IsPositive() {
+		h.k.IncrementRealizedLoss(ctx, ad.Debt)
+	}
+	h.k.DeleteAuctionDebt(ctx, closed.ID)
+}
+
+// collateralAuctionDebt returns the debt still escrowed by a collateral or dutch auction
+func collateralAuctionDebt(auction auctiontypes.Auction) (sdk.Coin, bool) {
+	switch a := auction.(type) {
+	case auctiontypes.CollateralAuction:
+		return a.CorrespondingDebt, true
+	case auctiontypes.DutchAuction:
+		return a.CorrespondingDebt, true
+	default:
+		return sdk.Coin{}, false
+	}
+}
+
+// GetAuctionDebt returns the debt not yet recovered by an open collateral auction
+func (k Keeper) GetAuctionDebt(ctx sdk.Context, auctionID uint64) (types.AuctionDebt, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AuctionDebtKeyPrefix)
+	bz := store.Get(sdk.Uint64ToBigEndian(auctionID))
+	if bz == nil {
+		return types.AuctionDebt{}, false
+	}
+	var ad types.AuctionDebt
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &ad)
+	return ad, true
+}
+
+// SetAuctionDebt sets the debt not yet recovered by an open collateral auction
+func (k Keeper) SetAuctionDebt(ctx sdk.Context, ad types.AuctionDebt) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AuctionDebtKeyPrefix)
+	store.Set(sdk.Uint64ToBigEndian(ad.AuctionID), k.cdc.MustMarshalBinaryLengthPrefixed(ad))
+}
+
+// DeleteAuctionDebt deletes the debt record of a collateral auction
+func (k Keeper) DeleteAuctionDebt(ctx sdk.Context, auctionID uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AuctionDebtKeyPrefix)
+	store.Delete(sdk.Uint64ToBigEndian(auctionID))
+}
+
+// IterateAuctionDebts iterates over the debt records of open collateral auctions and performs a callback function
+func (k Keeper) IterateAuctionDebts(ctx sdk.Context, cb func(ad types.AuctionDebt) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.AuctionDebtKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var ad types.AuctionDebt
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &ad)
+		if cb(ad) {
+			break
+		}
+	}
+}
+
+// GetRecoveredDebt returns the total internal debt of the input denom recovered by collateral auctions
+func (k Keeper) GetRecoveredDebt(ctx sdk.Context, denom string) sdk.Int {
+	return k.getDenomAmount(ctx, types.RecoveredDebtPrefix, denom)
+}
+
+// SetRecoveredDebt sets the total internal debt of the input denom recovered by collateral auctions
+func (k Keeper) SetRecoveredDebt(ctx sdk.Context, denom string, total sdk.Int) {
+	k.setDenomAmount(ctx, types.RecoveredDebtPrefix, denom, total)
+}
+
+// GetAllRecoveredDebt returns the total internal debt of each denom recovered by collateral auctions
+func (k Keeper) GetAllRecoveredDebt(ctx sdk.Context) sdk.Coins {
+	return k.getDenomAmounts(ctx, types.RecoveredDebtPrefix)
+}
+
+// IncrementRecoveredDebt increments the total internal debt recovered by collateral auctions
+func (k Keeper) IncrementRecoveredDebt(ctx sdk.Context, debt sdk.Coin) {
+	k.SetRecoveredDebt(ctx, debt.Denom, k.GetRecoveredDebt(ctx, debt.Denom).Add(debt.Amount))
+}
+
+// GetRealizedLoss returns the total internal debt of the input denom left unrecovered by closed collateral auctions
+func (k Keeper) GetRealizedLoss(ctx sdk.Context, denom string) sdk.Int {
+	return k.getDenomAmount(ctx, types.RealizedLossPrefix, denom)
+}
+
+// SetRealizedLoss sets the total internal debt of the input denom left unrecovered by closed collateral auctions
+func (k Keeper) SetRealizedLoss(ctx sdk.Context, denom string, total sdk.Int) {
+	k.setDenomAmount(ctx, types.RealizedLossPrefix, denom, total)
+}
+
+// GetAllRealizedLoss returns the total internal debt of each denom left unrecovered by closed collateral auctions
+func (k Keeper) GetAllRealizedLoss(ctx sdk.Context) sdk.Coins {
+	return k.getDenomAmounts(ctx, types.RealizedLossPrefix)
+}
+
+// IncrementRealizedLoss increments the total internal debt left unrecovered by closed collateral auctions
+func (k Keeper) IncrementRealizedLoss(ctx sdk.Context, debt sdk.Coin) {
+	k.SetRealizedLoss(ctx, debt.Denom, k.GetRealizedLoss(ctx, debt.Denom).Add(debt.Amount))
+}
@@ -101,25 +101,26 @@ func NewPricefeedGenStateMulti() app.GenesisState {
 func NewCDPGenStateMulti() app.GenesisState {
 	cdpGenesis := cdp.GenesisState{
 		Params: cdp.Params{
-			GlobalDebtLimit:         sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000), sdk.NewInt64Coin("susd", 1000000000000)),
+			GlobalDebtLimit:         sdk.NewCoins(sdk.NewInt64Coin("usdx", 2000000000000), sdk.NewInt64Coin("susd", 2000000000000)),
 			SurplusAuctionThreshold: cdp.DefaultSurplusThreshold,
 			DebtAuctionThreshold:    cdp.DefaultDebtThreshold,
 			CollateralParams: cdp.CollateralParams{
 				{
-					Denom:              "xrp",
-					LiquidationRatio:   sdk.MustNewDecFromStr("2.0"),
-					DebtLimit:          sdk.NewCoins(sdk.NewInt64Coin("usdx", 500000000000), sdk.NewInt64Coin("susd", 500000000000)),
-					StabilityFee:       sdk.MustNewDecFromStr("1.000000001547125958"), // %5 apr
-					LiquidationPenalty: d("0.05"),
-					AuctionSize:        i(7000000000),
-					Prefix:             0x20,
-					MarketID:           "xrp:usd",
-					ConversionFactor:   i(6),
+					Denom:                  "xrp",
+					LiquidationRatio:       sdk.MustNewDecFromStr("2.0"),
+					DebtLimit:              sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000), sdk.NewInt64Coin("susd", 1000000000000)),
+					StabilityFee:           sdk.MustNewDecFromStr("1.000000001547125958"), // %5 apr
+					LiquidationPenalty:     d("0.05"),
+					AuctionSize:            i(7000000000),
+					Prefix:                 0x20,
+					MarketID:               "xrp:usd",
+					ConversionFactor:       i(6),
+					KeeperRewardPercentage: d("0.5"),
 				},
 				{
 					Denom:              "btc",
 					LiquidationRatio:   sdk.MustNewDecFromStr("1.5"),
-					DebtLimit:          sdk.NewCoins(sdk.NewInt64Coin("usdx", 500000000000), sdk.NewInt64Coin("susd", 500000000000)),
+					DebtLimit:          sdk.NewCoins(sdk.NewInt64Coin("usdx", 1000000000000), sdk.NewInt64Coin("susd", 1000000000000)),
 					StabilityFee:       sdk.MustNewDecFromStr("1.000000000782997609"), // %2.5 apr
 					LiquidationPenalty: d("0.025"),
 					AuctionSize:        i(10000000),
@@ -140,6 +141,7 @@ func NewCDPGenStateMulti() app.GenesisState {
 					ReferenceAsset:   "usd",
 					ConversionFactor: i(6),
 					DebtFloor:        i(10000000),
+					DebtDenom:        "susddebt",
 				},
 			},
 		},
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// RegisterInvariants registers all cdp invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "savings-payouts", SavingsPayoutsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "savings-deposits", SavingsDepositsInvariant(k))
+}
+
+// SavingsPayoutsInvariant checks that the savings paid for each debt asset never exceed the stability fees collected
+func SavingsPayoutsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		broken := false
+		k.iterateDenomAmounts(ctx, types.SavingsDistributedPrefix, func(denom string, distributed sdk.Int) bool {
+			collected := k.GetSurplusCollected(ctx, denom)
+			if distributed.GT(collected) {
+				broken = true
+				msg += fmt.Sprintf("\tsavings paid %s%s exceed surplus collected %s%s\n", distributed, denom, collected, denom)
+			}
+			return false
+		})
+		return sdk.FormatInvariant(types.ModuleName, "savings payouts",
+			fmt.Sprintf("savings paid exceed surplus collected:\n%s", msg)), broken
+	}
+}
+
+// SavingsDepositsInvariant checks that the savings deposits of each debt asset are covered by the savings module account
+func SavingsDepositsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		deposits := sdk.NewCoins()
+		k.IterateSavingsDeposits(ctx, func(deposit types.SavingsDeposit) (stop bool) {
+			deposits = deposits.Add(sdk.NewCoins(k.SyncSavingsDeposit(ctx, deposit).Amount))
+			return false
+		})
+		balance := k.supplyKeeper.GetModuleAccount(ctx, types.SavingsRateMacc).GetCoins()
+
+		var msg string
+		broken := false
+		k.iterateDenomAmounts(ctx, types.TotalSavingsKeyPrefix, func(denom string, total sdk.Int) bool {
+			if deposits.AmountOf(denom).GT(total) || total.GT(balance.AmountOf(denom)) {
+				broken = true
+				msg += fmt.Sprintf("\tdeposits %s%s, total savings %s%s, savings balance %s%s\n",
+					deposits.AmountOf(denom), denom, total, denom, balance.AmountOf(denom), denom)
+			}
+			return false
+		})
+		return sdk.FormatInvariant(types.ModuleName, "savings deposits",
+			fmt.Sprintf("savings deposits exceed savings module account balance:\n%s", msg)), broken
+	}
+}
@@ -34,6 +34,11 @@ func NewKeeper(cdc *codec.Codec, key sdk.StoreKey, paramstore subspace.Subspace,
 		panic(fmt.Sprintf("%s module account has not been set", types.LiquidatorMacc))
 	}
 
+	// ensure savings rate module account is set
+	if addr := sk.GetModuleAddress(types.SavingsRateMacc); addr == nil {
+		panic(fmt.Sprintf("%s module account has not been set", types.SavingsRateMacc))
+	}
+
 	return Keeper{
 		key:             key,
 		cdc:             cdc,
@@ -108,3 +113,48 @@ func (k Keeper) IterateCdpsByCollateralRatio(ctx sdk.Context, denom string, targ
 
 	}
 }
+
+// IterateAllCdpsByCollateralRatio iterates over all cdps with collateral denom equal to denom in collateral:debt ratio order,
+// lowest first, and performs a callback function
+func (k Keeper) IterateAllCdpsByCollateralRatio(ctx sdk.Context, denom string, cb func(cdp types.CDP) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.CollateralRatioIndexPrefix)
+	db, _ := k.GetDenomPrefix(ctx, denom)
+	iterator := sdk.KVStorePrefixIterator(store, types.DenomIterKey(db))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, id, _ := types.SplitCollateralRatioKey(iterator.Key())
+		cdp, found := k.GetCDP(ctx, denom, id)
+		if !found {
+			panic(fmt.Sprintf("cdp %d does not exist", id))
+		}
+		if cb(cdp) {
+			break
+		}
+	}
+}
+
+// IterateBasketCdps iterates over cdps of the input collateral type that hold a basket of collateral denoms and performs a callback function
+func (k Keeper) IterateBasketCdps(ctx sdk.Context, denom string, cb func(cdp types.CDP) (stop bool)) {
+	k.IterateBasketCdpsFrom(ctx, denom, 0, cb)
+}
+
+// IterateBasketCdpsFrom iterates over cdps of the input collateral type that hold a basket of collateral denoms, in id order
+// starting from the input id, and performs a callback function
+func (k Keeper) IterateBasketCdpsFrom(ctx sdk.Context, denom string, startID uint64, cb func(cdp types.CDP) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BasketCdpIndexPrefix)
+	db, _ := k.GetDenomPrefix(ctx, denom)
+	iterator := store.Iterator(types.CdpKey(db, startID), sdk.PrefixEndBytes(types.DenomIterKey(db)))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		id := types.GetCdpIDFromBytes(iterator.Value())
+		cdp, found := k.GetCDP(ctx, denom, id)
+		if !found {
+			panic(fmt.Sprintf("cdp %d does not exist", id))
+		}
+		if cb(cdp) {
+			break
+		}
+	}
+}
@@ -41,6 +41,11 @@ func (k Keeper) GetDebtParam(ctx sdk.Context, denom string) (types.DebtParam, bo
 	return types.DebtParam{}, false
 }
 
+// GetSurplusBuffer returns the amount of the input debt asset the liquidator module account retains before starting surplus auctions
+func (k Keeper) GetSurplusBuffer(ctx sdk.Context, denom string) sdk.Int {
+	return k.GetParams(ctx).SurplusBuffer.AmountOf(denom)
+}
+
 // GetDenomPrefix returns the prefix of the matching denom
 func (k Keeper) GetDenomPrefix(ctx sdk.Context, denom string) (byte, bool) {
 	params := k.GetParams(ctx)
@@ -87,6 +92,33 @@ func (k Keeper) getLiquidationPenalty(ctx sdk.Context, denom string) sdk.Dec {
 	return cp.LiquidationPenalty
 }
 
+func (k Keeper) getKeeperRewardPercentage(ctx sdk.Context, denom string) sdk.Dec {
+	cp, found := k.GetCollateral(ctx, denom)
+	if !found {
+		panic(fmt.Sprintf("collateral not found: %s", denom))
+	}
+	if cp.KeeperRewardPercentage.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return cp.KeeperRewardPercentage
+}
+
+func (k Keeper) getMaxLiquidationsPerBlock(ctx sdk.Context, denom string) uint64 {
+	cp, found := k.GetCollateral(ctx, denom)
+	if !found {
+		panic(fmt.Sprintf("collateral not found: %s", denom))
+	}
+	return cp.MaxLiquidationsPerBlock
+}
+
+func (k Keeper) getMaxBasketChecksPerBlock(ctx sdk.Context, denom string) uint64 {
+	cp, found := k.GetCollateral(ctx, denom)
+	if !found {
+		panic(fmt.Sprintf("collateral not found: %s", denom))
+	}
+	return cp.MaxBasketChecksPerBlock
+}
+
 func (k Keeper) getAuctionSize(ctx sdk.Context, denom string) sdk.Int {
 	cp, found := k.GetCollateral(ctx, denom)
 	if !found {
@@ -95,11 +127,87 @@ func (k Keeper) getAuctionSize(ctx sdk.Context, denom string) sdk.Int {
 	return cp.AuctionSize
 }
 
-// GetFeeRate returns the per second fee rate for the input denom
-func (k Keeper) getFeeRate(ctx sdk.Context, denom string) (fee sdk.Dec) {
-	collalateralParam, found := k.GetCollateral(ctx, denom)
+// GetStabilityFee returns the per second stability fee charged on the input debt denom drawn against the input collateral.
+// The fee is the scheduled fee in effect at the current block time, raised along the utilization curve
+// when the principal drawn is above the utilization kink of the debt limit.
+func (k Keeper) GetStabilityFee(ctx sdk.Context, collateralDenom string, principalDenom string) sdk.Dec {
+	cp, found := k.GetCollateral(ctx, collateralDenom)
 	if !found {
-		panic(fmt.Sprintf("could not get fee rate for %s, collateral not found", denom))
+		panic(fmt.Sprintf("could not get fee rate for %s, collateral not found", collateralDenom))
+	}
+	fee := k.getScheduledStabilityFee(ctx, cp)
+	if !cp.HasUtilizationCurve() || cp.MaxStabilityFee.LTE(fee) {
+		return fee
+	}
+	utilization := k.GetUtilization(ctx, collateralDenom, principalDenom)
+	if utilization.LTE(cp.UtilizationKink) {
+		return fee
+	}
+	if utilization.GT(sdk.OneDec()) {
+		utilization = sdk.OneDec()
+	}
+	slope := utilization.Sub(cp.UtilizationKink).Quo(sdk.OneDec().Sub(cp.UtilizationKink))
+	return fee.Add(cp.MaxStabilityFee.Sub(fee).Mul(slope))
+}
+
+// GetUtilization returns the fraction of the debt limit for the input debt denom that has been drawn against the input collateral
+func (k Keeper) GetUtilization(ctx sdk.Context, collateralDenom string, principalDenom string) sdk.Dec {
+	totalPrincipal := k.GetTotalPrincipal(ctx, collateralDenom, principalDenom)
+	debtLimit := k.GetDebtLimit(ctx, collateralDenom).AmountOf(principalDenom)
+	return calculateUtilization(totalPrincipal, debtLimit)
+}
+
+// calculateUtilization returns the fraction of the input debt limit taken up by the input principal, which is 1 for a zero debt limit with principal drawn
+func calculateUtilization(totalPrincipal sdk.Int, debtLimit sdk.Int) sdk.Dec {
+	if !debtLimit.IsPositive() {
+		if totalPrincipal.IsPositive() {
+			return sdk.OneDec()
+		}
+		return sdk.ZeroDec()
+	}
+	return sdk.NewDecFromInt(totalPrincipal).QuoInt(debtLimit)
+}
+
+// GetDebtLimit returns the debt limit in effect for the input collateral at the current block time
+func (k Keeper) GetDebtLimit(ctx sdk.Context, collateralDenom string) sdk.Coins {
+	cp, found := k.GetCollateral(ctx, collateralDenom)
+	if !found {
+		panic(fmt.Sprintf("collateral not found: %s", collateralDenom))
+	}
+	debtLimit := cp.DebtLimit
+	for _, sc := range cp.Schedule {
+		if sc.Time.After(ctx.BlockTime()) {
+			break
+		}
+		if !sc.DebtLimit.Empty() {
+			debtLimit = sc.DebtLimit
+		}
+	}
+	return debtLimit
+}
+
+// getScheduledStabilityFee returns the stability fee of the input collateral param in effect at the current block time
+func (k Keeper) getScheduledStabilityFee(ctx sdk.Context, cp types.CollateralParam) sdk.Dec {
+	fee := cp.StabilityFee
+	for _, sc := range cp.Schedule {
+		if sc.Time.After(ctx.BlockTime()) {
+			break
+		}
+		if sc.ChangesStabilityFee() {
+			fee = sc.StabilityFee
+		}
+	}
+	return fee
+}
+
+// getDebtFeeRate returns the per second fee rate applied to the input debt denom on top of the collateral fee rate
+func (k Keeper) getDebtFeeRate(ctx sdk.Context, denom string) (fee sdk.Dec) {
+	dp, found := k.GetDebtParam(ctx, denom)
+	if !found {
+		panic(fmt.Sprintf("could not get fee rate for %s, debt param not found", denom))
+	}
+	if dp.StabilityFee.IsNil() || dp.StabilityFee.IsZero() {
+		return sdk.OneDec()
 	}
-	return collalateralParam.StabilityFee
+	return dp.StabilityFee
 }
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+	"sort"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -14,6 +17,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryGetCdp:
 			return queryGetCdp(ctx, req, keeper)
+		case types.QueryGetCdpHealth:
+			return queryGetCdpHealth(ctx, req, keeper)
+		case types.QueryGetCdpHistory:
+			return queryGetCdpHistory(ctx, req, keeper)
 		case types.QueryGetCdps:
 			return queryGetCdpsByDenom(ctx, req, keeper)
 		case types.QueryGetCdpsByCollateralization:
@@ -22,6 +29,22 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryGetParams(ctx, req, keeper)
 		case types.QueryGetCdpDeposits:
 			return queryGetDeposits(ctx, req, keeper)
+		case types.QueryGetDebtAssets:
+			return queryGetDebtAssets(ctx, req, keeper)
+		case types.QueryGetSystemSurplus:
+			return queryGetSystemSurplus(ctx, req, keeper)
+		case types.QueryGetSavingsDeposit:
+			return queryGetSavingsDeposit(ctx, req, keeper)
+		case types.QueryGetSavingsPools:
+			return queryGetSavingsPools(ctx, req, keeper)
+		case types.QueryGetSettlement:
+			return queryGetSettlement(ctx, req, keeper)
+		case types.QueryGetStats:
+			return queryGetStats(ctx, req, keeper)
+		case types.QueryGetLiquidationSimulation:
+			return queryGetLiquidationSimulation(ctx, req, keeper)
+		case types.QueryGetFeeRates:
+			return queryGetFeeRates(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown cdp query endpoint")
 		}
@@ -59,6 +82,53 @@ func queryGetCdp(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 
 }
 
+// query the ordered history of a cdp, including cdps that have been closed or liquidated
+func queryGetCdpHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var requestParams types.QueryCdpHistoryParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	history := keeper.GetCdpHistory(ctx, requestParams.CdpID)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, history)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// query the liquidation price and borrowing capacity of a cdp
+func queryGetCdpHealth(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var requestParams types.QueryCdpParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	_, valid := keeper.GetDenomPrefix(ctx, requestParams.CollateralDenom)
+	if !valid {
+		return nil, types.ErrInvalidCollateralDenom(keeper.codespace, requestParams.CollateralDenom)
+	}
+
+	cdp, found := keeper.GetCdpByOwnerAndDenom(ctx, requestParams.Owner, requestParams.CollateralDenom)
+	if !found {
+		return nil, types.ErrCdpNotFound(keeper.codespace, requestParams.Owner, requestParams.CollateralDenom)
+	}
+
+	health, sdkErr := keeper.LoadCDPHealth(ctx, cdp)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, health)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 // query deposits on a particular cdp
 func queryGetDeposits(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	var requestParams types.QueryCdpDeposits
@@ -104,14 +174,24 @@ func queryGetCdpsByRatio(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could get collateralization ratio from absolute ratio", err.Error()))
 	}
 
-	cdps := keeper.GetAllCdpsByDenomAndRatio(ctx, requestParams.CollateralDenom, ratio)
-	// augment CDPs by adding collateral value and collateralization ratio
-	var augmentedCDPs types.AugmentedCDPs
-	for _, cdp := range cdps {
-		augmentedCDP, err := keeper.LoadAugmentedCDP(ctx, cdp)
-		if err == nil {
-			augmentedCDPs = append(augmentedCDPs, augmentedCDP)
+	// cdps under the ratio are visited in ratio order, or in id order filtered by their collateralization ratio
+	filter := cdpFilter(requestParams.Owner, requestParams.MinID, requestParams.MaxID)
+	iterate := func(cb func(cdp types.CDP) bool) {
+		iterateCdpsByRatio(ctx, keeper, requestParams.CollateralDenom, ratio, requestParams.Ratio, cb)
+	}
+	if requestParams.SortBy == types.SortByID {
+		idFilter := filter
+		filter = func(cdp types.CDP) bool {
+			collateralizationRatio, err := keeper.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Principal, cdp.AccumulatedFees)
+			return err == nil && collateralizationRatio.LT(requestParams.Ratio) && idFilter(cdp)
 		}
+		iterate = func(cb func(cdp types.CDP) bool) {
+			keeper.IterateCdpsByDenom(ctx, requestParams.CollateralDenom, cb)
+		}
+	}
+	augmentedCDPs, sdkErr := listCdps(ctx, keeper, iterate, filter, requestParams.SortBy, requestParams.Page, requestParams.Limit)
+	if sdkErr != nil {
+		return nil, sdkErr
 	}
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, augmentedCDPs)
 	if err != nil {
@@ -132,15 +212,20 @@ func queryGetCdpsByDenom(ctx sdk.Context, req abci.RequestQuery, keeper Keeper)
 		return nil, types.ErrInvalidCollateralDenom(keeper.codespace, requestParams.CollateralDenom)
 	}
 
-	cdps := keeper.GetAllCdpsByDenom(ctx, requestParams.CollateralDenom)
-	// augment CDPs by adding collateral value and collateralization ratio
-	var augmentedCDPs types.AugmentedCDPs
-	for _, cdp := range cdps {
-		augmentedCDP, err := keeper.LoadAugmentedCDP(ctx, cdp)
-		if err == nil {
-			augmentedCDPs = append(augmentedCDPs, augmentedCDP)
+	// cdps are visited in id order, or in collateralization ratio order
+	iterate := func(cb func(cdp types.CDP) bool) {
+		keeper.IterateCdpsByDenom(ctx, requestParams.CollateralDenom, cb)
+	}
+	if requestParams.SortBy == types.SortByRatio {
+		iterate = func(cb func(cdp types.CDP) bool) {
+			iterateCdpsByRatio(ctx, keeper, requestParams.CollateralDenom, sdk.Dec{}, sdk.Dec{}, cb)
 		}
 	}
+	filter := cdpFilter(requestParams.Owner, requestParams.MinID, requestParams.MaxID)
+	augmentedCDPs, sdkErr := listCdps(ctx, keeper, iterate, filter, requestParams.SortBy, requestParams.Page, requestParams.Limit)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, augmentedCDPs)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
@@ -160,3 +245,304 @@ func queryGetParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]by
 	}
 	return bz, nil
 }
+
+// query the state of each debt asset
+func queryGetDebtAssets(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	debtAssets := keeper.GetDebtAssets(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, debtAssets)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// query the surplus buffer, liquidator surplus and bad debt, and pending liquidator auctions
+func queryGetSystemSurplus(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	systemSurplus := keeper.GetSystemSurplus(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, systemSurplus)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// query the current and scheduled stability fees of a collateral type
+func queryGetFeeRates(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var requestParams types.QueryFeeRatesParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	feeRates, found := keeper.GetCollateralFeeRates(ctx, requestParams.CollateralDenom)
+	if !found {
+		return nil, types.ErrInvalidCollateralDenom(keeper.codespace, requestParams.CollateralDenom)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, feeRates)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// query the aggregate collateral, debt and risk of the cdp system
+func queryGetStats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	stats := keeper.GetStats(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, stats)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// query the cdps that would be liquidated, and the auctions that would be started, at a hypothetical collateral price
+func queryGetLiquidationSimulation(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var requestParams types.QuerySimulateParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	simulation, sdkErr := keeper.SimulateLiquidations(ctx, requestParams.CollateralDenom, requestParams.Price, requestParams.LiquidationRatio)
+	if sdkErr != nil {
+		return nil, sdkErr
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, simulation)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// query a savings deposit, including the savings earned so far
+func queryGetSavingsDeposit(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var requestParams types.QuerySavingsParams
+	err := keeper.cdc.UnmarshalJSON(req.Data, &requestParams)
+	if err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("incorrectly formatted request data", err.Error()))
+	}
+
+	deposit, found := keeper.GetSavingsDeposit(ctx, requestParams.Depositor, requestParams.Denom)
+	if !found {
+		return nil, types.ErrSavingsDepositNotFound(keeper.codespace, requestParams.Depositor, requestParams.Denom)
+	}
+	deposit = keeper.SyncSavingsDeposit(ctx, deposit)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, deposit)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// query the savings deposits of each debt asset
+func queryGetSavingsPools(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	savingsPools := keeper.GetSavingsPools(ctx)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, savingsPools)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// query the state of the cdp system frozen by a global settlement
+func queryGetSettlement(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	settlement, found := keeper.GetSettlement(ctx)
+	if !found {
+		return nil, types.ErrSystemNotSettled(keeper.codespace)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, settlement)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+// listCdps augments the cdps on the requested page of those visited by iterate that pass the filter.
+// Cdps whose collateral can not be priced are skipped before paginating. Cdps sorted by id or ratio, or in the
+// default order, are paged in the order iterate visits them, so only the cdps up to the requested page are loaded.
+// Other orders load and sort every cdp that passes the filter.
+func listCdps(ctx sdk.Context, keeper Keeper, iterate func(cb func(cdp types.CDP) (stop bool)), filter func(cdp types.CDP) bool,
+	sortBy string, page, limit int) (types.AugmentedCDPs, sdk.Error) {
+	if page < 1 {
+		page = 1
+	}
+	switch sortBy {
+	case "", types.SortByID, types.SortByRatio:
+		var augmentedCDPs types.AugmentedCDPs
+		skip := (page - 1) * limit
+		iterate(func(cdp types.CDP) bool {
+			if !filter(cdp) {
+				return false
+			}
+			augmentedCDP, err := keeper.LoadAugmentedCDP(ctx, cdp)
+			if err != nil {
+				return false
+			}
+			if skip > 0 {
+				skip--
+				return false
+			}
+			augmentedCDPs = append(augmentedCDPs, augmentedCDP)
+			return limit > 0 && len(augmentedCDPs) >= limit
+		})
+		return augmentedCDPs, nil
+	case types.SortByDebt, types.SortByCollateral:
+	default:
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid sort order: %s", sortBy))
+	}
+
+	var cdps types.CDPs
+	augmented := make(map[uint64]types.AugmentedCDP)
+	iterate(func(cdp types.CDP) bool {
+		if !filter(cdp) {
+			return false
+		}
+		augmentedCDP, err := keeper.LoadAugmentedCDP(ctx, cdp)
+		if err != nil {
+			return false
+		}
+		cdps = append(cdps, cdp)
+		augmented[cdp.ID] = augmentedCDP
+		return false
+	})
+	sorted, err := keeper.sortCdps(ctx, cdps, sortBy)
+	if err != nil {
+		return nil, err
+	}
+	var augmentedCDPs types.AugmentedCDPs
+	for _, cdp := range paginateCdps(sorted, page, limit) {
+		augmentedCDPs = append(augmentedCDPs, augmented[cdp.ID])
+	}
+	return augmentedCDPs, nil
+}
+
+// cdpFilter returns a filter matching cdps of the input owner, if not empty, with ids in the input range, where a max id of 0 is unbounded
+func cdpFilter(owner sdk.AccAddress, minID, maxID uint64) func(cdp types.CDP) bool {
+	return func(cdp types.CDP) bool {
+		if !owner.Empty() && !cdp.Owner.Equals(owner) {
+			return false
+		}
+		return cdp.ID >= minID && (maxID == 0 || cdp.ID <= maxID)
+	}
+}
+
+// iterateCdpsByRatio visits the cdps of the input collateral type in collateralization ratio order, lowest first. When maxRatio is
+// not nil only cdps with a collateralization ratio below it are visited, and indexRatio is maxRatio in the units of the collateral
+// ratio index. Cdps in the collateral ratio index are visited in index order, which is at par debt, with basket cdps, which are not
+// in the index, merged in by their collateralization ratio. Cdps whose collateralization ratio can not be calculated are skipped.
+func iterateCdpsByRatio(ctx sdk.Context, keeper Keeper, denom string, indexRatio, maxRatio sdk.Dec, cb func(cdp types.CDP) (stop bool)) {
+	collateralizationRatio := func(cdp types.CDP) (sdk.Dec, bool) {
+		ratio, err := keeper.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Principal, cdp.AccumulatedFees)
+		if err != nil {
+			return sdk.Dec{}, false
+		}
+		return ratio, maxRatio.IsNil() || ratio.LT(maxRatio)
+	}
+
+	type rankedCdp struct {
+		cdp   types.CDP
+		ratio sdk.Dec
+	}
+	var baskets []rankedCdp
+	keeper.IterateBasketCdps(ctx, denom, func(cdp types.CDP) bool {
+		if ratio, ok := collateralizationRatio(cdp); ok {
+			baskets = append(baskets, rankedCdp{cdp, ratio})
+		}
+		return false
+	})
+	sort.SliceStable(baskets, func(i, j int) bool { return baskets[i].ratio.LT(baskets[j].ratio) })
+
+	stopped := false
+	visit := func(cdp types.CDP) bool {
+		ratio, ok := collateralizationRatio(cdp)
+		if !ok {
+			return false
+		}
+		for len(baskets) > 0 && baskets[0].ratio.LT(ratio) {
+			next := baskets[0]
+			baskets = baskets[1:]
+			if cb(next.cdp) {
+				stopped = true
+				return true
+			}
+		}
+		stopped = cb(cdp)
+		return stopped
+	}
+	if maxRatio.IsNil() {
+		keeper.IterateAllCdpsByCollateralRatio(ctx, denom, visit)
+	} else {
+		// debt priced above par moves cdps below the index ratio, so the index is walked up to the highest debt price
+		keeper.IterateCdpsByCollateralRatio(ctx, denom, indexRatio.Mul(keeper.getMaxDebtPrice(ctx)), visit)
+	}
+	for _, basket := range baskets {
+		if stopped || cb(basket.cdp) {
+			return
+		}
+	}
+}
+
+// sortCdps sorts the input cdps by debt or collateral value, largest first
+func (k Keeper) sortCdps(ctx sdk.Context, cdps types.CDPs, sortBy string) (types.CDPs, sdk.Error) {
+	var sortKey func(cdp types.CDP) (sdk.Dec, sdk.Error)
+	switch sortBy {
+	case types.SortByDebt:
+		sortKey = func(cdp types.CDP) (sdk.Dec, sdk.Error) {
+			return k.calculateDebtValue(ctx, cdp.Principal.Add(cdp.AccumulatedFees))
+		}
+	case types.SortByCollateral:
+		sortKey = func(cdp types.CDP) (sdk.Dec, sdk.Error) {
+			collateralValue := sdk.ZeroDec()
+			for _, cc := range cdp.Collateral {
+				value, err := k.calculateCollateralValue(ctx, cc)
+				if err != nil {
+					return sdk.Dec{}, err
+				}
+				collateralValue = collateralValue.Add(value)
+			}
+			return collateralValue, nil
+		}
+	default:
+		return nil, sdk.ErrUnknownRequest(fmt.Sprintf("invalid sort order: %s", sortBy))
+	}
+
+	keys := make(map[uint64]sdk.Dec, len(cdps))
+	for _, cdp := range cdps {
+		key, err := sortKey(cdp)
+		if err != nil {
+			return nil, err
+		}
+		keys[cdp.ID] = key
+	}
+	sort.SliceStable(cdps, func(i, j int) bool {
+		return keys[cdps[i].ID].GT(keys[cdps[j].ID])
+	})
+	return cdps, nil
+}
+
+// paginateCdps returns the cdps on the input page, or all cdps if the limit is 0
+func paginateCdps(cdps types.CDPs, page, limit int) types.CDPs {
+	if limit <= 0 {
+		return cdps
+	}
+	if page < 1 {
+		page = 1
+	}
+	start := (page - 1) * limit
+	if start < 0 || start >= len(cdps) {
+		return nil
+	}
+	end := start + limit
+	if end > len(cdps) {
+		end = len(cdps)
+	}
+	return cdps[start:end]
+}
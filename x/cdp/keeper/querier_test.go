@@ -148,11 +148,99 @@ func (suite *QuerierTestSuite) TestQueryCdp() {
 
 }
 
+func (suite *QuerierTestSuite) TestQueryCdpHealth() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetCdpHealth}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpParams(suite.cdps[0].Owner, suite.cdps[0].Collateral[0].Denom)),
+	}
+	bz, err := suite.querier(ctx, []string{types.QueryGetCdpHealth}, query)
+	suite.Nil(err)
+	suite.NotNil(bz)
+
+	var health types.CDPHealth
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &health))
+	expected, err := suite.keeper.LoadCDPHealth(ctx, suite.cdps[0])
+	suite.Nil(err)
+	suite.Equal(expected, health)
+
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetCdpHealth}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpParams(suite.cdps[0].Owner, "lol")),
+	}
+	_, err = suite.querier(ctx, []string{types.QueryGetCdpHealth}, query)
+	suite.Error(err)
+
+	// the error loading the health is returned
+	params := suite.keeper.GetParams(ctx)
+	params.DebtParams[0].MarketID = "usdx:usd"
+	suite.keeper.SetParams(ctx, params)
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetCdpHealth}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpParams(suite.cdps[0].Owner, suite.cdps[0].Collateral[0].Denom)),
+	}
+	_, err = suite.querier(ctx, []string{types.QueryGetCdpHealth}, query)
+	suite.Equal(pftypes.DefaultCodespace, err.Result().Codespace)
+	suite.Equal(pftypes.CodeInvalidPrice, err.Result().Code)
+}
+
+func (suite *QuerierTestSuite) TestQueryCdpHistory() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	params := suite.keeper.GetParams(ctx)
+	params.HistoryRetention = time.Hour
+	suite.keeper.SetParams(ctx, params)
+
+	// the history of a cdp that no longer exists is still returned
+	suite.keeper.RecordCdpHistory(ctx, 1000, types.EventTypeCdpLiquidation, nil, cs(c("xrp", 100)))
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetCdpHistory}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpHistoryParams(1000)),
+	}
+	bz, err := suite.querier(ctx, []string{types.QueryGetCdpHistory}, query)
+	suite.Nil(err)
+	suite.NotNil(bz)
+
+	var history types.CdpHistory
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &history))
+	suite.Len(history, 1)
+	suite.Equal(uint64(1000), history[0].CdpID)
+	suite.Equal(types.EventTypeCdpLiquidation, history[0].Type)
+	suite.Equal(cs(c("xrp", 100)), history[0].Amount)
+}
+
+func (suite *QuerierTestSuite) TestQueryLiquidationSimulation() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetLiquidationSimulation}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQuerySimulateParams("xrp", d("0.1"), sdk.Dec{})),
+	}
+	bz, err := suite.querier(ctx, []string{types.QueryGetLiquidationSimulation}, query)
+	suite.Nil(err)
+	suite.NotNil(bz)
+
+	var simulation types.LiquidationSimulation
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &simulation))
+	expected, err := suite.keeper.SimulateLiquidations(ctx, "xrp", d("0.1"), sdk.ZeroDec())
+	suite.Nil(err)
+	suite.Equal(len(expected.CDPs), len(simulation.CDPs))
+	suite.Equal(expected.CollateralSeized, simulation.CollateralSeized)
+	suite.Equal(expected.DebtSeized, simulation.DebtSeized)
+	suite.Equal(len(expected.Auctions), len(simulation.Auctions))
+	suite.Equal(d("2.0"), simulation.LiquidationRatio)
+
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetLiquidationSimulation}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQuerySimulateParams("lol", d("0.1"), sdk.Dec{})),
+	}
+	_, err = suite.querier(ctx, []string{types.QueryGetLiquidationSimulation}, query)
+	suite.Error(err)
+}
+
 func (suite *QuerierTestSuite) TestQueryCdpsByDenom() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetCdps}, "/"),
-		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpsParams(suite.cdps[0].Collateral[0].Denom)),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpsParams(suite.cdps[0].Collateral[0].Denom, nil, 0, 0, "", 0, 0)),
 	}
 	bz, err := suite.querier(ctx, []string{types.QueryGetCdps}, query)
 	suite.Nil(err)
@@ -164,12 +252,108 @@ func (suite *QuerierTestSuite) TestQueryCdpsByDenom() {
 
 	query = abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetCdps}, "/"),
-		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpsParams("lol")),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpsParams("lol", nil, 0, 0, "", 0, 0)),
 	}
 	_, err = suite.querier(ctx, []string{types.QueryGetCdps}, query)
 	suite.Error(err)
 }
 
+func (suite *QuerierTestSuite) TestQueryCdpsPagination() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	queryCdps := func(params types.QueryCdpsParams) (types.AugmentedCDPs, sdk.Error) {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetCdps}, "/"),
+			Data: types.ModuleCdc.MustMarshalJSON(params),
+		}
+		bz, err := suite.querier(ctx, []string{types.QueryGetCdps}, query)
+		if err != nil {
+			return nil, err
+		}
+		var cdps types.AugmentedCDPs
+		suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &cdps))
+		return cdps, nil
+	}
+
+	// xrp cdps have even ids, and are listed in id order by default
+	cdps, err := queryCdps(types.NewQueryCdpsParams("xrp", nil, 0, 0, "", 2, 20))
+	suite.Nil(err)
+	suite.Equal(20, len(cdps))
+	suite.Equal(uint64(42), cdps[0].ID)
+	suite.Equal(uint64(80), cdps[19].ID)
+	cdps, err = queryCdps(types.NewQueryCdpsParams("xrp", nil, 0, 0, "", 3, 20))
+	suite.Nil(err)
+	suite.Equal(10, len(cdps))
+	cdps, err = queryCdps(types.NewQueryCdpsParams("xrp", nil, 0, 0, "", 4, 20))
+	suite.Nil(err)
+	suite.Equal(0, len(cdps))
+
+	cdps, err = queryCdps(types.NewQueryCdpsParams("xrp", suite.addrs[3], 0, 0, "", 0, 0))
+	suite.Nil(err)
+	suite.Equal(1, len(cdps))
+	suite.Equal(suite.addrs[3], cdps[0].Owner)
+
+	cdps, err = queryCdps(types.NewQueryCdpsParams("xrp", nil, 10, 20, types.SortByID, 0, 0))
+	suite.Nil(err)
+	suite.Equal(6, len(cdps))
+	suite.Equal(uint64(10), cdps[0].ID)
+	suite.Equal(uint64(20), cdps[5].ID)
+
+	cdps, err = queryCdps(types.NewQueryCdpsParams("xrp", nil, 0, 0, types.SortByDebt, 0, 0))
+	suite.Nil(err)
+	suite.Equal(50, len(cdps))
+	for j := 1; j < len(cdps); j++ {
+		suite.True(cdps[j-1].Principal.AmountOf("usdx").GTE(cdps[j].Principal.AmountOf("usdx")))
+	}
+
+	cdps, err = queryCdps(types.NewQueryCdpsParams("btc", nil, 0, 0, types.SortByRatio, 1, 10))
+	suite.Nil(err)
+	suite.Equal(10, len(cdps))
+	for j := 1; j < len(cdps); j++ {
+		suite.True(cdps[j-1].CollateralizationRatio.LTE(cdps[j].CollateralizationRatio))
+	}
+
+	cdps, err = queryCdps(types.NewQueryCdpsParams("btc", nil, 0, 0, types.SortByCollateral, 1, 10))
+	suite.Nil(err)
+	for j := 1; j < len(cdps); j++ {
+		suite.True(cdps[j-1].Collateral.AmountOf("btc").GTE(cdps[j].Collateral.AmountOf("btc")))
+	}
+
+	_, err = queryCdps(types.NewQueryCdpsParams("btc", nil, 0, 0, "size", 0, 0))
+	suite.Error(err)
+
+	// cdps that can not be priced are skipped before paginating
+	suite.addUnpricedCollateral(ctx)
+	cdps, err = queryCdps(types.NewQueryCdpsParams("xrp", nil, 0, 0, "", 1, 20))
+	suite.Nil(err)
+	suite.Equal(20, len(cdps))
+	suite.Equal(uint64(4), cdps[0].ID)
+	suite.Equal(uint64(42), cdps[19].ID)
+	cdps, err = queryCdps(types.NewQueryCdpsParams("xrp", nil, 0, 0, "", 3, 20))
+	suite.Nil(err)
+	suite.Equal(9, len(cdps))
+	cdps, err = queryCdps(types.NewQueryCdpsParams("xrp", nil, 0, 0, types.SortByRatio, 3, 20))
+	suite.Nil(err)
+	suite.Equal(9, len(cdps))
+	cdps, err = queryCdps(types.NewQueryCdpsParams("xrp", nil, 0, 0, types.SortByDebt, 3, 20))
+	suite.Nil(err)
+	suite.Equal(9, len(cdps))
+
+	// cdps under a ratio are listed riskiest first by default
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetCdpsByCollateralization}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpsByRatioParams("xrp", d("2.0"), nil, 0, 0, "", 1, 5)),
+	}
+	bz, err := suite.querier(ctx, []string{types.QueryGetCdpsByCollateralization}, query)
+	suite.Nil(err)
+	cdps = types.AugmentedCDPs{}
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &cdps))
+	suite.True(len(cdps) <= 5)
+	for j := 1; j < len(cdps); j++ {
+		suite.True(cdps[j-1].CollateralizationRatio.LTE(cdps[j].CollateralizationRatio))
+	}
+
+}
+
 func (suite *QuerierTestSuite) TestQueryCdpsByRatio() {
 	ratioCountBtc := 0
 	ratioCountXrp := 0
@@ -197,7 +381,7 @@ func (suite *QuerierTestSuite) TestQueryCdpsByRatio() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	query := abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetCdpsByCollateralization}, "/"),
-		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpsByRatioParams("xrp", xrpRatio)),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpsByRatioParams("xrp", xrpRatio, nil, 0, 0, "", 0, 0)),
 	}
 	bz, err := suite.querier(ctx, []string{types.QueryGetCdpsByCollateralization}, query)
 	suite.Nil(err)
@@ -212,9 +396,24 @@ func (suite *QuerierTestSuite) TestQueryCdpsByRatio() {
 	sort.Ints(actualXrpIds)
 	suite.Equal(expectedXrpIds, actualXrpIds)
 
+	// cdps under the ratio can be listed in id order
 	query = abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetCdpsByCollateralization}, "/"),
-		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpsByRatioParams("btc", btcRatio)),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpsByRatioParams("xrp", xrpRatio, nil, 0, 0, types.SortByID, 0, 0)),
+	}
+	bz, err = suite.querier(ctx, []string{types.QueryGetCdpsByCollateralization}, query)
+	suite.Nil(err)
+	c = types.AugmentedCDPs{}
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &c))
+	actualXrpIds = []int{}
+	for _, k := range c {
+		actualXrpIds = append(actualXrpIds, int(k.ID))
+	}
+	suite.Equal(expectedXrpIds, actualXrpIds)
+
+	query = abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetCdpsByCollateralization}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpsByRatioParams("btc", btcRatio, nil, 0, 0, "", 0, 0)),
 	}
 	bz, err = suite.querier(ctx, []string{types.QueryGetCdpsByCollateralization}, query)
 	suite.Nil(err)
@@ -231,7 +430,7 @@ func (suite *QuerierTestSuite) TestQueryCdpsByRatio() {
 
 	query = abci.RequestQuery{
 		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetCdpsByCollateralization}, "/"),
-		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpsByRatioParams("xrp", d("0.003"))),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryCdpsByRatioParams("xrp", d("0.003"), nil, 0, 0, "", 0, 0)),
 	}
 	bz, err = suite.querier(ctx, []string{types.QueryGetCdpsByCollateralization}, query)
 	suite.Nil(err)
@@ -241,6 +440,56 @@ func (suite *QuerierTestSuite) TestQueryCdpsByRatio() {
 	suite.Equal(0, len(c))
 }
 
+func (suite *QuerierTestSuite) TestQueryCdpsByRatioBasket() {
+	// adding btc to an xrp cdp moves it out of the collateral ratio index
+	basket := suite.cdps[1]
+	suite.Nil(suite.keeper.DepositCollateral(suite.ctx, basket.Owner, basket.Owner, "xrp", cs(c("btc", 1000000))))
+	basket, _ = suite.keeper.GetCDP(suite.ctx, "xrp", basket.ID)
+	suite.True(basket.IsBasket())
+	basketRatio, err := suite.keeper.CalculateCollateralizationRatio(suite.ctx, basket.Collateral, basket.Principal, basket.AccumulatedFees)
+	suite.Nil(err)
+
+	ctx := suite.ctx.WithIsCheckTx(false)
+	queryCdps := func(path string, params interface{}) types.AugmentedCDPs {
+		query := abci.RequestQuery{
+			Path: strings.Join([]string{custom, types.QuerierRoute, path}, "/"),
+			Data: types.ModuleCdc.MustMarshalJSON(params),
+		}
+		bz, err := suite.querier(ctx, []string{path}, query)
+		suite.Nil(err)
+		var cdps types.AugmentedCDPs
+		suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &cdps))
+		return cdps
+	}
+	containsBasket := func(cdps types.AugmentedCDPs) bool {
+		for _, cdp := range cdps {
+			if cdp.ID == basket.ID {
+				return true
+			}
+		}
+		return false
+	}
+
+	// basket cdps are listed by their collateralization ratio, in ratio order and in id order
+	above := basketRatio.Add(d("0.000001"))
+	byRatio := queryCdps(types.QueryGetCdpsByCollateralization, types.NewQueryCdpsByRatioParams("xrp", above, nil, 0, 0, "", 0, 0))
+	suite.True(containsBasket(byRatio))
+	for j := 1; j < len(byRatio); j++ {
+		suite.True(byRatio[j-1].CollateralizationRatio.LTE(byRatio[j].CollateralizationRatio))
+	}
+	suite.True(containsBasket(queryCdps(types.QueryGetCdpsByCollateralization, types.NewQueryCdpsByRatioParams("xrp", above, nil, 0, 0, types.SortByID, 0, 0))))
+	suite.False(containsBasket(queryCdps(types.QueryGetCdpsByCollateralization, types.NewQueryCdpsByRatioParams("xrp", basketRatio, nil, 0, 0, "", 0, 0))))
+	suite.False(containsBasket(queryCdps(types.QueryGetCdpsByCollateralization, types.NewQueryCdpsByRatioParams("xrp", basketRatio, nil, 0, 0, types.SortByID, 0, 0))))
+
+	// basket cdps are included when listing every cdp in ratio order
+	all := queryCdps(types.QueryGetCdps, types.NewQueryCdpsParams("xrp", nil, 0, 0, types.SortByRatio, 0, 0))
+	suite.Equal(50, len(all))
+	suite.True(containsBasket(all))
+	for j := 1; j < len(all); j++ {
+		suite.True(all[j-1].CollateralizationRatio.LTE(all[j].CollateralizationRatio))
+	}
+}
+
 func (suite *QuerierTestSuite) TestQueryParams() {
 	ctx := suite.ctx.WithIsCheckTx(false)
 	bz, err := suite.querier(ctx, []string{types.QueryGetParams}, abci.RequestQuery{})
@@ -275,6 +524,194 @@ func (suite *QuerierTestSuite) TestQueryDeposits() {
 
 }
 
+func (suite *QuerierTestSuite) TestQueryDebtAssets() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	bz, err := suite.querier(ctx, []string{types.QueryGetDebtAssets}, abci.RequestQuery{})
+	suite.Nil(err)
+	suite.NotNil(bz)
+
+	var debtAssets types.DebtAssets
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &debtAssets))
+	suite.Equal(2, len(debtAssets))
+
+	totalPrincipal := sdk.ZeroInt()
+	for _, cdp := range suite.cdps {
+		totalPrincipal = totalPrincipal.Add(cdp.Principal.AmountOf("usdx"))
+	}
+	suite.Equal("usdx", debtAssets[0].Denom)
+	suite.Equal("debt", debtAssets[0].DebtDenom)
+	suite.Equal(sdk.OneDec(), debtAssets[0].StabilityFee)
+	suite.Equal(totalPrincipal, debtAssets[0].TotalPrincipal)
+	suite.Equal("susd", debtAssets[1].Denom)
+	suite.Equal(sdk.ZeroInt(), debtAssets[1].TotalPrincipal)
+}
+
+func (suite *QuerierTestSuite) TestQuerySystemSurplus() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	params := suite.keeper.GetParams(ctx)
+	params.SurplusBuffer = sdk.NewCoins(sdk.NewInt64Coin("usdx", 5000000000))
+	suite.keeper.SetParams(ctx, params)
+	sk := suite.app.GetSupplyKeeper()
+	suite.Nil(sk.MintCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewInt64Coin("usdx", 10000000000), sdk.NewInt64Coin("debt", 1000000000))))
+	suite.Nil(suite.keeper.RunSurplusAndDebtAuctions(ctx))
+
+	bz, err := suite.querier(ctx, []string{types.QueryGetSystemSurplus}, abci.RequestQuery{})
+	suite.Nil(err)
+	suite.NotNil(bz)
+
+	var systemSurplus types.SystemSurplus
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &systemSurplus))
+	suite.Equal(params.SurplusBuffer, systemSurplus.SurplusBuffer)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("usdx", 5000000000)), systemSurplus.Surplus)
+	suite.True(systemSurplus.BadDebt.Empty())
+	suite.Equal(uint64(1), systemSurplus.PendingSurplusAuctions)
+	suite.Equal(uint64(0), systemSurplus.PendingDebtAuctions)
+	suite.True(systemSurplus.AuctionDebt.Empty())
+
+	// debt escrowed by a collateral auction is reported separately from the bad debt held by the liquidator
+	suite.Nil(sk.MintCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewInt64Coin("xrp", 100000000), sdk.NewInt64Coin("debt", 500000000))))
+	_, err = suite.app.GetAuctionKeeper().StartCollateralAuction(ctx, types.LiquidatorMacc, sdk.NewInt64Coin("xrp", 100000000), sdk.NewInt64Coin("usdx", 500000000), []sdk.AccAddress{suite.addrs[0]}, []sdk.Int{sdk.OneInt()}, sdk.NewInt64Coin("debt", 500000000), sdk.ZeroDec())
+	suite.Nil(err)
+	bz, err = suite.querier(ctx, []string{types.QueryGetSystemSurplus}, abci.RequestQuery{})
+	suite.Nil(err)
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &systemSurplus))
+	suite.True(systemSurplus.BadDebt.Empty())
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("debt", 500000000)), systemSurplus.AuctionDebt)
+	suite.Equal(uint64(1), systemSurplus.PendingCollateralAuctions)
+}
+
+func (suite *QuerierTestSuite) TestQueryStats() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	sk := suite.app.GetSupplyKeeper()
+	suite.Nil(sk.MintCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewInt64Coin("usdx", 10000000), sdk.NewInt64Coin("debt", 20000000))))
+
+	bz, err := suite.querier(ctx, []string{types.QueryGetStats}, abci.RequestQuery{})
+	suite.Nil(err)
+	suite.NotNil(bz)
+
+	var stats types.Stats
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &stats))
+	suite.Equal(uint64(100), stats.CdpCount)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("usdx", 10000000)), stats.Surplus)
+	suite.Equal(sdk.NewCoins(sdk.NewInt64Coin("debt", 20000000)), stats.BadDebt)
+
+	totalPrincipal := sdk.NewCoins()
+	totalCollateral := map[string]sdk.Coins{"xrp": sdk.NewCoins(), "btc": sdk.NewCoins()}
+	expectedBuckets := types.NewRatioBuckets()
+	for _, cdp := range suite.cdps {
+		totalPrincipal = totalPrincipal.Add(cdp.Principal)
+		totalCollateral[cdp.Type] = totalCollateral[cdp.Type].Add(cdp.Collateral)
+		augmentedCDP, err := suite.keeper.LoadAugmentedCDP(ctx, cdp)
+		suite.Nil(err)
+		liquidationRatio, err := suite.keeper.CalculateLiquidationRatio(ctx, cdp.Collateral)
+		suite.Nil(err)
+		expectedBuckets.Add(augmentedCDP.CollateralizationRatio.Quo(liquidationRatio))
+	}
+	suite.Equal(totalPrincipal, stats.TotalPrincipal)
+	suite.Equal(expectedBuckets, stats.RatioBuckets)
+	utilization := sdk.NewDecFromInt(totalPrincipal.AmountOf("usdx")).QuoInt(stats.GlobalDebtLimit.AmountOf("usdx"))
+	suite.Equal(utilization, stats.GlobalUtilization.AmountOf("usdx"))
+	suite.Equal(sdk.ZeroDec(), stats.GlobalUtilization.AmountOf("susd"))
+
+	suite.Equal(2, len(stats.Collateral))
+	var bucketCount uint64
+	for _, cs := range stats.Collateral {
+		suite.Equal(uint64(50), cs.CdpCount)
+		suite.Equal(totalCollateral[cs.Denom], cs.TotalCollateral)
+		principal := suite.keeper.GetTotalPrincipal(ctx, cs.Denom, "usdx")
+		suite.Equal(sdk.NewCoins(sdk.NewCoin("usdx", principal)), cs.TotalPrincipal)
+		suite.Equal(sdk.NewDecFromInt(principal).QuoInt(cs.DebtLimit.AmountOf("usdx")), cs.Utilization.AmountOf("usdx"))
+		for _, rb := range cs.RatioBuckets {
+			bucketCount += rb.Count
+		}
+	}
+	suite.Equal(uint64(100), bucketCount)
+	suite.Equal(uint64(0), stats.UnpricedCount)
+
+	// cdps whose collateral can not be priced are counted as unpriced
+	suite.addUnpricedCollateral(ctx)
+	bz, err = suite.querier(ctx, []string{types.QueryGetStats}, abci.RequestQuery{})
+	suite.Nil(err)
+	stats = types.Stats{}
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &stats))
+	suite.Equal(uint64(100), stats.CdpCount)
+	suite.Equal(uint64(1), stats.UnpricedCount)
+	bucketCount = 0
+	for _, rb := range stats.RatioBuckets {
+		bucketCount += rb.Count
+	}
+	suite.Equal(uint64(99), bucketCount)
+	for _, cs := range stats.Collateral {
+		if cs.Denom == "xrp" {
+			suite.Equal(uint64(1), cs.UnpricedCount)
+		} else {
+			suite.Equal(uint64(0), cs.UnpricedCount)
+		}
+	}
+}
+
+func (suite *QuerierTestSuite) TestQuerySavings() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	suite.Nil(suite.keeper.DepositSavings(ctx, suite.addrs[0], sdk.NewInt64Coin("usdx", 1000)))
+
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetSavingsDeposit}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQuerySavingsParams(suite.addrs[0], "usdx")),
+	}
+	bz, err := suite.querier(ctx, []string{types.QueryGetSavingsDeposit}, query)
+	suite.Nil(err)
+	var deposit types.SavingsDeposit
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &deposit))
+	suite.Equal(sdk.NewInt64Coin("usdx", 1000), deposit.Amount)
+
+	query.Data = types.ModuleCdc.MustMarshalJSON(types.NewQuerySavingsParams(suite.addrs[1], "usdx"))
+	_, err = suite.querier(ctx, []string{types.QueryGetSavingsDeposit}, query)
+	suite.Error(err)
+
+	bz, err = suite.querier(ctx, []string{types.QueryGetSavingsPools}, abci.RequestQuery{})
+	suite.Nil(err)
+	var savingsPools types.SavingsPools
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &savingsPools))
+	suite.Equal(2, len(savingsPools))
+	suite.Equal("usdx", savingsPools[0].Denom)
+	suite.Equal(sdk.NewInt(1000), savingsPools[0].TotalSavings)
+}
+
+func (suite *QuerierTestSuite) TestQueryFeeRates() {
+	ctx := suite.ctx.WithIsCheckTx(false)
+	query := abci.RequestQuery{
+		Path: strings.Join([]string{custom, types.QuerierRoute, types.QueryGetFeeRates}, "/"),
+		Data: types.ModuleCdc.MustMarshalJSON(types.NewQueryFeeRatesParams("xrp")),
+	}
+	bz, err := suite.querier(ctx, []string{types.QueryGetFeeRates}, query)
+	suite.Nil(err)
+	suite.NotNil(bz)
+
+	var feeRates types.CollateralFeeRates
+	suite.Nil(types.ModuleCdc.UnmarshalJSON(bz, &feeRates))
+	expected, _ := suite.keeper.GetCollateralFeeRates(ctx, "xrp")
+	suite.Equal(expected, feeRates)
+	suite.Equal(2, len(feeRates.Rates))
+
+	query.Data = types.ModuleCdc.MustMarshalJSON(types.NewQueryFeeRatesParams("lol"))
+	_, err = suite.querier(ctx, []string{types.QueryGetFeeRates}, query)
+	suite.Error(err)
+}
+
+// addUnpricedCollateral adds a collateral type without a price to the params, and deposits it in the first xrp cdp
+func (suite *QuerierTestSuite) addUnpricedCollateral(ctx sdk.Context) {
+	params := suite.keeper.GetParams(ctx)
+	unpriced := params.CollateralParams[0]
+	unpriced.Denom = "zec"
+	unpriced.MarketID = "zec:usd"
+	unpriced.Prefix = 0x22
+	params.CollateralParams = append(params.CollateralParams, unpriced)
+	suite.keeper.SetParams(ctx, params)
+	cdp := suite.cdps[1]
+	cdp.Collateral = cdp.Collateral.Add(cs(c("zec", 1)))
+	suite.keeper.SetCDP(ctx, cdp)
+}
+
 func TestQuerierTestSuite(t *testing.T) {
 	suite.Run(t, new(QuerierTestSuite))
 }
@@ -0,0 +1,301 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// DepositSavings locks a debt asset in the savings module account, where it earns the savings rate of the asset
+func (k Keeper) DepositSavings(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin) sdk.Error {
+	if k.IsSettled(ctx) {
+		return types.ErrSystemSettled(k.codespace)
+	}
+	_, found := k.GetDebtParam(ctx, amount.Denom)
+	if !found {
+		return types.ErrDebtNotSupported(k.codespace, amount.Denom)
+	}
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.SavingsRateMacc, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	deposit, found := k.GetSavingsDeposit(ctx, depositor, amount.Denom)
+	if !found {
+		deposit = types.NewSavingsDeposit(depositor, sdk.NewCoin(amount.Denom, sdk.ZeroInt()), k.GetSavingsRateIndex(ctx, amount.Denom))
+	}
+	deposit = k.SyncSavingsDeposit(ctx, deposit)
+	deposit.Amount = deposit.Amount.Add(amount)
+	k.SetSavingsDeposit(ctx, deposit)
+	k.SetTotalSavings(ctx, amount.Denom, k.GetTotalSavings(ctx, amount.Denom).Add(amount.Amount))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsDeposit,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		),
+	)
+	return nil
+}
+
+// WithdrawSavings returns a debt asset, and the savings earned on it, from a savings deposit to the depositor.
+// The deposit is deleted once it has been fully withdrawn.
+func (k Keeper) WithdrawSavings(ctx sdk.Context, depositor sdk.AccAddress, amount sdk.Coin) sdk.Error {
+	deposit, found := k.GetSavingsDeposit(ctx, depositor, amount.Denom)
+	if !found {
+		return types.ErrSavingsDepositNotFound(k.codespace, depositor, amount.Denom)
+	}
+	deposit = k.SyncSavingsDeposit(ctx, deposit)
+	if amount.Amount.GT(deposit.Amount.Amount) {
+		return types.ErrInvalidSavingsWithdraw(k.codespace, amount, deposit.Amount)
+	}
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.SavingsRateMacc, depositor, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+
+	deposit.Amount = deposit.Amount.Sub(amount)
+	if deposit.Amount.IsZero() {
+		k.DeleteSavingsDeposit(ctx, depositor, amount.Denom)
+	} else {
+		k.SetSavingsDeposit(ctx, deposit)
+	}
+	k.SetTotalSavings(ctx, amount.Denom, k.GetTotalSavings(ctx, amount.Denom).Sub(amount.Amount))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSavingsWithdrawal,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		),
+	)
+	return nil
+}
+
+// DistributeSavingsRate pays the savings rate accrued over the input number of periods to the savings deposits of each debt asset.
+// Savings are paid from the liquidator surplus above the surplus buffer, after netting out bad debt, and never
+// exceed the stability fees collected by the liquidator; if there isn't enough surplus, the savings paid are reduced.
+func (k Keeper) DistributeSavingsRate(ctx sdk.Context, periods sdk.Int) sdk.Error {
+	err := k.NetSurplusAndDebt(ctx)
+	if err != nil {
+		return err
+	}
+	for _, dp := range k.GetParams(ctx).DebtParams {
+		if !dp.HasSavingsRate() {
+			continue
+		}
+		totalSavings := k.GetTotalSavings(ctx, dp.Denom)
+		if !totalSavings.IsPositive() {
+			continue
+		}
+
+		// savingsAccrued = (totalSavings * (savingsRate^periods)) - totalSavings
+		scalar := sdk.NewInt(1000000000000000000)
+		savingsRateInt := dp.SavingsRate.Mul(sdk.NewDecFromInt(scalar)).TruncateInt()
+		accumulator := sdk.NewDecFromInt(types.RelativePow(savingsRateInt, periods, scalar)).Mul(sdk.SmallestDec())
+		savingsAccrued := sdk.NewDecFromInt(totalSavings).Mul(accumulator).Sub(sdk.NewDecFromInt(totalSavings)).TruncateInt()
+
+		surplus := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins().AmountOf(dp.Denom).Sub(k.GetSurplusBuffer(ctx, dp.Denom))
+		uncommitted := k.GetSurplusCollected(ctx, dp.Denom).Sub(k.GetSavingsDistributed(ctx, dp.Denom))
+		payout := sdk.MinInt(savingsAccrued, sdk.MinInt(surplus, uncommitted))
+		if !payout.IsPositive() {
+			continue
+		}
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.LiquidatorMacc, types.SavingsRateMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, payout)))
+		if err != nil {
+			return err
+		}
+
+		index := k.GetSavingsRateIndex(ctx, dp.Denom)
+		newTotalSavings := totalSavings.Add(payout)
+		k.SetSavingsRateIndex(ctx, dp.Denom, index.MulInt(newTotalSavings).QuoInt(totalSavings))
+		k.SetTotalSavings(ctx, dp.Denom, newTotalSavings)
+		k.SetSavingsDistributed(ctx, dp.Denom, k.GetSavingsDistributed(ctx, dp.Denom).Add(payout))
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSavingsRatePayout,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, sdk.NewCoin(dp.Denom, payout).String()),
+			),
+		)
+	}
+	return nil
+}
+
+// SyncSavingsDeposit returns the savings deposit with the savings earned since its last update added to its amount
+func (k Keeper) SyncSavingsDeposit(ctx sdk.Context, deposit types.SavingsDeposit) types.SavingsDeposit {
+	index := k.GetSavingsRateIndex(ctx, deposit.Amount.Denom)
+	if deposit.Index.IsNil() || !deposit.Index.IsPositive() || deposit.Index.Equal(index) {
+		deposit.Index = index
+		return deposit
+	}
+	deposit.Amount.Amount = sdk.NewDecFromInt(deposit.Amount.Amount).Mul(index).Quo(deposit.Index).TruncateInt()
+	deposit.Index = index
+	return deposit
+}
+
+// GetSavingsPools returns a summary of the savings deposits of each debt asset
+func (k Keeper) GetSavingsPools(ctx sdk.Context) types.SavingsPools {
+	savingsPools := types.SavingsPools{}
+	for _, dp := range k.GetParams(ctx).DebtParams {
+		savingsRate := dp.SavingsRate
+		if savingsRate.IsNil() {
+			savingsRate = sdk.ZeroDec()
+		}
+		savingsPools = append(savingsPools, types.NewSavingsPool(
+			dp.Denom,
+			savingsRate,
+			k.GetSavingsRateIndex(ctx, dp.Denom),
+			k.GetTotalSavings(ctx, dp.Denom),
+			k.GetSavingsDistributed(ctx, dp.Denom),
+			k.GetSurplusCollected(ctx, dp.Denom),
+		))
+	}
+	return savingsPools
+}
+
+// GetSavingsDeposit returns the savings deposit of a debt asset held by the input depositor
+func (k Keeper) GetSavingsDeposit(ctx sdk.Context, depositor sdk.AccAddress, denom string) (deposit types.SavingsDeposit, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositKeyPrefix)
+	bz := store.Get(types.SavingsDepositKey(denom, depositor))
+	if bz == nil {
+		return deposit, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &deposit)
+	return deposit, true
+}
+
+// SetSavingsDeposit sets the savings deposit in the store
+func (k Keeper) SetSavingsDeposit(ctx sdk.Context, deposit types.SavingsDeposit) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositKeyPrefix)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(deposit)
+	store.Set(types.SavingsDepositKey(deposit.Amount.Denom, deposit.Depositor), bz)
+}
+
+// DeleteSavingsDeposit deletes a savings deposit from the store
+func (k Keeper) DeleteSavingsDeposit(ctx sdk.Context, depositor sdk.AccAddress, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositKeyPrefix)
+	store.Delete(types.SavingsDepositKey(denom, depositor))
+}
+
+// IterateSavingsDeposits iterates over all savings deposits and performs a callback function
+func (k Keeper) IterateSavingsDeposits(ctx sdk.Context, cb func(deposit types.SavingsDeposit) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsDepositKeyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var deposit types.SavingsDeposit
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &deposit)
+		if cb(deposit) {
+			break
+		}
+	}
+}
+
+// GetSavingsRateIndex returns the savings rate accumulated by deposits of the input debt asset, starting from 1
+func (k Keeper) GetSavingsRateIndex(ctx sdk.Context, denom string) (index sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsRateIndexKeyPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.OneDec()
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &index)
+	return index
+}
+
+// SetSavingsRateIndex sets the savings rate index of the input debt asset
+func (k Keeper) SetSavingsRateIndex(ctx sdk.Context, denom string, index sdk.Dec) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SavingsRateIndexKeyPrefix)
+	store.Set([]byte(denom), k.cdc.MustMarshalBinaryLengthPrefixed(index))
+}
+
+// GetTotalSavings returns the total of the savings deposits of the input debt asset, including the savings paid to them
+func (k Keeper) GetTotalSavings(ctx sdk.Context, denom string) sdk.Int {
+	return k.getDenomAmount(ctx, types.TotalSavingsKeyPrefix, denom)
+}
+
+// SetTotalSavings sets the total of the savings deposits of the input debt asset
+func (k Keeper) SetTotalSavings(ctx sdk.Context, denom string, total sdk.Int) {
+	k.setDenomAmount(ctx, types.TotalSavingsKeyPrefix, denom, total)
+}
+
+// GetSavingsDistributed returns the total savings of the input debt asset paid from the liquidator surplus
+func (k Keeper) GetSavingsDistributed(ctx sdk.Context, denom string) sdk.Int {
+	return k.getDenomAmount(ctx, types.SavingsDistributedPrefix, denom)
+}
+
+// SetSavingsDistributed sets the total savings of the input debt asset paid from the liquidator surplus
+func (k Keeper) SetSavingsDistributed(ctx sdk.Context, denom string, total sdk.Int) {
+	k.setDenomAmount(ctx, types.SavingsDistributedPrefix, denom, total)
+}
+
+// GetAllSavingsDistributed returns the total savings of each debt asset paid from the liquidator surplus
+func (k Keeper) GetAllSavingsDistributed(ctx sdk.Context) sdk.Coins {
+	return k.getDenomAmounts(ctx, types.SavingsDistributedPrefix)
+}
+
+// GetSurplusCollected returns the total stability fees of the input debt asset collected by the liquidator
+func (k Keeper) GetSurplusCollected(ctx sdk.Context, denom string) sdk.Int {
+	return k.getDenomAmount(ctx, types.SurplusCollectedPrefix, denom)
+}
+
+// SetSurplusCollected sets the total stability fees of the input debt asset collected by the liquidator
+func (k Keeper) SetSurplusCollected(ctx sdk.Context, denom string, total sdk.Int) {
+	k.setDenomAmount(ctx, types.SurplusCollectedPrefix, denom, total)
+}
+
+// GetAllSurplusCollected returns the total stability fees of each debt asset collected by the liquidator
+func (k Keeper) GetAllSurplusCollected(ctx sdk.Context) sdk.Coins {
+	return k.getDenomAmounts(ctx, types.SurplusCollectedPrefix)
+}
+
+// IncrementSurplusCollected increments the total stability fees collected by the liquidator
+func (k Keeper) IncrementSurplusCollected(ctx sdk.Context, fees sdk.Coins) {
+	for _, fee := range fees {
+		total := k.GetSurplusCollected(ctx, fee.Denom).Add(fee.Amount)
+		k.setDenomAmount(ctx, types.SurplusCollectedPrefix, fee.Denom, total)
+	}
+}
+
+func (k Keeper) getDenomAmount(ctx sdk.Context, keyPrefix []byte, denom string) (amount sdk.Int) {
+	store := prefix.NewStore(ctx.KVStore(k.key), keyPrefix)
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &amount)
+	return amount
+}
+
+func (k Keeper) iterateDenomAmounts(ctx sdk.Context, keyPrefix []byte, cb func(denom string, amount sdk.Int) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.key), keyPrefix)
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iterator.Value(), &amount)
+		if cb(string(iterator.Key()), amount) {
+			break
+		}
+	}
+}
+
+func (k Keeper) getDenomAmounts(ctx sdk.Context, keyPrefix []byte) sdk.Coins {
+	amounts := sdk.NewCoins()
+	k.iterateDenomAmounts(ctx, keyPrefix, func(denom string, amount sdk.Int) bool {
+		amounts = amounts.Add(sdk.NewCoins(sdk.NewCoin(denom, amount)))
+		return false
+	})
+	return amounts
+}
+
+func (k Keeper) setDenomAmount(ctx sdk.Context, keyPrefix []byte, denom string, amount sdk.Int) {
+	if amount.IsNegative() {
+		panic(fmt.Sprintf("negative amount %s%s", amount, denom))
+	}
+	store := prefix.NewStore(ctx.KVStore(k.key), keyPrefix)
+	store.Set([]byte(denom), k.cdc.MustMarshalBinaryLengthPrefixed(amount))
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+type SavingsTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *SavingsTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	authGS := app.NewAuthGenState(
+		addrs,
+		[]sdk.Coins{
+			cs(c("usdx", 1000000000)),
+			cs(c("usdx", 3000000000))})
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(),
+		NewCDPGenStateMulti(),
+	)
+	keeper := tApp.GetCDPKeeper()
+	params := keeper.GetParams(ctx)
+	params.DebtParams[0].SavingsRate = d("1.000001")
+	keeper.SetParams(ctx, params)
+	suite.app = tApp
+	suite.keeper = keeper
+	suite.ctx = ctx
+	suite.addrs = addrs
+}
+
+func (suite *SavingsTestSuite) TestDepositAndWithdrawSavings() {
+	err := suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], c("usdx", 400000000))
+	suite.NoError(err)
+	err = suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], c("usdx", 600000000))
+	suite.NoError(err)
+	deposit, found := suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[0], "usdx")
+	suite.True(found)
+	suite.Equal(c("usdx", 1000000000), deposit.Amount)
+	suite.Equal(i(1000000000), suite.keeper.GetTotalSavings(suite.ctx, "usdx"))
+	acc := suite.app.GetSupplyKeeper().GetModuleAccount(suite.ctx, types.SavingsRateMacc)
+	suite.Equal(cs(c("usdx", 1000000000)), acc.GetCoins())
+
+	err = suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], c("xrp", 1000))
+	suite.Equal(types.CodeDebtNotSupported, err.Result().Code)
+	err = suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[0], c("usdx", 1000000001))
+	suite.Equal(types.CodeInvalidSavingsWithdraw, err.Result().Code)
+	err = suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[1], c("usdx", 1000))
+	suite.Equal(types.CodeSavingsDepositNotFound, err.Result().Code)
+
+	err = suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[0], c("usdx", 1000000000))
+	suite.NoError(err)
+	_, found = suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[0], "usdx")
+	suite.False(found)
+	suite.Equal(i(0), suite.keeper.GetTotalSavings(suite.ctx, "usdx"))
+	userAcc := suite.app.GetAccountKeeper().GetAccount(suite.ctx, suite.addrs[0])
+	suite.Equal(cs(c("usdx", 1000000000)), userAcc.GetCoins())
+}
+
+func (suite *SavingsTestSuite) TestDistributeSavingsRate() {
+	suite.NoError(suite.keeper.DepositSavings(suite.ctx, suite.addrs[0], c("usdx", 1000000000)))
+	suite.NoError(suite.keeper.DepositSavings(suite.ctx, suite.addrs[1], c("usdx", 3000000000)))
+	suite.fundSurplus(1000000)
+
+	suite.NoError(suite.keeper.DistributeSavingsRate(suite.ctx, i(1)))
+	suite.Equal(i(4000004000), suite.keeper.GetTotalSavings(suite.ctx, "usdx"))
+	suite.Equal(i(4000), suite.keeper.GetSavingsDistributed(suite.ctx, "usdx"))
+	acc := suite.app.GetSupplyKeeper().GetModuleAccount(suite.ctx, types.LiquidatorMacc)
+	suite.Equal(cs(c("usdx", 996000)), acc.GetCoins())
+
+	deposit, _ := suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[0], "usdx")
+	suite.Equal(c("usdx", 1000001000), suite.keeper.SyncSavingsDeposit(suite.ctx, deposit).Amount)
+	deposit, _ = suite.keeper.GetSavingsDeposit(suite.ctx, suite.addrs[1], "usdx")
+	suite.Equal(c("usdx", 3000003000), suite.keeper.SyncSavingsDeposit(suite.ctx, deposit).Amount)
+
+	suite.NoError(suite.keeper.WithdrawSavings(suite.ctx, suite.addrs[0], c("usdx", 1000001000)))
+	userAcc := suite.app.GetAccountKeeper().GetAccount(suite.ctx, suite.addrs[0])
+	suite.Equal(cs(c("usdx", 1000001000)), userAcc.GetCoins())
+	suite.checkInvariants()
+}
+
+func (suite *SavingsTestSuite) TestDistributeSavingsRateLimitedBySurplus() {
+	suite.NoError(suite.keeper.DepositSavings(suite.ctx, suite.addrs[1], c("usdx", 3000000000)))
+
+	// no surplus has been collected
+	sk := suite.app.GetSupplyKeeper()
+	suite.NoError(sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 1000000))))
+	suite.NoError(suite.keeper.DistributeSavingsRate(suite.ctx, i(1)))
+	suite.Equal(i(0), suite.keeper.GetSavingsDistributed(suite.ctx, "usdx"))
+
+	// payouts never exceed the surplus collected
+	suite.keeper.IncrementSurplusCollected(suite.ctx, cs(c("usdx", 500)))
+	suite.NoError(suite.keeper.DistributeSavingsRate(suite.ctx, i(1)))
+	suite.Equal(i(500), suite.keeper.GetSavingsDistributed(suite.ctx, "usdx"))
+
+	// payouts never use the surplus buffer
+	suite.keeper.IncrementSurplusCollected(suite.ctx, cs(c("usdx", 1000000)))
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SurplusBuffer = cs(c("usdx", 999300))
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.NoError(suite.keeper.DistributeSavingsRate(suite.ctx, i(1)))
+	suite.Equal(i(700), suite.keeper.GetSavingsDistributed(suite.ctx, "usdx"))
+	suite.Equal(i(3000000700), suite.keeper.GetTotalSavings(suite.ctx, "usdx"))
+
+	// bad debt is covered before savings are paid
+	suite.NoError(sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 999300))))
+	suite.NoError(suite.keeper.DistributeSavingsRate(suite.ctx, i(1)))
+	suite.Equal(i(700), suite.keeper.GetSavingsDistributed(suite.ctx, "usdx"))
+	suite.checkInvariants()
+}
+
+func (suite *SavingsTestSuite) fundSurplus(amount int64) {
+	err := suite.app.GetSupplyKeeper().MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", amount)))
+	suite.NoError(err)
+	suite.keeper.IncrementSurplusCollected(suite.ctx, cs(c("usdx", amount)))
+}
+
+func (suite *SavingsTestSuite) checkInvariants() {
+	_, broken := keeper.SavingsPayoutsInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+	_, broken = keeper.SavingsDepositsInvariant(suite.keeper)(suite.ctx)
+	suite.False(broken)
+}
+
+func TestSavingsTestSuite(t *testing.T) {
+	suite.Run(t, new(SavingsTestSuite))
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kava-labs/kava/x/cdp/types"
 )
@@ -10,37 +11,47 @@ import (
 // SeizeCollateral liquidates the collateral in the input cdp.
 // the following operations are performed:
 // 1. updates the fees for the input cdp,
-// 2. sends collateral for all deposits from the cdp module to the liquidator module account
-// 3. Applies the liquidation penalty and mints the corresponding amount of debt coins in the cdp module
-// 3. moves debt coins from the cdp module to the liquidator module account,
-// 4. decrements the total amount of principal outstanding for that collateral type
+// 2. moves debt coins for each debt asset from the cdp module to the liquidator module account,
+// 3. sends collateral for all deposits from the cdp module to the liquidator module account,
+// 4. starts collateral auctions for each collateral denom and debt asset held by the cdp,
+// 5. decrements the total amount of principal outstanding for that collateral type
 // (this is the equivalent of saying that fees are no longer accumulated by a cdp once it gets liquidated)
 func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) sdk.Error {
+	// Price the auctions of every collateral denom and debt asset before moving any coins, so a missing price leaves the cdp untouched
+	for _, cc := range cdp.Collateral {
+		for _, pc := range cdp.Principal {
+			if _, err := k.collateralAuctionPrice(ctx, cc, pc.Denom); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Calculate the previous collateral ratio
 	oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
 	// Update fees
 	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
-	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Collateral[0].Denom)
+	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Type)
 	cdp.AccumulatedFees = cdp.AccumulatedFees.Add(fees)
 	cdp.FeesUpdated = ctx.BlockTime()
 
-	// Move debt coins from cdp to liquidator account
+	// Move debt coins for each debt asset from cdp to liquidator account
 	deposits := k.GetDeposits(ctx, cdp.ID)
-	debt := sdk.ZeroInt()
-	for _, pc := range cdp.Principal {
-		debt = debt.Add(pc.Amount)
-	}
-	for _, dc := range cdp.AccumulatedFees {
-		debt = debt.Add(dc.Amount)
-	}
-	modAccountDebt := k.getModAccountDebt(ctx, types.ModuleName)
-	if modAccountDebt.LT(debt) {
-		debt = modAccountDebt
-	}
-	debtCoin := sdk.NewCoin(k.GetDebtDenom(ctx), debt)
-	err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(debtCoin))
-	if err != nil {
-		return err
+	debt := sdk.NewCoins()
+	for _, pc := range cdp.Principal.Add(cdp.AccumulatedFees) {
+		debtDenom := k.GetInternalDebtDenom(ctx, pc.Denom)
+		amount := pc.Amount
+		modAccountDebt := k.getModAccountDebt(ctx, types.ModuleName, debtDenom)
+		if modAccountDebt.LT(amount) {
+			amount = modAccountDebt
+		}
+		if amount.IsPositive() {
+			err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(debtDenom, amount)))
+			if err != nil {
+				return err
+			}
+		}
+		// zero amounts are kept so that the collateral is still auctioned for every debt asset
+		debt = append(debt, sdk.NewCoin(pc.Denom, amount))
 	}
 
 	// liquidate deposits and send collateral from cdp to liquidator
@@ -59,7 +70,7 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) sdk.Error {
 		}
 		k.DeleteDeposit(ctx, dep.CdpID, dep.Depositor)
 	}
-	err = k.AuctionCollateral(ctx, deposits, debt, cdp.Principal[0].Denom)
+	err := k.AuctionCollateralForDebt(ctx, deposits, cdp.Collateral, debt)
 	if err != nil {
 		return err
 	}
@@ -72,11 +83,15 @@ func (k Keeper) SeizeCollateral(ctx sdk.Context, cdp types.CDP) sdk.Error {
 			feeCoins := sdk.NewCoins(sdk.NewCoin(dc.Denom, feeAmount))
 			coinsToDecrement = coinsToDecrement.Add(feeCoins)
 		}
-		k.DecrementTotalPrincipal(ctx, cdp.Collateral[0].Denom, coinsToDecrement)
+		k.DecrementTotalPrincipal(ctx, cdp.Type, coinsToDecrement)
 	}
 	k.RemoveCdpOwnerIndex(ctx, cdp)
-	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Collateral[0].Denom, cdp.ID, oldCollateralToDebtRatio)
+	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
+	k.RemoveBasketCdpIndex(ctx, cdp.Type, cdp.ID)
 	k.DeleteCDP(ctx, cdp)
+
+	k.recordFeeAccrual(ctx, cdp.ID, fees)
+	k.RecordCdpHistory(ctx, cdp.ID, types.EventTypeCdpLiquidation, nil, cdp.Collateral)
 	return nil
 }
 
@@ -89,28 +104,261 @@ func (k Keeper) HandleNewDebt(ctx sdk.Context, collateralDenom string, principal
 	previousDebt := k.GetTotalPrincipal(ctx, collateralDenom, principalDenom)
 	feeCoins := sdk.NewCoins(sdk.NewCoin(principalDenom, previousDebt))
 	newFees := k.CalculateFees(ctx, feeCoins, periods, collateralDenom)
-	k.MintDebtCoins(ctx, types.ModuleName, k.GetDebtDenom(ctx), newFees)
+	k.MintDebtCoinsForPrincipal(ctx, types.ModuleName, newFees)
 	k.supplyKeeper.MintCoins(ctx, types.LiquidatorMacc, newFees)
+	k.IncrementSurplusCollected(ctx, newFees)
 	k.SetTotalPrincipal(ctx, collateralDenom, principalDenom, feeCoins.Add(newFees).AmountOf(principalDenom))
 }
 
-// LiquidateCdps seizes collateral from all CDPs below the input liquidation ratio
+// LiquidateCdps seizes collateral from CDPs below the input liquidation ratio, starting with the lowest collateral ratio.
+// Cdps holding a basket of collateral denoms are checked afterwards against the liquidation ratio of their basket, at most
+// MaxBasketChecksPerBlock of them each block.
+// At most MaxLiquidationsPerBlock cdps are seized; the remainder stay in the store and are liquidated in subsequent blocks.
+// A cdp that fails to be seized is skipped and left untouched, and reported with a liquidation unpriced event.
+// The backlog event reports the cdps left over, counted up to MaxLiquidationsPerBlock of them, as a lower bound of the backlog.
 func (k Keeper) LiquidateCdps(ctx sdk.Context, marketID string, denom string, liquidationRatio sdk.Dec) sdk.Error {
-	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	// up to twice the cap is collected to measure the backlog, without iterating over all of it
+	maxLiquidations := k.getMaxLiquidationsPerBlock(ctx, denom)
+	limit := 0
+	if maxLiquidations > 0 {
+		limit = 2 * int(maxLiquidations)
+	}
+	cdpsToLiquidate, err := k.getCdpsToLiquidate(ctx, marketID, denom, liquidationRatio, limit, k.getMaxBasketChecksPerBlock(ctx, denom))
 	if err != nil {
 		return err
 	}
-	normalizedRatio := sdk.OneDec().Quo(price.Price.Quo(liquidationRatio))
-	cdpsToLiquidate := k.GetAllCdpsByDenomAndRatio(ctx, denom, normalizedRatio)
+	backlog := 0
+	if maxLiquidations > 0 && uint64(len(cdpsToLiquidate)) > maxLiquidations {
+		backlog = len(cdpsToLiquidate) - int(maxLiquidations)
+		cdpsToLiquidate = cdpsToLiquidate[:maxLiquidations]
+	}
+	// each cdp is seized in its own cache, so a cdp that fails to liquidate is left untouched without holding up the others
+	liquidated := 0
 	for _, c := range cdpsToLiquidate {
-		err := k.SeizeCollateral(ctx, c)
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := k.SeizeCollateral(cacheCtx, c)
+		if err != nil {
+			k.emitLiquidationUnpriced(ctx, c, err)
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		liquidated++
+	}
+	if backlog > 0 {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeLiquidationBacklog,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyCollateralDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyLiquidated, fmt.Sprintf("%d", liquidated)),
+				sdk.NewAttribute(types.AttributeKeyBacklog, fmt.Sprintf("%d", backlog)),
+			),
+		)
+	}
+	return nil
+}
+
+// getCdpsToLiquidate returns the cdps of the input collateral type that are below the liquidation ratio, in the order they are liquidated.
+// Iteration stops once limit cdps have been collected, a limit of 0 returns all of them. At most basketChecks basket cdps are priced,
+// see getBasketCdpsToLiquidate.
+func (k Keeper) getCdpsToLiquidate(ctx sdk.Context, marketID string, denom string, liquidationRatio sdk.Dec, limit int, basketChecks uint64) (types.CDPs, sdk.Error) {
+	price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, marketID)
+	if err != nil {
+		return nil, err
+	}
+	// the index values debt at par, so the scan is widened to the highest debt asset price and each cdp found is checked at current debt prices
+	normalizedRatio := sdk.OneDec().Quo(price.Price.Quo(liquidationRatio)).Mul(k.getMaxDebtPrice(ctx))
+	var cdps types.CDPs
+	full := func() bool { return limit > 0 && len(cdps) >= limit }
+	k.IterateCdpsByCollateralRatio(ctx, denom, normalizedRatio, func(cdp types.CDP) bool {
+		liquidatable, err := k.isCdpLiquidatable(ctx, cdp)
+		if err != nil {
+			k.emitLiquidationUnpriced(ctx, cdp, err)
+		} else if liquidatable {
+			cdps = append(cdps, cdp)
+		}
+		return full()
+	})
+	if full() {
+		return cdps, nil
+	}
+	basketLimit := 0
+	if limit > 0 {
+		basketLimit = limit - len(cdps)
+	}
+	return append(cdps, k.getBasketCdpsToLiquidate(ctx, denom, basketChecks, basketLimit)...), nil
+}
+
+// getBasketCdpsToLiquidate returns the cdps of the input collateral type holding a basket of collateral denoms that are below the
+// liquidation ratio of their basket, including fees accrued since they were last updated. Basket cdps can not be ordered by a
+// single price, so at most checks of them are priced, starting after the one last checked and wrapping around, so that every
+// basket cdp is checked within a bounded number of blocks. Checking stops once limit cdps have been found.
+// A checks or limit value of 0 is no limit, and checking every basket cdp does not move the rotation.
+// Basket cdps holding a collateral denom that can not be priced are skipped with an event, so that they do not hold up the
+// liquidation of other cdps of the collateral type.
+func (k Keeper) getBasketCdpsToLiquidate(ctx sdk.Context, denom string, checks uint64, limit int) types.CDPs {
+	var cdps types.CDPs
+	checked := uint64(0)
+	check := func(cdp types.CDP) bool {
+		if (checks > 0 && checked >= checks) || (limit > 0 && len(cdps) >= limit) {
+			return true
+		}
+		checked++
+		liquidatable, err := k.isCdpLiquidatable(ctx, cdp)
+		if err != nil {
+			k.emitLiquidationUnpriced(ctx, cdp, err)
+		} else if liquidatable {
+			cdps = append(cdps, cdp)
+		}
+		if checks > 0 {
+			k.setBasketCdpCursor(ctx, denom, cdp.ID)
+		}
+		return false
+	}
+	if checks == 0 {
+		k.IterateBasketCdps(ctx, denom, check)
+		return cdps
+	}
+	cursor := k.getBasketCdpCursor(ctx, denom)
+	k.IterateBasketCdpsFrom(ctx, denom, cursor+1, check)
+	if cursor > 0 {
+		k.IterateBasketCdps(ctx, denom, func(cdp types.CDP) bool {
+			return cdp.ID > cursor || check(cdp)
+		})
+	}
+	return cdps
+}
+
+// isCdpLiquidatable returns true if a cdp is below the liquidation ratio of its collateral, including fees accrued since it was last updated
+func (k Keeper) isCdpLiquidatable(ctx sdk.Context, cdp types.CDP) (bool, sdk.Error) {
+	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
+	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Type)
+	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Principal, cdp.AccumulatedFees.Add(fees))
+	if err != nil {
+		return false, err
+	}
+	basketLiquidationRatio, err := k.CalculateLiquidationRatio(ctx, cdp.Collateral)
+	if err != nil {
+		return false, err
+	}
+	return collateralizationRatio.LT(basketLiquidationRatio), nil
+}
+
+// emitLiquidationUnpriced emits an event for a cdp skipped by liquidation because its collateral or debt could not be priced,
+// or because seizing it failed
+func (k Keeper) emitLiquidationUnpriced(ctx sdk.Context, cdp types.CDP, err sdk.Error) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLiquidationUnpriced,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyError, err.Result().Log),
+		),
+	)
+}
+
+// getMaxDebtPrice returns the highest current price of the debt assets, and at least 1.
+// Debt assets that can not be priced are left out, cdps drawing them are skipped when they are checked.
+func (k Keeper) getMaxDebtPrice(ctx sdk.Context) sdk.Dec {
+	maxPrice := sdk.OneDec()
+	for _, dp := range k.GetParams(ctx).DebtParams {
+		price, err := k.getDebtPrice(ctx, dp)
+		if err == nil && price.GT(maxPrice) {
+			maxPrice = price
+		}
+	}
+	return maxPrice
+}
+
+// getBasketCdpCursor returns the id of the basket cdp of the input collateral type last checked for liquidation
+func (k Keeper) getBasketCdpCursor(ctx sdk.Context, denom string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BasketCdpCursorPrefix)
+	db, _ := k.GetDenomPrefix(ctx, denom)
+	bz := store.Get([]byte{db})
+	if bz == nil {
+		return 0
+	}
+	return types.GetCdpIDFromBytes(bz)
+}
+
+// setBasketCdpCursor sets the id of the basket cdp of the input collateral type last checked for liquidation
+func (k Keeper) setBasketCdpCursor(ctx sdk.Context, denom string, id uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.BasketCdpCursorPrefix)
+	db, _ := k.GetDenomPrefix(ctx, denom)
+	store.Set([]byte{db}, types.GetCdpIDBytes(id))
+}
+
+// AttemptKeeperLiquidation liquidates the cdp owned by borrower for the input collateral denom if it is below the liquidation ratio.
+// The keeper that submitted the liquidation is paid a share of the liquidation penalty, which is taken from the surplus held by the liquidator module account.
+func (k Keeper) AttemptKeeperLiquidation(ctx sdk.Context, keeper sdk.AccAddress, borrower sdk.AccAddress, denom string) sdk.Error {
+	if k.IsSettled(ctx) {
+		return types.ErrSystemSettled(k.codespace)
+	}
+	cdp, found := k.GetCdpByOwnerAndDenom(ctx, borrower, denom)
+	if !found {
+		return types.ErrCdpNotFound(k.codespace, borrower, denom)
+	}
+	periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
+	fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, denom)
+	totalDebt := cdp.Principal.Add(cdp.AccumulatedFees).Add(fees)
+	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Principal, cdp.AccumulatedFees.Add(fees))
+	if err != nil {
+		return err
+	}
+	liquidationRatio, err := k.CalculateLiquidationRatio(ctx, cdp.Collateral)
+	if err != nil {
+		return err
+	}
+	if collateralizationRatio.GTE(liquidationRatio) {
+		return types.ErrCdpNotLiquidatable(k.codespace, cdp.ID, collateralizationRatio, liquidationRatio)
+	}
+	err = k.SeizeCollateral(ctx, cdp)
+	if err != nil {
+		return err
+	}
+	reward := k.CalculateKeeperReward(ctx, denom, totalDebt)
+	if !reward.IsZero() {
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.LiquidatorMacc, keeper, reward)
 		if err != nil {
 			return err
 		}
 	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeKeeperLiquidation,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyKeeper, keeper.String()),
+			sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+		),
+	)
 	return nil
 }
 
+// CalculateKeeperReward returns the reward paid for liquidating a cdp with the input collateral denom and debt.
+// The reward is the keeper reward percentage of the liquidation penalty, capped by the net surplus of the liquidator module account:
+// its balance of the debt asset less the internal debt coins it holds and the surplus buffer. Rewards are only paid out of
+// surplus, never out of the buffer or while the liquidator holds bad debt.
+func (k Keeper) CalculateKeeperReward(ctx sdk.Context, collateralDenom string, debt sdk.Coins) sdk.Coins {
+	rewardPercentage := k.getKeeperRewardPercentage(ctx, collateralDenom)
+	liquidatorCoins := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins()
+	reward := sdk.NewCoins()
+	for _, dc := range debt {
+		penalty := k.ApplyLiquidationPenalty(ctx, collateralDenom, dc.Amount)
+		rewardAmount := sdk.NewDecFromInt(penalty).Mul(rewardPercentage).TruncateInt()
+		badDebt := liquidatorCoins.AmountOf(k.GetInternalDebtDenom(ctx, dc.Denom))
+		available := liquidatorCoins.AmountOf(dc.Denom).Sub(badDebt).Sub(k.GetSurplusBuffer(ctx, dc.Denom))
+		if rewardAmount.GT(available) {
+			rewardAmount = available
+		}
+		if rewardAmount.IsPositive() {
+			reward = reward.Add(sdk.NewCoins(sdk.NewCoin(dc.Denom, rewardAmount)))
+		}
+	}
+	return reward
+}
+
 // ApplyLiquidationPenalty multiplies the input debt amount by the liquidation penalty and mints the debt coins in the cdp module account
 func (k Keeper) ApplyLiquidationPenalty(ctx sdk.Context, denom string, debt sdk.Int) sdk.Int {
 	penalty := k.getLiquidationPenalty(ctx, denom)
@@ -118,7 +366,7 @@ func (k Keeper) ApplyLiquidationPenalty(ctx sdk.Context, denom string, debt sdk.
 	return penaltyAmount
 }
 
-func (k Keeper) getModAccountDebt(ctx sdk.Context, accountName string) sdk.Int {
+func (k Keeper) getModAccountDebt(ctx sdk.Context, accountName string, debtDenom string) sdk.Int {
 	macc := k.supplyKeeper.GetModuleAccount(ctx, accountName)
-	return macc.GetCoins().AmountOf(k.GetDebtDenom(ctx))
+	return macc.GetCoins().AmountOf(debtDenom)
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 	"time"
@@ -15,6 +16,7 @@ import (
 	"github.com/kava-labs/kava/x/auction"
 	"github.com/kava-labs/kava/x/cdp/keeper"
 	"github.com/kava-labs/kava/x/cdp/types"
+	"github.com/kava-labs/kava/x/pricefeed"
 )
 
 type SeizeTestSuite struct {
@@ -144,7 +146,7 @@ func (suite *SeizeTestSuite) TestSeizeCollateral() {
 	ak := suite.app.GetAccountKeeper()
 	acc := ak.GetAccount(suite.ctx, suite.addrs[1])
 	suite.Equal(p.Int64(), acc.GetCoins().AmountOf("usdx").Int64())
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], cs(c("xrp", 10)))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], "xrp", cs(c("xrp", 10)))
 	suite.Equal(types.CodeCdpNotFound, err.Result().Code)
 }
 
@@ -152,7 +154,7 @@ func (suite *SeizeTestSuite) TestSeizeCollateralMultiDeposit() {
 	suite.createCdps()
 	sk := suite.app.GetSupplyKeeper()
 	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
-	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], cs(c("xrp", 6999000000)))
+	err := suite.keeper.DepositCollateral(suite.ctx, suite.addrs[1], suite.addrs[0], "xrp", cs(c("xrp", 6999000000)))
 	suite.NoError(err)
 	cdp, _ = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
 	deposits := suite.keeper.GetDeposits(suite.ctx, cdp.ID)
@@ -169,10 +171,213 @@ func (suite *SeizeTestSuite) TestSeizeCollateralMultiDeposit() {
 	ak := suite.app.GetAccountKeeper()
 	acc := ak.GetAccount(suite.ctx, suite.addrs[1])
 	suite.Equal(p.Int64(), acc.GetCoins().AmountOf("usdx").Int64())
-	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], cs(c("xrp", 10)))
+	err = suite.keeper.WithdrawCollateral(suite.ctx, suite.addrs[1], suite.addrs[1], "xrp", cs(c("xrp", 10)))
 	suite.Equal(types.CodeCdpNotFound, err.Result().Code)
 }
 
+func (suite *SeizeTestSuite) TestSeizeCollateralDutchAuction() {
+	suite.createCdps()
+	params := suite.keeper.GetParams(suite.ctx)
+	for j, cp := range params.CollateralParams {
+		if cp.Denom == "xrp" {
+			params.CollateralParams[j].AuctionType = types.AuctionTypeDutch
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	err := suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.NoError(err)
+
+	// the price starts at the xrp price of 0.25 usdx, times the default price buffer
+	lot := sdk.ZeroInt()
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		da, ok := a.(auction.DutchAuction)
+		suite.Require().True(ok)
+		suite.Equal(d("0.3"), da.StartPrice)
+		suite.Equal(types.LiquidatorMacc, da.Initiator)
+		lot = lot.Add(da.Lot.Amount)
+		return false
+	})
+	suite.Equal(cdp.Collateral[0].Amount, lot)
+}
+
+func (suite *SeizeTestSuite) TestSeizeCollateralUnpriced() {
+	suite.createCdps()
+	params := suite.keeper.GetParams(suite.ctx)
+	for j, cp := range params.CollateralParams {
+		if cp.Denom == "xrp" {
+			params.CollateralParams[j].AuctionType = types.AuctionTypeDutch
+		}
+	}
+	// the dutch auction price is converted into usdx at a market with no price
+	params.DebtParams[0].MarketID = "usdx:usd"
+	suite.keeper.SetParams(suite.ctx, params)
+	sk := suite.app.GetSupplyKeeper()
+	cdpCoins := sk.GetModuleAccount(suite.ctx, types.ModuleName).GetCoins()
+	liquidatorCoins := sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc).GetCoins()
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	deposits := suite.keeper.GetDeposits(suite.ctx, cdp.ID)
+
+	// seizing fails before any coins are moved, leaving the cdp and its deposits in place
+	suite.Error(suite.keeper.SeizeCollateral(suite.ctx, cdp))
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp", cdp.ID)
+	suite.True(found)
+	suite.Equal(deposits, suite.keeper.GetDeposits(suite.ctx, cdp.ID))
+	suite.Equal(cdpCoins, sk.GetModuleAccount(suite.ctx, types.ModuleName).GetCoins())
+	suite.Equal(liquidatorCoins, sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc).GetCoins())
+}
+
+func (suite *SeizeTestSuite) TestSeizeCollateralReserve() {
+	suite.createCdps()
+	params := suite.keeper.GetParams(suite.ctx)
+	for j, cp := range params.CollateralParams {
+		if cp.Denom == "xrp" {
+			params.CollateralParams[j].ReserveDiscount = d("0.2")
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	err := suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.NoError(err)
+
+	// the reserve is the xrp price of 0.25 usdx, less the discount
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		ca, ok := a.(auction.CollateralAuction)
+		suite.Require().True(ok)
+		suite.Equal(d("0.2"), ca.ReservePrice)
+		return false
+	})
+}
+
+func (suite *SeizeTestSuite) TestSeizeCollateralReserveRelist() {
+	suite.createCdps()
+	params := suite.keeper.GetParams(suite.ctx)
+	for j, cp := range params.CollateralParams {
+		if cp.Denom == "xrp" {
+			params.CollateralParams[j].ReserveDiscount = d("0.2")
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	err := suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.NoError(err)
+
+	// auctions ending without a bid are relisted at the current xrp price of 0.5 usdx, less the discount
+	suite.setPrice(d("0.5"), "xrp:usd")
+	ak := suite.app.GetAuctionKeeper()
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(auction.DefaultMaxAuctionDuration))
+	ak.CloseExpiredAuctions(ctx)
+	relisted := 0
+	ak.IterateAuctions(ctx, func(a auction.Auction) bool {
+		ca, ok := a.(auction.CollateralAuction)
+		suite.Require().True(ok)
+		suite.Equal(d("0.4"), ca.ReservePrice)
+		suite.Equal(uint64(1), ca.Relists)
+		relisted++
+		return false
+	})
+	suite.True(relisted > 0)
+
+	// once out of relists the unsold collateral is returned to the liquidator instead of the depositors
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(auction.DefaultMaxAuctionDuration))
+	ak.CloseExpiredAuctions(ctx)
+	ak.IterateAuctions(ctx, func(a auction.Auction) bool {
+		suite.Fail("auction was not closed")
+		return true
+	})
+	liquidator := suite.app.GetSupplyKeeper().GetModuleAccount(ctx, types.LiquidatorMacc)
+	suite.Equal(cdp.Collateral[0].Amount, liquidator.GetCoins().AmountOf("xrp"))
+	acc := suite.app.GetAccountKeeper().GetAccount(ctx, suite.addrs[1])
+	suite.Equal(i(0), acc.GetCoins().AmountOf("xrp"))
+}
+
+func (suite *SeizeTestSuite) TestSeizeCollateralAuctionAccounting() {
+	suite.createCdps()
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	err := suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.NoError(err)
+
+	// the debt of each auction started by the liquidator is recorded
+	ak := suite.app.GetAuctionKeeper()
+	var auctions []auction.CollateralAuction
+	ak.IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		ca := a.(auction.CollateralAuction)
+		ad, found := suite.keeper.GetAuctionDebt(suite.ctx, ca.ID)
+		suite.True(found)
+		suite.Equal(ca.CorrespondingDebt, ad.Debt)
+		auctions = append(auctions, ca)
+		return false
+	})
+	suite.Require().True(len(auctions) > 1)
+
+	// bids recover debt as they are placed
+	suite.NoError(ak.PlaceBid(suite.ctx, auctions[0].ID, suite.addrs[1], c("usdx", 1000000)))
+	suite.Equal(i(1000000), suite.keeper.GetRecoveredDebt(suite.ctx, "debt"))
+	ad, _ := suite.keeper.GetAuctionDebt(suite.ctx, auctions[0].ID)
+	suite.Equal(auctions[0].CorrespondingDebt.Sub(c("debt", 1000000)), ad.Debt)
+
+	// debt left when an auction closes is a realized loss
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(auction.DefaultBidDuration))
+	ak.CloseExpiredAuctions(ctx)
+	loss := auctions[0].CorrespondingDebt.Amount.Sub(i(1000000))
+	suite.Equal(loss, suite.keeper.GetRealizedLoss(ctx, "debt"))
+	_, found := suite.keeper.GetAuctionDebt(ctx, auctions[0].ID)
+	suite.False(found)
+	_, found = suite.keeper.GetAuctionDebt(ctx, auctions[1].ID)
+	suite.True(found)
+	systemSurplus := suite.keeper.GetSystemSurplus(ctx)
+	suite.Equal(cs(c("debt", 1000000)), systemSurplus.RecoveredDebt)
+	suite.Equal(cs(sdk.NewCoin("debt", loss)), systemSurplus.RealizedLoss)
+}
+
+func (suite *SeizeTestSuite) TestCancelCollateralAuctionAccounting() {
+	suite.createCdps()
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	suite.NoError(suite.keeper.SeizeCollateral(suite.ctx, cdp))
+	ak := suite.app.GetAuctionKeeper()
+	var ca auction.CollateralAuction
+	ak.IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		ca = a.(auction.CollateralAuction)
+		return true
+	})
+	suite.NoError(ak.PlaceBid(suite.ctx, ca.ID, suite.addrs[1], c("usdx", 1000000)))
+
+	// debt returned to the liquidator by a cancelled auction is a realized loss
+	ak.SetStuckAuction(suite.ctx, auction.NewStuckAuction(ca.ID, "", suite.ctx.BlockTime(), 1))
+	suite.NoError(ak.CancelStuckAuction(suite.ctx, ca.ID))
+	_, found := suite.keeper.GetAuctionDebt(suite.ctx, ca.ID)
+	suite.False(found)
+	suite.Equal(ca.CorrespondingDebt.Amount.Sub(i(1000000)), suite.keeper.GetRealizedLoss(suite.ctx, "debt"))
+}
+
+func (suite *SeizeTestSuite) TestSeizeCollateralReserveDebtPrice() {
+	suite.createCdps()
+	pk := suite.app.GetPriceFeedKeeper()
+	pfParams := pk.GetParams(suite.ctx)
+	pfParams.Markets = append(pfParams.Markets, pricefeed.Market{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true})
+	pk.SetParams(suite.ctx, pfParams)
+	suite.setPrice(d("0.5"), "usdx:usd")
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParams[0].MarketID = "usdx:usd"
+	for j, cp := range params.CollateralParams {
+		if cp.Denom == "xrp" {
+			params.CollateralParams[j].ReserveDiscount = d("0.2")
+		}
+	}
+	suite.keeper.SetParams(suite.ctx, params)
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	err := suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.NoError(err)
+
+	// the xrp price of 0.25 usd is 0.5 usdx at the usdx price of 0.5 usd, less the discount
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		ca, ok := a.(auction.CollateralAuction)
+		suite.Require().True(ok)
+		suite.Equal(d("0.4"), ca.ReservePrice)
+		return false
+	})
+}
+
 func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.createCdps()
 	sk := suite.app.GetSupplyKeeper()
@@ -188,6 +393,344 @@ func (suite *SeizeTestSuite) TestLiquidateCdps() {
 	suite.Equal(len(suite.liquidations.xrp), xrpLiquidations)
 }
 
+func (suite *SeizeTestSuite) TestLiquidateCdpsDebtPrice() {
+	suite.createCdps()
+	sk := suite.app.GetSupplyKeeper()
+	originalXrpCollateral := sk.GetModuleAccount(suite.ctx, types.ModuleName).GetCoins().AmountOf("xrp")
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	suite.Equal(originalXrpCollateral, sk.GetModuleAccount(suite.ctx, types.ModuleName).GetCoins().AmountOf("xrp"))
+
+	// at a usdx price of 1.25, cdps with more than 1000 usdx of debt fall below the liquidation ratio of their $2500 of xrp
+	pk := suite.app.GetPriceFeedKeeper()
+	pfParams := pk.GetParams(suite.ctx)
+	pfParams.Markets = append(pfParams.Markets, pricefeed.Market{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true})
+	pk.SetParams(suite.ctx, pfParams)
+	suite.setPrice(d("1.25"), "usdx:usd")
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParams[0].MarketID = "usdx:usd"
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	seizedXrpCollateral := originalXrpCollateral.Sub(sk.GetModuleAccount(suite.ctx, types.ModuleName).GetCoins().AmountOf("xrp"))
+	suite.Equal(len(suite.liquidations.xrp), int(seizedXrpCollateral.Quo(i(10000000000)).Int64()))
+}
+
+func (suite *SeizeTestSuite) TestSimulateLiquidations() {
+	suite.createCdps()
+	sk := suite.app.GetSupplyKeeper()
+	originalCoins := sk.GetModuleAccount(suite.ctx, types.ModuleName).GetCoins()
+
+	simulation, err := suite.keeper.SimulateLiquidations(suite.ctx, "xrp", d("0.2"), sdk.ZeroDec())
+	suite.NoError(err)
+	suite.Equal(d("2.0"), simulation.LiquidationRatio)
+	var ids []uint64
+	debt := sdk.NewCoins()
+	for _, cdp := range simulation.CDPs {
+		ids = append(ids, cdp.ID)
+		debt = debt.Add(cdp.Principal.Add(cdp.AccumulatedFees))
+	}
+	suite.ElementsMatch(suite.liquidations.xrp, ids)
+	suite.Equal(cs(c("xrp", int64(len(suite.liquidations.xrp))*10000000000)), simulation.CollateralSeized)
+	suite.Equal(debt, simulation.DebtSeized)
+	suite.NotEmpty(simulation.Auctions)
+
+	// no state is changed
+	suite.Equal(originalCoins, sk.GetModuleAccount(suite.ctx, types.ModuleName).GetCoins())
+	for _, id := range ids {
+		_, found := suite.keeper.GetCDP(suite.ctx, "xrp", id)
+		suite.True(found)
+	}
+	_, found := suite.app.GetAuctionKeeper().GetAuction(suite.ctx, auction.DefaultNextAuctionID)
+	suite.False(found)
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	suite.Equal(d("2.0"), p.LiquidationRatio)
+
+	// raising the liquidation ratio at the current price liquidates the same cdps
+	ratioSimulation, err := suite.keeper.SimulateLiquidations(suite.ctx, "xrp", d("0.25"), d("2.5"))
+	suite.NoError(err)
+	suite.Equal(simulation.CollateralSeized, ratioSimulation.CollateralSeized)
+
+	// the simulated auctions are started by the liquidations at that price
+	suite.setPrice(d("0.2"), "xrp:usd")
+	suite.NoError(suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio))
+	var started types.SimulatedAuctions
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		ca := a.(auction.CollateralAuction)
+		started = append(started, types.NewSimulatedAuction(ca.Lot, ca.MaxBid, ca.CorrespondingDebt, ca.LotReturns))
+		return false
+	})
+	suite.Equal(simulation.Auctions, started)
+
+	_, err = suite.keeper.SimulateLiquidations(suite.ctx, "lol", d("0.2"), sdk.ZeroDec())
+	suite.Error(err)
+	_, err = suite.keeper.SimulateLiquidations(suite.ctx, "xrp", sdk.ZeroDec(), sdk.ZeroDec())
+	suite.Error(err)
+}
+
+func (suite *SeizeTestSuite) TestSeizeCollateralMultipleDebtAssets() {
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParams[1].DebtDenom = "susddebt"
+	suite.keeper.SetParams(suite.ctx, params)
+	_, addrs := app.GeneratePrivKeyAddressPairs(1)
+	ak := suite.app.GetAccountKeeper()
+	acc := ak.NewAccountWithAddress(suite.ctx, addrs[0])
+	acc.SetCoins(cs(c("xrp", 1000000000)))
+	ak.SetAccount(suite.ctx, acc)
+	err := suite.keeper.AddCdp(suite.ctx, addrs[0], cs(c("xrp", 1000000000)), cs(c("usdx", 50000000), c("susd", 30000000)))
+	suite.NoError(err)
+	cdp, _ := suite.keeper.GetCdpByOwnerAndDenom(suite.ctx, addrs[0], "xrp")
+
+	err = suite.keeper.SeizeCollateral(suite.ctx, cdp)
+	suite.NoError(err)
+	sk := suite.app.GetSupplyKeeper()
+	auctionMacc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(cs(c("debt", 50000000), c("susddebt", 30000000), c("xrp", 1000000000)), auctionMacc.GetCoins())
+
+	// the collateral is split between the debt assets in proportion to the debt owed
+	lots := sdk.NewCoins()
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		ca, ok := a.(auction.CollateralAuction)
+		suite.True(ok)
+		lots = lots.Add(sdk.NewCoins(sdk.NewCoin(ca.MaxBid.Denom, ca.Lot.Amount)))
+		return false
+	})
+	suite.Equal(cs(c("susd", 375000000), c("usdx", 625000000)), lots)
+}
+
+func (suite *SeizeTestSuite) TestLiquidateBasketCdp() {
+	suite.createCdps()
+	sk := suite.app.GetSupplyKeeper()
+	cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	err := suite.keeper.DepositCollateral(suite.ctx, cdp.Owner, cdp.Owner, "xrp", cs(c("btc", 1000000)))
+	suite.NoError(err)
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+
+	err = suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio)
+	suite.NoError(err)
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	suite.True(found)
+
+	suite.setPrice(d("0.05"), "xrp:usd")
+	err = suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio)
+	suite.NoError(err)
+	_, found = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	suite.False(found)
+
+	// btc from the basket is auctioned separately from the xrp
+	auctionMacc := sk.GetModuleAccount(suite.ctx, auction.ModuleName)
+	suite.Equal(i(1000000), auctionMacc.GetCoins().AmountOf("btc"))
+	btcAuctions := 0
+	suite.app.GetAuctionKeeper().IterateAuctions(suite.ctx, func(a auction.Auction) bool {
+		if ca, ok := a.(auction.CollateralAuction); ok && ca.Lot.Denom == "btc" {
+			btcAuctions++
+		}
+		return false
+	})
+	suite.Equal(1, btcAuctions)
+}
+
+func (suite *SeizeTestSuite) TestLiquidateBasketCdpsInRotation() {
+	suite.createCdps()
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[0].MaxBasketChecksPerBlock = 1
+	suite.keeper.SetParams(suite.ctx, params)
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	for _, id := range []uint64{2, 4} {
+		cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", id)
+		err := suite.keeper.DepositCollateral(suite.ctx, cdp.Owner, cdp.Owner, "xrp", cs(c("btc", 1000000)))
+		suite.NoError(err)
+	}
+
+	// one basket cdp is checked each block, in id order
+	suite.setPrice(d("0.05"), "xrp:usd")
+	err := suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio)
+	suite.NoError(err)
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp", uint64(2))
+	suite.False(found)
+	_, found = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(4))
+	suite.True(found)
+
+	err = suite.keeper.LiquidateCdps(suite.ctx, "xrp:usd", "xrp", p.LiquidationRatio)
+	suite.NoError(err)
+	_, found = suite.keeper.GetCDP(suite.ctx, "xrp", uint64(4))
+	suite.False(found)
+}
+
+func (suite *SeizeTestSuite) TestLiquidateBasketCdpUnpriced() {
+	suite.createCdps()
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[0].MaxBasketChecksPerBlock = 1
+	suite.keeper.SetParams(suite.ctx, params)
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	for _, id := range []uint64{2, 4} {
+		cdp, _ := suite.keeper.GetCDP(suite.ctx, "xrp", id)
+		err := suite.keeper.DepositCollateral(suite.ctx, cdp.Owner, cdp.Owner, "xrp", cs(c("btc", 1000000)))
+		suite.NoError(err)
+	}
+
+	// the btc price expires while xrp is still priced
+	suite.setPrice(d("0.2"), "xrp:usd")
+	ctx := suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(2 * time.Hour))
+	suite.Error(suite.app.GetPriceFeedKeeper().SetCurrentPrices(ctx, "btc:usd"))
+
+	// unpriced basket cdps are skipped in turn, without holding up the other liquidations
+	for _, id := range []uint64{2, 4} {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		err := suite.keeper.LiquidateCdps(ctx, "xrp:usd", "xrp", p.LiquidationRatio)
+		suite.NoError(err)
+		_, found := suite.keeper.GetCDP(ctx, "xrp", id)
+		suite.True(found)
+		skipped := []string{}
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeLiquidationUnpriced {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == types.AttributeKeyCdpID {
+					skipped = append(skipped, string(attr.Value))
+				}
+			}
+		}
+		suite.Equal([]string{fmt.Sprintf("%d", id)}, skipped)
+	}
+	for _, id := range suite.liquidations.xrp {
+		if id == 2 || id == 4 {
+			continue
+		}
+		_, found := suite.keeper.GetCDP(ctx, "xrp", id)
+		suite.False(found)
+	}
+}
+
+func (suite *SeizeTestSuite) TestLiquidateCdpsMaxPerBlock() {
+	suite.createCdps()
+	params := suite.keeper.GetParams(suite.ctx)
+	params.CollateralParams[0].MaxLiquidationsPerBlock = 2
+	suite.keeper.SetParams(suite.ctx, params)
+	suite.setPrice(d("0.2"), "xrp:usd")
+	p, _ := suite.keeper.GetCollateral(suite.ctx, "xrp")
+	suite.Equal(uint64(2), p.MaxLiquidationsPerBlock)
+
+	remaining := len(suite.liquidations.xrp)
+	suite.True(remaining > 2)
+	for remaining > 0 {
+		// the stats query reports the whole backlog
+		for _, cs := range suite.keeper.GetStats(suite.ctx).Collateral {
+			if cs.Denom == "xrp" {
+				suite.Equal(uint64(remaining), cs.Backlog)
+			}
+		}
+
+		ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+		err := suite.keeper.LiquidateCdps(ctx, "xrp:usd", "xrp", p.LiquidationRatio)
+		suite.NoError(err)
+		liquidated := 2
+		if remaining < 2 {
+			liquidated = remaining
+		}
+		remaining -= liquidated
+		suite.Equal(len(suite.cdps)/2-len(suite.liquidations.xrp)+remaining, len(suite.keeper.GetAllCdpsByDenom(suite.ctx, "xrp")))
+
+		// the backlog event counts the cdps left over up to the cap
+		backlogEvents := 0
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeLiquidationBacklog {
+				backlogEvents++
+				expectedBacklog := remaining
+				if expectedBacklog > 2 {
+					expectedBacklog = 2
+				}
+				for _, attr := range event.Attributes {
+					if string(attr.Key) == types.AttributeKeyBacklog {
+						suite.Equal(fmt.Sprintf("%d", expectedBacklog), string(attr.Value))
+					}
+				}
+			}
+		}
+		if remaining > 0 {
+			suite.Equal(1, backlogEvents)
+		} else {
+			suite.Equal(0, backlogEvents)
+		}
+	}
+}
+
+func (suite *SeizeTestSuite) TestKeeperLiquidation() {
+	suite.createCdps()
+	sk := suite.app.GetSupplyKeeper()
+	ak := suite.app.GetAccountKeeper()
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 1000000000)))
+	suite.NoError(err)
+	suite.setPrice(d("0.2"), "xrp:usd")
+	keeperAddr := suite.addrs[0]
+
+	// cdps above the liquidation ratio cannot be liquidated
+	liquidatable := make(map[uint64]bool)
+	for _, id := range suite.liquidations.xrp {
+		liquidatable[id] = true
+	}
+	var safeCdp types.CDP
+	for _, cdp := range suite.cdps {
+		if cdp.Collateral[0].Denom == "xrp" && !liquidatable[cdp.ID] {
+			safeCdp = cdp
+			break
+		}
+	}
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeperAddr, safeCdp.Owner, "xrp")
+	suite.Equal(types.CodeCdpNotLiquidatable, err.Result().Code)
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp", safeCdp.ID)
+	suite.True(found)
+
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeperAddr, suite.addrs[99], "btc")
+	suite.Equal(types.CodeCdpNotFound, err.Result().Code)
+
+	liquidatedCdp, found := suite.keeper.GetCDP(suite.ctx, "xrp", suite.liquidations.xrp[0])
+	suite.True(found)
+	balanceBefore := ak.GetAccount(suite.ctx, keeperAddr).GetCoins().AmountOf("usdx")
+	err = suite.keeper.AttemptKeeperLiquidation(suite.ctx, keeperAddr, liquidatedCdp.Owner, "xrp")
+	suite.NoError(err)
+	_, found = suite.keeper.GetCDP(suite.ctx, "xrp", liquidatedCdp.ID)
+	suite.False(found)
+
+	// reward is half of the 5% liquidation penalty
+	expectedReward := sdk.NewDecFromInt(liquidatedCdp.Principal.AmountOf("usdx")).Mul(d("0.025")).TruncateInt()
+	balanceAfter := ak.GetAccount(suite.ctx, keeperAddr).GetCoins().AmountOf("usdx")
+	suite.Equal(expectedReward, balanceAfter.Sub(balanceBefore))
+	suite.Equal(i(1000000000).Sub(expectedReward), sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc).GetCoins().AmountOf("usdx"))
+}
+
+func (suite *SeizeTestSuite) TestKeeperRewardSurplusBuffer() {
+	sk := suite.app.GetSupplyKeeper()
+	err := sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("usdx", 1000)))
+	suite.NoError(err)
+	balance := sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc).GetCoins().AmountOf("usdx")
+
+	// rewards are capped at the liquidator balance above the surplus buffer
+	params := suite.keeper.GetParams(suite.ctx)
+	params.SurplusBuffer = sdk.NewCoins(sdk.NewCoin("usdx", balance.SubRaw(10)))
+	suite.keeper.SetParams(suite.ctx, params)
+	reward := suite.keeper.CalculateKeeperReward(suite.ctx, "xrp", cs(c("usdx", 100000000)))
+	suite.Equal(cs(c("usdx", 10)), reward)
+
+	// no reward is paid when the balance is within the buffer
+	params.SurplusBuffer = sdk.NewCoins(sdk.NewCoin("usdx", balance.AddRaw(10)))
+	suite.keeper.SetParams(suite.ctx, params)
+	reward = suite.keeper.CalculateKeeperReward(suite.ctx, "xrp", cs(c("usdx", 100000000)))
+	suite.True(reward.IsZero())
+
+	// bad debt held by the liquidator is netted against its balance
+	params.SurplusBuffer = sdk.NewCoins(sdk.NewCoin("usdx", balance.SubRaw(10)))
+	suite.keeper.SetParams(suite.ctx, params)
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 4)))
+	suite.NoError(err)
+	reward = suite.keeper.CalculateKeeperReward(suite.ctx, "xrp", cs(c("usdx", 100000000)))
+	suite.Equal(cs(c("usdx", 6)), reward)
+	err = sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("debt", 10)))
+	suite.NoError(err)
+	reward = suite.keeper.CalculateKeeperReward(suite.ctx, "xrp", cs(c("usdx", 100000000)))
+	suite.True(reward.IsZero())
+}
+
 func (suite *SeizeTestSuite) TestHandleNewDebt() {
 	suite.createCdps()
 	tpb := suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx")
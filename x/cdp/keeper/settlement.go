@@ -0,0 +1,321 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// StartGlobalSettlement freezes the cdp system so that debt assets can be redeemed for the collateral backing them.
+// the following operations are performed:
+// 1. the price of each collateral type, and of each debt asset with a market, is frozen at the current price,
+// 2. every auction started by the liquidator module account is settled, returning the lots and debt of auctions without bids,
+// and the collateral held by the liquidator is added to the collateral redeemable by debt asset holders,
+// 3. the fees of each cdp are updated, and the collateral needed to cover its debt at the frozen prices is moved
+// from its deposits to the collateral redeemable by debt asset holders,
+// 4. the surplus held by the liquidator module account is burned,
+// 5. the value of the outstanding debt assets, which is redeemed pro-rata for the collateral, is recorded.
+// Debt assets held by the liquidator and auction module accounts are not outstanding.
+func (k Keeper) StartGlobalSettlement(ctx sdk.Context) sdk.Error {
+	if k.IsSettled(ctx) {
+		return types.ErrSystemSettled(k.codespace)
+	}
+	params := k.GetParams(ctx)
+	prices := types.SettlementPrices{}
+	for _, cp := range params.CollateralParams {
+		price, err := k.pricefeedKeeper.GetCurrentPrice(ctx, cp.MarketID)
+		if err != nil {
+			return err
+		}
+		if !price.Price.IsPositive() {
+			return types.ErrInvalidPrice(k.codespace, cp.MarketID, price.Price)
+		}
+		prices = append(prices, types.NewSettlementPrice(cp.Denom, price.Price))
+	}
+	debtPrices := types.SettlementPrices{}
+	for _, dp := range params.DebtParams {
+		if dp.MarketID == "" {
+			continue
+		}
+		price, err := k.getDebtPrice(ctx, dp)
+		if err != nil {
+			return err
+		}
+		debtPrices = append(debtPrices, types.NewSettlementPrice(dp.Denom, price))
+	}
+	settlement := types.NewSettlement(ctx.BlockTime(), prices, debtPrices, sdk.ZeroDec(), sdk.NewCoins(), sdk.NewCoins())
+
+	var auctionIDs []uint64
+	k.auctionKeeper.IterateAuctions(ctx, func(a auctiontypes.Auction) bool {
+		if a.GetInitiator() == types.LiquidatorMacc {
+			auctionIDs = append(auctionIDs, a.GetID())
+		}
+		return false
+	})
+	for _, id := range auctionIDs {
+		err := k.auctionKeeper.SettleAuction(ctx, id)
+		if err != nil {
+			return err
+		}
+	}
+	liquidatorCollateral := sdk.NewCoins()
+	liquidatorCoins := k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins()
+	for _, cp := range params.CollateralParams {
+		liquidatorCollateral = liquidatorCollateral.Add(sdk.NewCoins(sdk.NewCoin(cp.Denom, liquidatorCoins.AmountOf(cp.Denom))))
+	}
+	if !liquidatorCollateral.Empty() {
+		err := k.supplyKeeper.SendCoinsFromModuleToModule(ctx, types.LiquidatorMacc, types.ModuleName, liquidatorCollateral)
+		if err != nil {
+			return err
+		}
+		settlement.Collateral = settlement.Collateral.Add(liquidatorCollateral)
+	}
+
+	for _, cdp := range k.GetAllCdps(ctx) {
+		oldCollateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
+		k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, oldCollateralToDebtRatio)
+		periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
+		fees := k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Type)
+		cdp.AccumulatedFees = cdp.AccumulatedFees.Add(fees)
+		cdp.FeesUpdated = ctx.BlockTime()
+
+		k.recordFeeAccrual(ctx, cdp.ID, fees)
+
+		// deposits keep only the collateral their owners can reclaim
+		settlementRatio := k.calculateSettlementRatio(ctx, settlement, cdp)
+		cdpSettled := sdk.NewCoins()
+		reclaimable := false
+		for _, dep := range k.GetDeposits(ctx, cdp.ID) {
+			settled := sdk.NewCoins()
+			for _, dc := range dep.Amount {
+				amount := sdk.MinInt(sdk.NewDecFromInt(dc.Amount).Mul(settlementRatio).Ceil().TruncateInt(), dc.Amount)
+				settled = settled.Add(sdk.NewCoins(sdk.NewCoin(dc.Denom, amount)))
+			}
+			settlement.Collateral = settlement.Collateral.Add(settled)
+			cdpSettled = cdpSettled.Add(settled)
+			dep.Amount = dep.Amount.Sub(settled)
+			if dep.Amount.IsZero() {
+				k.DeleteDeposit(ctx, cdp.ID, dep.Depositor)
+				continue
+			}
+			k.SetDeposit(ctx, dep)
+			reclaimable = true
+		}
+		cdp.Collateral = cdp.Collateral.Sub(cdpSettled)
+		k.RecordCdpHistory(ctx, cdp.ID, types.EventTypeGlobalSettlement, nil, cdpSettled)
+		if !reclaimable {
+			k.closeSettledCdp(ctx, cdp)
+			continue
+		}
+		collateralToDebtRatio := k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, cdp.Principal.Add(cdp.AccumulatedFees))
+		k.SetCdpAndCollateralRatioIndex(ctx, cdp, collateralToDebtRatio)
+	}
+
+	// the liquidator surplus is not redeemable, burning it leaves more collateral for debt asset holders
+	err := k.NetSurplusAndDebt(ctx)
+	if err != nil {
+		return err
+	}
+	liquidatorCoins = k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins()
+	for _, dp := range params.DebtParams {
+		surplus := liquidatorCoins.AmountOf(dp.Denom)
+		if !surplus.IsPositive() {
+			continue
+		}
+		err = k.supplyKeeper.BurnCoins(ctx, types.LiquidatorMacc, sdk.NewCoins(sdk.NewCoin(dp.Denom, surplus)))
+		if err != nil {
+			return err
+		}
+	}
+
+	supply := k.supplyKeeper.GetSupply(ctx).GetTotal()
+	liquidatorCoins = k.supplyKeeper.GetModuleAccount(ctx, types.LiquidatorMacc).GetCoins()
+	auctionCoins := k.supplyKeeper.GetModuleAccount(ctx, auctiontypes.ModuleName).GetCoins()
+	for _, dp := range params.DebtParams {
+		outstanding := supply.AmountOf(dp.Denom).Sub(liquidatorCoins.AmountOf(dp.Denom)).Sub(auctionCoins.AmountOf(dp.Denom))
+		settlement.DebtValue = settlement.DebtValue.Add(k.calculateSettlementDebtValue(ctx, settlement, sdk.NewCoins(sdk.NewCoin(dp.Denom, sdk.MaxInt(outstanding, sdk.ZeroInt())))))
+	}
+	k.SetSettlement(ctx, settlement)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeGlobalSettlement,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, settlement.Collateral.String()),
+		),
+	)
+	return nil
+}
+
+// RedeemDebt burns the input amount of a debt asset and pays the redeemer its pro-rata share of the collateral backing all cdps at the settlement
+func (k Keeper) RedeemDebt(ctx sdk.Context, redeemer sdk.AccAddress, amount sdk.Coin) sdk.Error {
+	settlement, found := k.GetSettlement(ctx)
+	if !found {
+		return types.ErrSystemNotSettled(k.codespace)
+	}
+	_, found = k.GetDebtParam(ctx, amount.Denom)
+	if !found {
+		return types.ErrDebtNotSupported(k.codespace, amount.Denom)
+	}
+
+	share := sdk.ZeroDec()
+	if settlement.DebtValue.IsPositive() {
+		share = k.calculateSettlementDebtValue(ctx, settlement, sdk.NewCoins(amount)).Quo(settlement.DebtValue)
+	}
+	remaining := settlement.Collateral.Sub(settlement.CollateralRedeemed)
+	payout := sdk.NewCoins()
+	for _, cc := range settlement.Collateral {
+		payoutAmount := sdk.MinInt(sdk.NewDecFromInt(cc.Amount).Mul(share).TruncateInt(), remaining.AmountOf(cc.Denom))
+		payout = payout.Add(sdk.NewCoins(sdk.NewCoin(cc.Denom, payoutAmount)))
+	}
+
+	err := k.supplyKeeper.SendCoinsFromAccountToModule(ctx, redeemer, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		return err
+	}
+	err = k.supplyKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount))
+	if err != nil {
+		panic(err)
+	}
+	if !payout.IsZero() {
+		err = k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, redeemer, payout)
+		if err != nil {
+			return err
+		}
+	}
+	settlement.CollateralRedeemed = settlement.CollateralRedeemed.Add(payout)
+	k.SetSettlement(ctx, settlement)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDebtRedemption,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyRedeemer, redeemer.String()),
+		),
+	)
+	return nil
+}
+
+// ReclaimCollateral returns a deposit's collateral that was not needed to cover the debt of its cdp at the settlement to the depositor.
+// The cdp is closed once all of its deposits have been reclaimed.
+func (k Keeper) ReclaimCollateral(ctx sdk.Context, owner sdk.AccAddress, depositor sdk.AccAddress, denom string) sdk.Error {
+	if !k.IsSettled(ctx) {
+		return types.ErrSystemNotSettled(k.codespace)
+	}
+	cdp, found := k.GetCdpByOwnerAndDenom(ctx, owner, denom)
+	if !found {
+		return types.ErrCdpNotFound(k.codespace, owner, denom)
+	}
+	deposit, found := k.GetDeposit(ctx, cdp.ID, depositor)
+	if !found {
+		return types.ErrDepositNotFound(k.codespace, depositor, cdp.ID)
+	}
+	err := k.supplyKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, deposit.Amount)
+	if err != nil {
+		return err
+	}
+	k.DeleteDeposit(ctx, cdp.ID, depositor)
+	k.RecordCdpHistory(ctx, cdp.ID, types.EventTypeCollateralReclaim, depositor, deposit.Amount)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCollateralReclaim,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, deposit.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+			sdk.NewAttribute(types.AttributeKeyDepositor, depositor.String()),
+		),
+	)
+
+	if len(k.GetDeposits(ctx, cdp.ID)) == 0 {
+		k.closeSettledCdp(ctx, cdp)
+		return nil
+	}
+	debt := cdp.Principal.Add(cdp.AccumulatedFees)
+	k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, debt))
+	cdp.Collateral = cdp.Collateral.Sub(deposit.Amount)
+	k.SetCdpAndCollateralRatioIndex(ctx, cdp, k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, debt))
+	return nil
+}
+
+// GetSettlement returns the state of the cdp system frozen by a global settlement
+func (k Keeper) GetSettlement(ctx sdk.Context) (settlement types.Settlement, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SettlementKey)
+	bz := store.Get([]byte{})
+	if bz == nil {
+		return settlement, false
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &settlement)
+	return settlement, true
+}
+
+// SetSettlement sets the state of the cdp system frozen by a global settlement
+func (k Keeper) SetSettlement(ctx sdk.Context, settlement types.Settlement) {
+	store := prefix.NewStore(ctx.KVStore(k.key), types.SettlementKey)
+	store.Set([]byte{}, k.cdc.MustMarshalBinaryLengthPrefixed(settlement))
+}
+
+// IsSettled returns true once a global settlement of the cdp system has started
+func (k Keeper) IsSettled(ctx sdk.Context) bool {
+	_, found := k.GetSettlement(ctx)
+	return found
+}
+
+// calculateSettlementRatio returns the fraction of the collateral of a cdp that covers its debt at the settlement prices, at most 1
+func (k Keeper) calculateSettlementRatio(ctx sdk.Context, settlement types.Settlement, cdp types.CDP) sdk.Dec {
+	debtValue := k.calculateSettlementDebtValue(ctx, settlement, cdp.Principal.Add(cdp.AccumulatedFees))
+	collateralValue := sdk.ZeroDec()
+	for _, cc := range cdp.Collateral {
+		price, found := settlement.PriceOf(cc.Denom)
+		if !found {
+			continue
+		}
+		collateralValue = collateralValue.Add(k.convertCollateralToBaseUnits(ctx, cc).Mul(price))
+	}
+	if debtValue.GTE(collateralValue) {
+		return sdk.OneDec()
+	}
+	return debtValue.Quo(collateralValue)
+}
+
+// calculateSettlementDebtValue returns the value of the input debt in base units at the settlement prices
+func (k Keeper) calculateSettlementDebtValue(ctx sdk.Context, settlement types.Settlement, debt sdk.Coins) sdk.Dec {
+	debtValue := sdk.ZeroDec()
+	for _, dc := range debt {
+		debtValue = debtValue.Add(k.convertDebtToBaseUnits(ctx, dc).Mul(settlement.DebtPriceOf(dc.Denom)))
+	}
+	return debtValue
+}
+
+// closeSettledCdp burns the debt coins of a settled cdp and removes it and its indexes from the store
+func (k Keeper) closeSettledCdp(ctx sdk.Context, cdp types.CDP) {
+	debt := cdp.Principal.Add(cdp.AccumulatedFees)
+	for _, dc := range debt {
+		debtDenom := k.GetInternalDebtDenom(ctx, dc.Denom)
+		amount := sdk.MinInt(dc.Amount, k.getModAccountDebt(ctx, types.ModuleName, debtDenom))
+		if !amount.IsPositive() {
+			continue
+		}
+		err := k.BurnDebtCoins(ctx, types.ModuleName, debtDenom, sdk.NewCoins(sdk.NewCoin(dc.Denom, amount)))
+		if err != nil {
+			panic(err)
+		}
+	}
+	k.DecrementTotalPrincipal(ctx, cdp.Type, debt)
+	// a cdp whose collateral was all settled has already been removed from the collateral ratio index
+	if !cdp.Collateral.IsZero() {
+		k.RemoveCdpCollateralRatioIndex(ctx, cdp.Type, cdp.ID, k.CalculateCollateralToDebtRatio(ctx, cdp.Collateral, debt))
+	}
+	k.RemoveBasketCdpIndex(ctx, cdp.Type, cdp.ID)
+	k.RemoveCdpOwnerIndex(ctx, cdp)
+	k.DeleteCDP(ctx, cdp)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCdpClose,
+			sdk.NewAttribute(types.AttributeKeyCdpID, fmt.Sprintf("%d", cdp.ID)),
+		),
+	)
+	k.RecordCdpHistory(ctx, cdp.ID, types.EventTypeCdpClose, nil, nil)
+}
@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/app"
+	"github.com/kava-labs/kava/x/auction"
+	"github.com/kava-labs/kava/x/cdp/keeper"
+	"github.com/kava-labs/kava/x/cdp/types"
+	"github.com/kava-labs/kava/x/pricefeed"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtime "github.com/tendermint/tendermint/types/time"
+)
+
+type SettlementTestSuite struct {
+	suite.Suite
+
+	keeper keeper.Keeper
+	app    app.TestApp
+	ctx    sdk.Context
+	addrs  []sdk.AccAddress
+}
+
+func (suite *SettlementTestSuite) SetupTest() {
+	tApp := app.NewTestApp()
+	ctx := tApp.NewContext(true, abci.Header{Height: 1, Time: tmtime.Now()})
+	_, addrs := app.GeneratePrivKeyAddressPairs(2)
+	authGS := app.NewAuthGenState(
+		addrs,
+		[]sdk.Coins{
+			cs(c("xrp", 1000000000)),
+			cs(c("btc", 100000000))})
+	tApp.InitializeFromGenesisStates(
+		authGS,
+		NewPricefeedGenStateMulti(),
+		NewCDPGenStateMulti(),
+	)
+	keeper := tApp.GetCDPKeeper()
+	suite.app = tApp
+	suite.keeper = keeper
+	suite.ctx = ctx
+	suite.addrs = addrs
+
+	// $100 of xrp backing $40 of debt, and $8000 of btc backing $4000 of debt
+	suite.NoError(keeper.AddCdp(ctx, addrs[0], cs(c("xrp", 400000000)), cs(c("usdx", 40000000))))
+	suite.NoError(keeper.AddCdp(ctx, addrs[1], cs(c("btc", 100000000)), cs(c("usdx", 4000000000))))
+}
+
+func (suite *SettlementTestSuite) TestStartGlobalSettlement() {
+	err := suite.keeper.RedeemDebt(suite.ctx, suite.addrs[0], c("usdx", 40000000))
+	suite.Equal(types.CodeSystemNotSettled, err.Result().Code)
+
+	suite.NoError(suite.keeper.StartGlobalSettlement(suite.ctx))
+	settlement, found := suite.keeper.GetSettlement(suite.ctx)
+	suite.True(found)
+	suite.Equal(types.SettlementPrices{
+		types.NewSettlementPrice("xrp", d("0.25")),
+		types.NewSettlementPrice("btc", d("8000")),
+	}, settlement.Prices)
+	suite.Equal(d("4040"), settlement.DebtValue)
+	suite.Equal(cs(c("btc", 50000000), c("xrp", 160000000)), settlement.Collateral)
+
+	// deposits keep the collateral not needed to cover the debt
+	deposit, found := suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[0])
+	suite.True(found)
+	suite.Equal(cs(c("xrp", 240000000)), deposit.Amount)
+	deposit, found = suite.keeper.GetDeposit(suite.ctx, 2, suite.addrs[1])
+	suite.True(found)
+	suite.Equal(cs(c("btc", 50000000)), deposit.Amount)
+	cdp, found := suite.keeper.GetCDP(suite.ctx, "xrp", 1)
+	suite.True(found)
+	suite.Equal(cs(c("xrp", 240000000)), cdp.Collateral)
+
+	err = suite.keeper.StartGlobalSettlement(suite.ctx)
+	suite.Equal(types.CodeSystemSettled, err.Result().Code)
+	err = suite.keeper.AddPrincipal(suite.ctx, suite.addrs[0], "xrp", cs(c("usdx", 1000000)))
+	suite.Equal(types.CodeSystemSettled, err.Result().Code)
+	err = suite.keeper.DepositCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp", cs(c("xrp", 1000000)))
+	suite.Equal(types.CodeSystemSettled, err.Result().Code)
+}
+
+func (suite *SettlementTestSuite) TestStartGlobalSettlementDebtPrice() {
+	pk := suite.app.GetPriceFeedKeeper()
+	pfParams := pk.GetParams(suite.ctx)
+	pfParams.Markets = append(pfParams.Markets, pricefeed.Market{MarketID: "usdx:usd", BaseAsset: "usdx", QuoteAsset: "usd", Oracles: []sdk.AccAddress{}, Active: true})
+	pk.SetParams(suite.ctx, pfParams)
+	_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, "usdx:usd", d("0.5"), suite.ctx.BlockTime().Add(time.Hour*3))
+	suite.NoError(err)
+	suite.NoError(pk.SetCurrentPrices(suite.ctx, "usdx:usd"))
+	params := suite.keeper.GetParams(suite.ctx)
+	params.DebtParams[0].MarketID = "usdx:usd"
+	suite.keeper.SetParams(suite.ctx, params)
+
+	// at a usdx price of 0.5 the debt is worth $20 and $2000, so half as much collateral covers it
+	suite.NoError(suite.keeper.StartGlobalSettlement(suite.ctx))
+	settlement, _ := suite.keeper.GetSettlement(suite.ctx)
+	suite.Equal(types.SettlementPrices{types.NewSettlementPrice("usdx", d("0.5"))}, settlement.DebtPrices)
+	suite.Equal(d("2020"), settlement.DebtValue)
+	suite.Equal(cs(c("btc", 25000000), c("xrp", 80000000)), settlement.Collateral)
+
+	// the frozen debt price values redemptions after the market price moves
+	_, err = pk.SetPrice(suite.ctx, sdk.AccAddress{}, "usdx:usd", d("1.0"), suite.ctx.BlockTime().Add(time.Hour*3))
+	suite.NoError(err)
+	suite.NoError(pk.SetCurrentPrices(suite.ctx, "usdx:usd"))
+	suite.NoError(suite.keeper.RedeemDebt(suite.ctx, suite.addrs[0], c("usdx", 40000000)))
+	acc := suite.app.GetAccountKeeper().GetAccount(suite.ctx, suite.addrs[0])
+	suite.Equal(cs(c("btc", 247524), c("xrp", 600792079)), acc.GetCoins())
+}
+
+func (suite *SettlementTestSuite) TestSettleUndercollateralizedCdp() {
+	pk := suite.app.GetPriceFeedKeeper()
+	_, err := pk.SetPrice(suite.ctx, sdk.AccAddress{}, "xrp:usd", d("0.05"), suite.ctx.BlockTime().Add(time.Hour*3))
+	suite.NoError(err)
+	suite.NoError(pk.SetCurrentPrices(suite.ctx, "xrp:usd"))
+
+	suite.NoError(suite.keeper.StartGlobalSettlement(suite.ctx))
+	settlement, _ := suite.keeper.GetSettlement(suite.ctx)
+	suite.Equal(cs(c("btc", 50000000), c("xrp", 400000000)), settlement.Collateral)
+
+	// all of the collateral covers the debt, so the cdp is closed
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp", 1)
+	suite.False(found)
+	_, found = suite.keeper.GetDeposit(suite.ctx, 1, suite.addrs[0])
+	suite.False(found)
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx"))
+}
+
+func (suite *SettlementTestSuite) TestSettleLiquidatorAuctions() {
+	sk := suite.app.GetSupplyKeeper()
+	ak := suite.app.GetAuctionKeeper()
+	suite.NoError(sk.MintCoins(suite.ctx, types.LiquidatorMacc, cs(c("xrp", 150000000), c("debt", 30000000), c("usdx", 10000000))))
+	_, err := ak.StartCollateralAuction(suite.ctx, types.LiquidatorMacc, c("xrp", 100000000), c("usdx", 20000000), []sdk.AccAddress{suite.addrs[0]}, []sdk.Int{i(1)}, c("debt", 20000000), sdk.ZeroDec())
+	suite.NoError(err)
+	bidID, err := ak.StartCollateralAuction(suite.ctx, types.LiquidatorMacc, c("xrp", 50000000), c("usdx", 10000000), []sdk.AccAddress{suite.addrs[0]}, []sdk.Int{i(1)}, c("debt", 10000000), sdk.ZeroDec())
+	suite.NoError(err)
+	_, err = ak.StartSurplusAuction(suite.ctx, types.LiquidatorMacc, c("usdx", 10000000), "ukava", sdk.ZeroDec())
+	suite.NoError(err)
+	suite.NoError(ak.PlaceBid(suite.ctx, bidID, suite.addrs[1], c("usdx", 5000000)))
+
+	suite.NoError(suite.keeper.StartGlobalSettlement(suite.ctx))
+
+	// the auctions are closed, the latest bidder is paid the lot, and the unsold lot backs the debt assets
+	count := 0
+	ak.IterateAuctions(suite.ctx, func(auction.Auction) bool {
+		count++
+		return false
+	})
+	suite.Equal(0, count)
+	acc := suite.app.GetAccountKeeper().GetAccount(suite.ctx, suite.addrs[1])
+	suite.Equal(cs(c("usdx", 3995000000), c("xrp", 50000000)), acc.GetCoins())
+	settlement, _ := suite.keeper.GetSettlement(suite.ctx)
+	suite.Equal(cs(c("btc", 50000000), c("xrp", 260000000)), settlement.Collateral)
+	suite.True(sk.GetModuleAccount(suite.ctx, auction.ModuleName).GetCoins().Empty())
+	suite.True(sk.GetModuleAccount(suite.ctx, types.LiquidatorMacc).GetCoins().AmountOf("usdx").IsZero())
+
+	// the bid paid to the liquidator and the returned surplus are burned, so they are not redeemable
+	suite.Equal(d("4035"), settlement.DebtValue)
+}
+
+func (suite *SettlementTestSuite) TestRedeemDebt() {
+	suite.NoError(suite.keeper.StartGlobalSettlement(suite.ctx))
+
+	err := suite.keeper.RedeemDebt(suite.ctx, suite.addrs[1], c("xrp", 1000000))
+	suite.Equal(types.CodeDebtNotSupported, err.Result().Code)
+
+	suite.NoError(suite.keeper.RedeemDebt(suite.ctx, suite.addrs[1], c("usdx", 4000000000)))
+	acc := suite.app.GetAccountKeeper().GetAccount(suite.ctx, suite.addrs[1])
+	suite.Equal(cs(c("btc", 49504950), c("xrp", 158415841)), acc.GetCoins())
+
+	suite.NoError(suite.keeper.RedeemDebt(suite.ctx, suite.addrs[0], c("usdx", 40000000)))
+	acc = suite.app.GetAccountKeeper().GetAccount(suite.ctx, suite.addrs[0])
+	suite.Equal(cs(c("btc", 495049), c("xrp", 601584158)), acc.GetCoins())
+
+	settlement, _ := suite.keeper.GetSettlement(suite.ctx)
+	suite.Equal(cs(c("btc", 49999999), c("xrp", 159999999)), settlement.CollateralRedeemed)
+	supply := suite.app.GetSupplyKeeper().GetSupply(suite.ctx).GetTotal()
+	suite.Equal(i(0), supply.AmountOf("usdx"))
+}
+
+func (suite *SettlementTestSuite) TestReclaimCollateral() {
+	err := suite.keeper.ReclaimCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp")
+	suite.Equal(types.CodeSystemNotSettled, err.Result().Code)
+
+	suite.NoError(suite.keeper.StartGlobalSettlement(suite.ctx))
+	err = suite.keeper.ReclaimCollateral(suite.ctx, suite.addrs[0], suite.addrs[1], "xrp")
+	suite.Equal(types.CodeDepositNotFound, err.Result().Code)
+
+	suite.NoError(suite.keeper.ReclaimCollateral(suite.ctx, suite.addrs[0], suite.addrs[0], "xrp"))
+	acc := suite.app.GetAccountKeeper().GetAccount(suite.ctx, suite.addrs[0])
+	suite.Equal(cs(c("usdx", 40000000), c("xrp", 840000000)), acc.GetCoins())
+	_, found := suite.keeper.GetCDP(suite.ctx, "xrp", 1)
+	suite.False(found)
+	suite.Equal(i(0), suite.keeper.GetTotalPrincipal(suite.ctx, "xrp", "usdx"))
+
+	// the cdp module account holds the collateral of the remaining deposit and the collateral to be redeemed
+	acc = suite.app.GetSupplyKeeper().GetModuleAccount(suite.ctx, types.ModuleName)
+	suite.Equal(cs(c("btc", 100000000), c("debt", 4000000000), c("xrp", 160000000)), acc.GetCoins())
+}
+
+func TestSettlementTestSuite(t *testing.T) {
+	suite.Run(t, new(SettlementTestSuite))
+}
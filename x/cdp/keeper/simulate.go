@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
+	"github.com/kava-labs/kava/x/cdp/types"
+	pftypes "github.com/kava-labs/kava/x/pricefeed/types"
+)
+
+// SimulateLiquidations returns the cdps of a collateral type that would be liquidated at a hypothetical price, and the collateral auctions
+// their liquidation would start. If liquidationRatio is zero the current liquidation ratio of the collateral type is used.
+// Every cdp below the liquidation ratio is included, even if MaxLiquidationsPerBlock would spread the liquidations over several blocks.
+// The liquidations run on a cache of the store that is discarded, so no state is changed.
+func (k Keeper) SimulateLiquidations(ctx sdk.Context, denom string, price sdk.Dec, liquidationRatio sdk.Dec) (types.LiquidationSimulation, sdk.Error) {
+	cp, found := k.GetCollateral(ctx, denom)
+	if !found {
+		return types.LiquidationSimulation{}, types.ErrCollateralNotSupported(k.codespace, denom)
+	}
+	if price.IsNil() || !price.IsPositive() {
+		return types.LiquidationSimulation{}, types.ErrInvalidPrice(k.codespace, cp.MarketID, price)
+	}
+	if liquidationRatio.IsNil() || liquidationRatio.IsZero() {
+		liquidationRatio = cp.LiquidationRatio
+	}
+	if liquidationRatio.IsNegative() {
+		return types.LiquidationSimulation{}, sdk.ErrUnknownRequest(fmt.Sprintf("invalid liquidation ratio: %s", liquidationRatio))
+	}
+
+	simCtx, _ := ctx.CacheContext()
+	params := k.GetParams(simCtx)
+	for i := range params.CollateralParams {
+		if params.CollateralParams[i].Denom == denom {
+			params.CollateralParams[i].LiquidationRatio = liquidationRatio
+		}
+	}
+	k.SetParams(simCtx, params)
+
+	auctions := types.SimulatedAuctions{}
+	simKeeper := k
+	simKeeper.pricefeedKeeper = simulatedPricefeedKeeper{k.pricefeedKeeper, cp.MarketID, price}
+	simKeeper.auctionKeeper = simulatedAuctionKeeper{k.auctionKeeper, &auctions}
+
+	cdps, err := simKeeper.getCdpsToLiquidate(simCtx, cp.MarketID, denom, liquidationRatio, 0, 0)
+	if err != nil {
+		return types.LiquidationSimulation{}, err
+	}
+	liquidated := types.CDPs{}
+	collateralSeized := sdk.NewCoins()
+	debtSeized := sdk.NewCoins()
+	for _, cdp := range cdps {
+		err := simKeeper.SeizeCollateral(simCtx, cdp)
+		if err != nil {
+			return types.LiquidationSimulation{}, err
+		}
+		periods := sdk.NewInt(ctx.BlockTime().Unix()).Sub(sdk.NewInt(cdp.FeesUpdated.Unix()))
+		cdp.AccumulatedFees = cdp.AccumulatedFees.Add(k.CalculateFees(ctx, cdp.Principal.Add(cdp.AccumulatedFees), periods, cdp.Type))
+		cdp.FeesUpdated = ctx.BlockTime()
+		liquidated = append(liquidated, cdp)
+		collateralSeized = collateralSeized.Add(cdp.Collateral)
+		debtSeized = debtSeized.Add(cdp.Principal.Add(cdp.AccumulatedFees))
+	}
+	return types.NewLiquidationSimulation(denom, price, liquidationRatio, liquidated, collateralSeized, debtSeized, auctions), nil
+}
+
+// simulatedPricefeedKeeper returns a hypothetical price for one market, and the current price for all other markets
+type simulatedPricefeedKeeper struct {
+	types.PricefeedKeeper
+	marketID string
+	price    sdk.Dec
+}
+
+// GetCurrentPrice returns the hypothetical price of the simulated market
+func (pk simulatedPricefeedKeeper) GetCurrentPrice(ctx sdk.Context, marketID string) (pftypes.CurrentPrice, sdk.Error) {
+	if marketID == pk.marketID {
+		return pftypes.CurrentPrice{MarketID: marketID, Price: pk.price}, nil
+	}
+	return pk.PricefeedKeeper.GetCurrentPrice(ctx, marketID)
+}
+
+// simulatedAuctionKeeper records the collateral and dutch auctions that would be started instead of starting them
+type simulatedAuctionKeeper struct {
+	types.AuctionKeeper
+	auctions *types.SimulatedAuctions
+}
+
+// StartCollateralAuction records a collateral auction
+func (ak simulatedAuctionKeeper) StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin, reservePrice sdk.Dec) (uint64, sdk.Error) {
+	lotReturns, err := auctiontypes.NewWeightedAddresses(lotReturnAddrs, lotReturnWeights)
+	if err != nil {
+		return 0, err
+	}
+	*ak.auctions = append(*ak.auctions, types.NewSimulatedAuction(lot, maxBid, debt, lotReturns))
+	return uint64(len(*ak.auctions)), nil
+}
+
+// StartDutchAuction records a dutch auction
+func (ak simulatedAuctionKeeper) StartDutchAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, marketPrice sdk.Dec, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, sdk.Error) {
+	return ak.StartCollateralAuction(ctx, seller, lot, maxBid, lotReturnAddrs, lotReturnWeights, debt, sdk.ZeroDec())
+}
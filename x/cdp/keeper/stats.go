@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kava-labs/kava/x/cdp/types"
+)
+
+// GetStats returns the total collateral, principal and debt limit utilization of each collateral type and of the whole system,
+// the surplus and bad debt held by the liquidator module account, and the number of cdps in each ratio bucket.
+// Cdps are counted in ratio buckets by their collateralization ratio, including accumulated fees, as a multiple of their liquidation ratio.
+// Cdps whose collateral can not be priced are counted as unpriced instead. Cdps below their liquidation ratio are counted as the
+// liquidation backlog, the cdps left for later blocks once MaxLiquidationsPerBlock is reached.
+func (k Keeper) GetStats(ctx sdk.Context) types.Stats {
+	params := k.GetParams(ctx)
+	var cdpCount, unpricedCount, backlogCount uint64
+	totalPrincipal := sdk.NewCoins()
+	ratioBuckets := types.NewRatioBuckets()
+	collateralStats := types.CollateralStats{}
+	for _, cp := range params.CollateralParams {
+		var count, unpriced, backlog uint64
+		totalCollateral := sdk.NewCoins()
+		buckets := types.NewRatioBuckets()
+		k.IterateCdpsByDenom(ctx, cp.Denom, func(cdp types.CDP) bool {
+			count++
+			totalCollateral = totalCollateral.Add(cdp.Collateral)
+			ratio, err := k.calculateRatioToLiquidationRatio(ctx, cdp)
+			if err != nil {
+				unpriced++
+				return false
+			}
+			if ratio.LT(sdk.OneDec()) {
+				backlog++
+			}
+			buckets.Add(ratio)
+			ratioBuckets.Add(ratio)
+			return false
+		})
+
+		principal := sdk.NewCoins()
+		utilization := sdk.DecCoins{}
+		for _, dp := range params.DebtParams {
+			principal = principal.Add(sdk.NewCoins(sdk.NewCoin(dp.Denom, k.GetTotalPrincipal(ctx, cp.Denom, dp.Denom))))
+			utilization = append(utilization, sdk.NewDecCoinFromDec(dp.Denom, k.GetUtilization(ctx, cp.Denom, dp.Denom)))
+		}
+		collateralStats = append(collateralStats, types.NewCollateralStat(
+			cp.Denom, count, totalCollateral, principal, k.GetDebtLimit(ctx, cp.Denom), utilization.Sort(), buckets, unpriced, backlog,
+		))
+		cdpCount += count
+		unpricedCount += unpriced
+		backlogCount += backlog
+		totalPrincipal = totalPrincipal.Add(principal)
+	}
+
+	globalUtilization := sdk.DecCoins{}
+	for _, dp := range params.DebtParams {
+		globalUtilization = append(globalUtilization, sdk.NewDecCoinFromDec(dp.Denom,
+			calculateUtilization(totalPrincipal.AmountOf(dp.Denom), params.GlobalDebtLimit.AmountOf(dp.Denom))))
+	}
+	systemSurplus := k.GetSystemSurplus(ctx)
+	return types.NewStats(
+		cdpCount, totalPrincipal, params.GlobalDebtLimit, globalUtilization.Sort(),
+		systemSurplus.Surplus, systemSurplus.BadDebt, ratioBuckets, unpricedCount, backlogCount, collateralStats,
+	)
+}
+
+// calculateRatioToLiquidationRatio returns the collateralization ratio of a cdp, including accumulated fees, as a multiple of its liquidation ratio
+func (k Keeper) calculateRatioToLiquidationRatio(ctx sdk.Context, cdp types.CDP) (sdk.Dec, sdk.Error) {
+	collateralizationRatio, err := k.CalculateCollateralizationRatio(ctx, cdp.Collateral, cdp.Principal, cdp.AccumulatedFees)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	liquidationRatio, err := k.CalculateLiquidationRatio(ctx, cdp.Collateral)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return collateralizationRatio.Quo(liquidationRatio), nil
+}
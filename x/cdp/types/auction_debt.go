@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AuctionDebt is the debt of an open collateral auction started by the liquidator that has not been recovered by its bids yet
+type AuctionDebt struct {
+	AuctionID uint64   `json:"auction_id" yaml:"auction_id"`
+	Debt      sdk.Coin `json:"debt" yaml:"debt"` // internal debt coins still escrowed by the auction
+}
+
+// NewAuctionDebt returns a new AuctionDebt
+func NewAuctionDebt(auctionID uint64, debt sdk.Coin) AuctionDebt {
+	return AuctionDebt{
+		AuctionID: auctionID,
+		Debt:      debt,
+	}
+}
+
+// String implements fmt.Stringer
+func (ad AuctionDebt) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Auction Debt:
+	Auction ID: %d
+	Debt: %s`, ad.AuctionID, ad.Debt))
+}
+
+// Validate performs basic validation of an auction debt
+func (ad AuctionDebt) Validate() error {
+	if !ad.Debt.IsValid() {
+		return fmt.Errorf("invalid auction debt: %s", ad)
+	}
+	return nil
+}
+
+// AuctionDebts a slice of AuctionDebt
+type AuctionDebts []AuctionDebt
@@ -12,6 +12,7 @@ import (
 type CDP struct {
 	ID              uint64         `json:"id" yaml:"id"`                 // unique id for cdp
 	Owner           sdk.AccAddress `json:"owner" yaml:"owner"`           // Account that authorizes changes to the CDP
+	Type            string         `json:"type" yaml:"type"`             // collateral type of the cdp, which determines its params, fees and debt limits
 	Collateral      sdk.Coins      `json:"collateral" yaml:"collateral"` // Amount of collateral stored in this CDP
 	Principal       sdk.Coins      `json:"principal" yaml:"principal"`
 	AccumulatedFees sdk.Coins      `json:"accumulated_fees" yaml:"accumulated_fees"`
@@ -24,6 +25,7 @@ func NewCDP(id uint64, owner sdk.AccAddress, collateral sdk.Coins, principal sdk
 	return CDP{
 		ID:              id,
 		Owner:           owner,
+		Type:            collateral[0].Denom,
 		Collateral:      collateral,
 		Principal:       principal,
 		AccumulatedFees: fees,
@@ -43,7 +45,7 @@ func (cdp CDP) String() string {
 	Fees Last Updated: %s`,
 		cdp.Owner,
 		cdp.ID,
-		cdp.Collateral[0].Denom,
+		cdp.Type,
 		cdp.Collateral,
 		cdp.Principal,
 		cdp.AccumulatedFees,
@@ -51,6 +53,11 @@ func (cdp CDP) String() string {
 	))
 }
 
+// IsBasket returns true if the cdp holds collateral other than its collateral type
+func (cdp CDP) IsBasket() bool {
+	return len(cdp.Collateral) != 1 || cdp.Collateral[0].Denom != cdp.Type
+}
+
 // CDPs a collection of CDP objects
 type CDPs []CDP
 
@@ -76,6 +83,7 @@ func NewAugmentedCDP(cdp CDP, collateralValue sdk.Coin, collateralizationRatio s
 		CDP: CDP{
 			ID:              cdp.ID,
 			Owner:           cdp.Owner,
+			Type:            cdp.Type,
 			Collateral:      cdp.Collateral,
 			Principal:       cdp.Principal,
 			AccumulatedFees: cdp.AccumulatedFees,
@@ -101,7 +109,7 @@ func (augCDP AugmentedCDP) String() string {
 	Collateralization ratio: %s`,
 		augCDP.Owner,
 		augCDP.ID,
-		augCDP.Collateral[0].Denom,
+		augCDP.Type,
 		augCDP.Collateral,
 		augCDP.CollateralValue,
 		augCDP.Principal,
@@ -122,3 +130,47 @@ func (augcdps AugmentedCDPs) String() string {
 	}
 	return out
 }
+
+// CDPHealth provides the liquidation price and borrowing capacity of a CDP at current prices and fee rates
+type CDPHealth struct {
+	AugmentedCDP        `json:"augmented_cdp" yaml:"augmented_cdp"`
+	FeesAccrued         sdk.Coins `json:"fees_accrued" yaml:"fees_accrued"`                   // fees accrued since the fees were last updated
+	LiquidationRatio    sdk.Dec   `json:"liquidation_ratio" yaml:"liquidation_ratio"`         // collateralization ratio below which the cdp is liquidated
+	LiquidationPrice    sdk.Dec   `json:"liquidation_price" yaml:"liquidation_price"`         // price of the cdp's collateral type below which the cdp is liquidated
+	AvailableToWithdraw sdk.Coins `json:"available_to_withdraw" yaml:"available_to_withdraw"` // amount of each collateral denom that can be withdrawn if nothing else is withdrawn or drawn
+	AvailableToDraw     sdk.Coins `json:"available_to_draw" yaml:"available_to_draw"`         // amount of each debt asset that can be drawn if nothing else is withdrawn or drawn
+	TimeToLiquidation   int64     `json:"time_to_liquidation" yaml:"time_to_liquidation"`     // seconds until accruing fees put the cdp below the liquidation ratio, -1 if never
+}
+
+// NewCDPHealth returns a new CDPHealth
+func NewCDPHealth(augmentedCDP AugmentedCDP, feesAccrued sdk.Coins, liquidationRatio, liquidationPrice sdk.Dec,
+	availableToWithdraw, availableToDraw sdk.Coins, timeToLiquidation int64) CDPHealth {
+	return CDPHealth{
+		AugmentedCDP:        augmentedCDP,
+		FeesAccrued:         feesAccrued,
+		LiquidationRatio:    liquidationRatio,
+		LiquidationPrice:    liquidationPrice,
+		AvailableToWithdraw: availableToWithdraw,
+		AvailableToDraw:     availableToDraw,
+		TimeToLiquidation:   timeToLiquidation,
+	}
+}
+
+// String implements fmt.Stringer
+func (h CDPHealth) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`%s
+	Fees Accrued: %s
+	Liquidation Ratio: %s
+	Liquidation Price: %s
+	Available To Withdraw (any one of): %s
+	Available To Draw (any one of): %s
+	Time To Liquidation: %ds`,
+		h.AugmentedCDP,
+		h.FeesAccrued,
+		h.LiquidationRatio,
+		h.LiquidationPrice,
+		h.AvailableToWithdraw,
+		h.AvailableToDraw,
+		h.TimeToLiquidation,
+	))
+}
@@ -19,4 +19,9 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgWithdraw{}, "cdp/MsgWithdraw", nil)
 	cdc.RegisterConcrete(MsgDrawDebt{}, "cdp/MsgDrawDebt", nil)
 	cdc.RegisterConcrete(MsgRepayDebt{}, "cdp/MsgRepayDebt", nil)
+	cdc.RegisterConcrete(MsgLiquidate{}, "cdp/MsgLiquidate", nil)
+	cdc.RegisterConcrete(MsgDepositSavings{}, "cdp/MsgDepositSavings", nil)
+	cdc.RegisterConcrete(MsgWithdrawSavings{}, "cdp/MsgWithdrawSavings", nil)
+	cdc.RegisterConcrete(MsgRedeemDebt{}, "cdp/MsgRedeemDebt", nil)
+	cdc.RegisterConcrete(MsgReclaimCollateral{}, "cdp/MsgReclaimCollateral", nil)
 }
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DebtAsset summarizes the state of a stable asset that can be drawn from cdps
+type DebtAsset struct {
+	Denom           string  `json:"denom" yaml:"denom"`
+	DebtDenom       string  `json:"debt_denom" yaml:"debt_denom"`           // internal debt coin tracking this asset
+	StabilityFee    sdk.Dec `json:"stability_fee" yaml:"stability_fee"`     // per second fee applied on top of the collateral stability fee
+	TotalPrincipal  sdk.Int `json:"total_principal" yaml:"total_principal"` // principal plus fees drawn across all collateral types
+	GlobalDebtLimit sdk.Int `json:"global_debt_limit" yaml:"global_debt_limit"`
+	Surplus         sdk.Int `json:"surplus" yaml:"surplus"` // surplus held by the liquidator module account
+	Debt            sdk.Int `json:"debt" yaml:"debt"`       // seized debt held by the liquidator module account, shared by assets with the same debt denom
+}
+
+// NewDebtAsset returns a new DebtAsset
+func NewDebtAsset(denom, debtDenom string, stabilityFee sdk.Dec, totalPrincipal, globalDebtLimit, surplus, debt sdk.Int) DebtAsset {
+	return DebtAsset{
+		Denom:           denom,
+		DebtDenom:       debtDenom,
+		StabilityFee:    stabilityFee,
+		TotalPrincipal:  totalPrincipal,
+		GlobalDebtLimit: globalDebtLimit,
+		Surplus:         surplus,
+		Debt:            debt,
+	}
+}
+
+// String implements fmt.Stringer
+func (da DebtAsset) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Debt Asset:
+	Denom: %s
+	Debt Denom: %s
+	Stability Fee: %s
+	Total Principal: %s
+	Global Debt Limit: %s
+	Surplus: %s
+	Debt: %s`,
+		da.Denom, da.DebtDenom, da.StabilityFee, da.TotalPrincipal, da.GlobalDebtLimit, da.Surplus, da.Debt))
+}
+
+// DebtAssets a collection of DebtAsset objects
+type DebtAssets []DebtAsset
+
+// String implements fmt.Stringer
+func (das DebtAssets) String() string {
+	out := ""
+	for _, da := range das {
+		out += da.String() + "\n"
+	}
+	return out
+}
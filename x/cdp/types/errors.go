@@ -27,6 +27,13 @@ const (
 	CodeBelowDebtFloor          sdk.CodeType      = 15
 	CodePaymentExceedsDebt      sdk.CodeType      = 16
 	CodeLoadingAugmentedCDP     sdk.CodeType      = 17
+	CodeCdpNotLiquidatable      sdk.CodeType      = 18
+	CodeInvalidPrice            sdk.CodeType      = 19
+	CodeSavingsDepositNotFound  sdk.CodeType      = 20
+	CodeInvalidSavingsWithdraw  sdk.CodeType      = 21
+	CodeSystemSettled           sdk.CodeType      = 22
+	CodeSystemNotSettled        sdk.CodeType      = 23
+	CodeInsufficientPayment     sdk.CodeType      = 24
 )
 
 // ErrCdpAlreadyExists error for duplicate cdps
@@ -36,7 +43,7 @@ func ErrCdpAlreadyExists(codespace sdk.CodespaceType, owner sdk.AccAddress, deno
 
 // ErrInvalidCollateralLength error for invalid collateral input length
 func ErrInvalidCollateralLength(codespace sdk.CodespaceType, length int) sdk.Error {
-	return sdk.NewError(codespace, CodeCollateralLengthInvalid, fmt.Sprintf("only one collateral type per cdp, has %d", length))
+	return sdk.NewError(codespace, CodeCollateralLengthInvalid, fmt.Sprintf("cdps must be created with exactly one collateral type, has %d", length))
 }
 
 // ErrCollateralNotSupported error for unsupported collateral
@@ -79,7 +86,7 @@ func ErrInvalidPaymentDenom(codespace sdk.CodespaceType, cdpID uint64, expected
 	return sdk.NewError(codespace, CodeInvalidPaymentDenom, fmt.Sprintf("invalid payment for cdp %d, expects %s, got  %s", cdpID, expected, actual))
 }
 
-//ErrDepositNotAvailable error for withdrawing deposits in liquidation
+// ErrDepositNotAvailable error for withdrawing deposits in liquidation
 func ErrDepositNotAvailable(codespace sdk.CodespaceType, cdpID uint64, depositor sdk.AccAddress) sdk.Error {
 	return sdk.NewError(codespace, CodeDepositNotAvailable, fmt.Sprintf("deposit from %s for cdp %d in liquidation", depositor, cdpID))
 }
@@ -94,7 +101,7 @@ func ErrInvalidWithdrawAmount(codespace sdk.CodespaceType, withdraw sdk.Coins, d
 	return sdk.NewError(codespace, CodeInvalidWithdrawAmount, fmt.Sprintf("withdrawal amount of %s exceeds deposit of %s", withdraw, deposit))
 }
 
-//ErrCdpNotAvailable error for depositing to a CDP in liquidation
+// ErrCdpNotAvailable error for depositing to a CDP in liquidation
 func ErrCdpNotAvailable(codespace sdk.CodespaceType, cdpID uint64) sdk.Error {
 	return sdk.NewError(codespace, CodeCdpNotAvailable, fmt.Sprintf("cannot modify cdp %d, in liquidation", cdpID))
 }
@@ -113,3 +120,38 @@ func ErrPaymentExceedsDebt(codespace sdk.CodespaceType, payment sdk.Coins, princ
 func ErrLoadingAugmentedCDP(codespace sdk.CodespaceType, cdpID uint64) sdk.Error {
 	return sdk.NewError(codespace, CodeCdpNotFound, fmt.Sprintf("augmented cdp could not be loaded from cdp id %d", cdpID))
 }
+
+// ErrCdpNotLiquidatable error for attempting to liquidate a cdp that is above the liquidation ratio
+func ErrCdpNotLiquidatable(codespace sdk.CodespaceType, cdpID uint64, collateralizationRatio sdk.Dec, liquidationRatio sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeCdpNotLiquidatable, fmt.Sprintf("cdp %d has collateralization ratio %s, which is not below the liquidation ratio %s", cdpID, collateralizationRatio, liquidationRatio))
+}
+
+// ErrInvalidPrice error for a market price that can not be used to value assets
+func ErrInvalidPrice(codespace sdk.CodespaceType, marketID string, price sdk.Dec) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPrice, fmt.Sprintf("price %s for market %s is not positive", price, marketID))
+}
+
+// ErrSavingsDepositNotFound error for a savings deposit that does not exist
+func ErrSavingsDepositNotFound(codespace sdk.CodespaceType, depositor sdk.AccAddress, denom string) sdk.Error {
+	return sdk.NewError(codespace, CodeSavingsDepositNotFound, fmt.Sprintf("savings deposit of %s for %s not found", denom, depositor))
+}
+
+// ErrInvalidSavingsWithdraw error for withdrawing more than a savings deposit holds
+func ErrInvalidSavingsWithdraw(codespace sdk.CodespaceType, withdraw sdk.Coin, deposit sdk.Coin) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSavingsWithdraw, fmt.Sprintf("withdrawal %s exceeds savings deposit %s", withdraw, deposit))
+}
+
+// ErrSystemSettled error for changing the cdp system after a global settlement
+func ErrSystemSettled(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSystemSettled, "cdp system has been globally settled")
+}
+
+// ErrSystemNotSettled error for redeeming debt or reclaiming collateral before a global settlement
+func ErrSystemNotSettled(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSystemNotSettled, "cdp system has not been globally settled")
+}
+
+// ErrInsufficientPayment error for repaying all the debt of a cdp with a maximum payment that does not cover it
+func ErrInsufficientPayment(codespace sdk.CodespaceType, payment sdk.Coins, debt sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientPayment, fmt.Sprintf("maximum payment %s does not cover the cdp debt of %s", payment, debt))
+}
@@ -2,17 +2,35 @@ package types
 
 // Event types for cdp module
 const (
-	EventTypeCreateCdp         = "create_cdp"
-	EventTypeCdpDeposit        = "cdp_deposit"
-	EventTypeCdpDraw           = "cdp_draw"
-	EventTypeCdpRepay          = "cdp_repayment"
-	EventTypeCdpClose          = "cdp_close"
-	EventTypeCdpWithdrawal     = "cdp_withdrawal"
-	EventTypeCdpLiquidation    = "cdp_liquidation"
-	EventTypeBeginBlockerFatal = "cdp_begin_block_error"
+	EventTypeCreateCdp           = "create_cdp"
+	EventTypeCdpDeposit          = "cdp_deposit"
+	EventTypeCdpDraw             = "cdp_draw"
+	EventTypeCdpRepay            = "cdp_repayment"
+	EventTypeCdpClose            = "cdp_close"
+	EventTypeCdpWithdrawal       = "cdp_withdrawal"
+	EventTypeCdpLiquidation      = "cdp_liquidation"
+	EventTypeKeeperLiquidation   = "cdp_keeper_liquidation"
+	EventTypeLiquidationBacklog  = "cdp_liquidation_backlog"
+	EventTypeLiquidationUnpriced = "cdp_liquidation_unpriced"
+	EventTypeBeginBlockerFatal   = "cdp_begin_block_error"
+	EventTypeSavingsDeposit      = "savings_deposit"
+	EventTypeSavingsWithdrawal   = "savings_withdrawal"
+	EventTypeSavingsRatePayout   = "savings_rate_payout"
+	EventTypeGlobalSettlement    = "global_settlement"
+	EventTypeDebtRedemption      = "debt_redemption"
+	EventTypeCollateralReclaim   = "collateral_reclaim"
+	EventTypeCdpDustSweep        = "cdp_dust_sweep"
+	EventTypeSurplusReserveSkip  = "cdp_surplus_reserve_skipped"
 
-	AttributeKeyCdpID      = "cdp_id"
-	AttributeKeyDepositor  = "depositor"
-	AttributeValueCategory = "cdp"
-	AttributeKeyError      = "error_message"
+	AttributeKeyCdpID           = "cdp_id"
+	AttributeKeyDepositor       = "depositor"
+	AttributeValueCategory      = "cdp"
+	AttributeKeyError           = "error_message"
+	AttributeKeyKeeper          = "keeper"
+	AttributeKeyReward          = "reward"
+	AttributeKeyCollateralDenom = "collateral_denom"
+	AttributeKeyLiquidated      = "liquidated"
+	AttributeKeyBacklog         = "backlog"
+	AttributeKeyRedeemer        = "redeemer"
+	AttributeKeyDebtDenom       = "debt_denom"
 )
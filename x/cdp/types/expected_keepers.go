@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
 	pftypes "github.com/kava-labs/kava/x/pricefeed/types"
 )
 
@@ -21,6 +22,7 @@ type SupplyKeeper interface {
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) sdk.Error
 	BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
 	MintCoins(ctx sdk.Context, name string, amt sdk.Coins) sdk.Error
+	GetSupply(ctx sdk.Context) supplyexported.SupplyI
 }
 
 // PricefeedKeeper defines the expected interface for the pricefeed
@@ -35,7 +37,10 @@ type PricefeedKeeper interface {
 
 // AuctionKeeper expected interface for the auction keeper (noalias)
 type AuctionKeeper interface {
-	StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin, bidDenom string) (uint64, sdk.Error)
+	StartSurplusAuction(ctx sdk.Context, seller string, lot sdk.Coin, bidDenom string, reservePrice sdk.Dec) (uint64, sdk.Error)
 	StartDebtAuction(ctx sdk.Context, buyer string, bid sdk.Coin, initialLot sdk.Coin, debt sdk.Coin) (uint64, sdk.Error)
-	StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, sdk.Error)
+	StartCollateralAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin, reservePrice sdk.Dec) (uint64, sdk.Error)
+	StartDutchAuction(ctx sdk.Context, seller string, lot sdk.Coin, maxBid sdk.Coin, marketPrice sdk.Dec, lotReturnAddrs []sdk.AccAddress, lotReturnWeights []sdk.Int, debt sdk.Coin) (uint64, sdk.Error)
+	IterateAuctions(ctx sdk.Context, cb func(auction auctiontypes.Auction) (stop bool))
+	SettleAuction(ctx sdk.Context, auctionID uint64) sdk.Error
 }
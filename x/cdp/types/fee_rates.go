@@ -0,0 +1,75 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DebtFeeRate the stability fee currently charged on one debt asset drawn against a collateral type
+type DebtFeeRate struct {
+	Denom        string  `json:"denom" yaml:"denom"`
+	Utilization  sdk.Dec `json:"utilization" yaml:"utilization"`     // fraction of the debt limit that has been drawn
+	StabilityFee sdk.Dec `json:"stability_fee" yaml:"stability_fee"` // per second fee including the utilization curve and the debt asset fee
+}
+
+// NewDebtFeeRate returns a new DebtFeeRate
+func NewDebtFeeRate(denom string, utilization, stabilityFee sdk.Dec) DebtFeeRate {
+	return DebtFeeRate{
+		Denom:        denom,
+		Utilization:  utilization,
+		StabilityFee: stabilityFee,
+	}
+}
+
+// String implements fmt.Stringer
+func (dfr DebtFeeRate) String() string {
+	return fmt.Sprintf(`Debt Fee Rate:
+		Denom: %s
+		Utilization: %s
+		Stability Fee: %s`, dfr.Denom, dfr.Utilization, dfr.StabilityFee)
+}
+
+// DebtFeeRates array of DebtFeeRate
+type DebtFeeRates []DebtFeeRate
+
+// String implements fmt.Stringer
+func (dfrs DebtFeeRates) String() string {
+	out := ""
+	for _, dfr := range dfrs {
+		out += fmt.Sprintf("\n%s", dfr)
+	}
+	return out
+}
+
+// CollateralFeeRates the current and scheduled stability fees of a collateral type
+type CollateralFeeRates struct {
+	CollateralDenom string           `json:"collateral_denom" yaml:"collateral_denom"`
+	StabilityFee    sdk.Dec          `json:"stability_fee" yaml:"stability_fee"` // scheduled fee in effect, before the utilization curve is applied
+	DebtLimit       sdk.Coins        `json:"debt_limit" yaml:"debt_limit"`       // scheduled debt limit in effect
+	Rates           DebtFeeRates     `json:"rates" yaml:"rates"`
+	Scheduled       ScheduledChanges `json:"scheduled" yaml:"scheduled"` // changes that have not taken effect yet
+}
+
+// NewCollateralFeeRates returns a new CollateralFeeRates
+func NewCollateralFeeRates(denom string, stabilityFee sdk.Dec, debtLimit sdk.Coins, rates DebtFeeRates, scheduled ScheduledChanges) CollateralFeeRates {
+	return CollateralFeeRates{
+		CollateralDenom: denom,
+		StabilityFee:    stabilityFee,
+		DebtLimit:       debtLimit,
+		Rates:           rates,
+		Scheduled:       scheduled,
+	}
+}
+
+// String implements fmt.Stringer
+func (cfr CollateralFeeRates) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Collateral Fee Rates:
+	Collateral Denom: %s
+	Stability Fee: %s
+	Debt Limit: %s
+	Rates: %s
+	Scheduled: %s`,
+		cfr.CollateralDenom, cfr.StabilityFee, cfr.DebtLimit, cfr.Rates, cfr.Scheduled))
+}
@@ -4,42 +4,68 @@ import (
 	"bytes"
 	"fmt"
 	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // GenesisState is the state that must be provided at genesis.
 type GenesisState struct {
-	Params            Params    `json:"params" yaml:"params"`
-	CDPs              CDPs      `json:"cdps" yaml:"cdps"`
-	Deposits          Deposits  `json:"deposits" yaml:"deposits"`
-	StartingCdpID     uint64    `json:"starting_cdp_id" yaml:"starting_cdp_id"`
-	DebtDenom         string    `json:"debt_denom" yaml:"debt_denom"`
-	GovDenom          string    `json:"gov_denom" yaml:"gov_denom"`
-	PreviousBlockTime time.Time `json:"previous_block_time" yaml:"previous_block_time"`
+	Params             Params          `json:"params" yaml:"params"`
+	CDPs               CDPs            `json:"cdps" yaml:"cdps"`
+	Deposits           Deposits        `json:"deposits" yaml:"deposits"`
+	StartingCdpID      uint64          `json:"starting_cdp_id" yaml:"starting_cdp_id"`
+	DebtDenom          string          `json:"debt_denom" yaml:"debt_denom"`
+	GovDenom           string          `json:"gov_denom" yaml:"gov_denom"`
+	PreviousBlockTime  time.Time       `json:"previous_block_time" yaml:"previous_block_time"`
+	SavingsDeposits    SavingsDeposits `json:"savings_deposits" yaml:"savings_deposits"`
+	Settlement         Settlement      `json:"settlement" yaml:"settlement"`
+	CdpHistory         CdpHistory      `json:"cdp_history" yaml:"cdp_history"`
+	SurplusCollected   sdk.Coins       `json:"surplus_collected" yaml:"surplus_collected"`
+	SavingsDistributed sdk.Coins       `json:"savings_distributed" yaml:"savings_distributed"`
+	RecoveredDebt      sdk.Coins       `json:"recovered_debt" yaml:"recovered_debt"`
+	RealizedLoss       sdk.Coins       `json:"realized_loss" yaml:"realized_loss"`
+	AuctionDebts       AuctionDebts    `json:"auction_debts" yaml:"auction_debts"`
 }
 
 // NewGenesisState returns a new genesis state
-func NewGenesisState(params Params, cdps CDPs, deposits Deposits, startingCdpID uint64, debtDenom, govDenom string, previousBlockTime time.Time) GenesisState {
+func NewGenesisState(params Params, cdps CDPs, deposits Deposits, startingCdpID uint64, debtDenom, govDenom string, previousBlockTime time.Time, savingsDeposits SavingsDeposits, settlement Settlement, cdpHistory CdpHistory, surplusCollected, savingsDistributed, recoveredDebt, realizedLoss sdk.Coins, auctionDebts AuctionDebts) GenesisState {
 	return GenesisState{
-		Params:            params,
-		CDPs:              cdps,
-		Deposits:          deposits,
-		StartingCdpID:     startingCdpID,
-		DebtDenom:         debtDenom,
-		GovDenom:          govDenom,
-		PreviousBlockTime: previousBlockTime,
+		Params:             params,
+		CDPs:               cdps,
+		Deposits:           deposits,
+		StartingCdpID:      startingCdpID,
+		DebtDenom:          debtDenom,
+		GovDenom:           govDenom,
+		PreviousBlockTime:  previousBlockTime,
+		SavingsDeposits:    savingsDeposits,
+		Settlement:         settlement,
+		CdpHistory:         cdpHistory,
+		SurplusCollected:   surplusCollected,
+		SavingsDistributed: savingsDistributed,
+		RecoveredDebt:      recoveredDebt,
+		RealizedLoss:       realizedLoss,
+		AuctionDebts:       auctionDebts,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Params:            DefaultParams(),
-		CDPs:              CDPs{},
-		Deposits:          Deposits{},
-		StartingCdpID:     DefaultCdpStartingID,
-		DebtDenom:         DefaultDebtDenom,
-		GovDenom:          DefaultGovDenom,
-		PreviousBlockTime: DefaultPreviousBlockTime,
+		Params:             DefaultParams(),
+		CDPs:               CDPs{},
+		Deposits:           Deposits{},
+		StartingCdpID:      DefaultCdpStartingID,
+		DebtDenom:          DefaultDebtDenom,
+		GovDenom:           DefaultGovDenom,
+		PreviousBlockTime:  DefaultPreviousBlockTime,
+		SavingsDeposits:    SavingsDeposits{},
+		Settlement:         Settlement{},
+		CdpHistory:         CdpHistory{},
+		SurplusCollected:   sdk.Coins{},
+		SavingsDistributed: sdk.Coins{},
+		RecoveredDebt:      sdk.Coins{},
+		RealizedLoss:       sdk.Coins{},
+		AuctionDebts:       AuctionDebts{},
 	}
 }
 
@@ -60,6 +86,63 @@ func (gs GenesisState) Validate() error {
 
 	}
 
+	if err := gs.Params.ValidateDenoms(gs.DebtDenom, gs.GovDenom); err != nil {
+		return err
+	}
+
+	for _, sd := range gs.SavingsDeposits {
+		if sd.Depositor.Empty() {
+			return fmt.Errorf("savings deposit depositor not set: %s", sd)
+		}
+		if !sd.Amount.IsValid() || !sd.Amount.IsPositive() {
+			return fmt.Errorf("invalid savings deposit amount: %s", sd)
+		}
+	}
+
+	if !gs.SurplusCollected.IsValid() {
+		return fmt.Errorf("invalid surplus collected: %s", gs.SurplusCollected)
+	}
+	if !gs.SavingsDistributed.IsValid() {
+		return fmt.Errorf("invalid savings distributed: %s", gs.SavingsDistributed)
+	}
+	for _, sd := range gs.SavingsDistributed {
+		if sd.Amount.GT(gs.SurplusCollected.AmountOf(sd.Denom)) {
+			return fmt.Errorf("savings distributed %s exceed surplus collected %s", sd, gs.SurplusCollected)
+		}
+	}
+
+	if !gs.RecoveredDebt.IsValid() {
+		return fmt.Errorf("invalid recovered debt: %s", gs.RecoveredDebt)
+	}
+	if !gs.RealizedLoss.IsValid() {
+		return fmt.Errorf("invalid realized loss: %s", gs.RealizedLoss)
+	}
+	auctionIDs := make(map[uint64]bool)
+	for _, ad := range gs.AuctionDebts {
+		if auctionIDs[ad.AuctionID] {
+			return fmt.Errorf("duplicate auction debt for auction %d", ad.AuctionID)
+		}
+		auctionIDs[ad.AuctionID] = true
+		if err := ad.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := gs.Settlement.Validate(); err != nil {
+		return err
+	}
+
+	sequences := make(map[uint64]bool)
+	for _, e := range gs.CdpHistory {
+		if sequences[e.Sequence] {
+			return fmt.Errorf("duplicate cdp history sequence: %d", e.Sequence)
+		}
+		sequences[e.Sequence] = true
+		if e.Type == "" {
+			return fmt.Errorf("cdp history entry type not set: %s", e)
+		}
+	}
+
 	return nil
 }
 
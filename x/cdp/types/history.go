@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HistoryTypeFeeAccrual is the type of cdp history entries recording the fees accrued by a cdp since they were last updated.
+// Other entries use the type of the event emitted for the action they record.
+const HistoryTypeFeeAccrual = "cdp_fee_accrual"
+
+// CdpHistoryEntry an action that changed a cdp, kept after the cdp is closed or liquidated
+type CdpHistoryEntry struct {
+	CdpID    uint64         `json:"cdp_id" yaml:"cdp_id"`
+	Sequence uint64         `json:"sequence" yaml:"sequence"` // orders entries across all cdps
+	Type     string         `json:"type" yaml:"type"`
+	Address  sdk.AccAddress `json:"address" yaml:"address"` // account that took the action, empty for actions taken by the system
+	Amount   sdk.Coins      `json:"amount" yaml:"amount"`   // collateral or debt assets moved by the action
+	Height   int64          `json:"height" yaml:"height"`
+	Time     time.Time      `json:"time" yaml:"time"`
+}
+
+// NewCdpHistoryEntry returns a new CdpHistoryEntry
+func NewCdpHistoryEntry(cdpID, sequence uint64, entryType string, address sdk.AccAddress, amount sdk.Coins, height int64, entryTime time.Time) CdpHistoryEntry {
+	return CdpHistoryEntry{
+		CdpID:    cdpID,
+		Sequence: sequence,
+		Type:     entryType,
+		Address:  address,
+		Amount:   amount,
+		Height:   height,
+		Time:     entryTime,
+	}
+}
+
+// String implements fmt.Stringer
+func (e CdpHistoryEntry) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`CDP History Entry:
+	CDP ID: %d
+	Sequence: %d
+	Type: %s
+	Address: %s
+	Amount: %s
+	Height: %d
+	Time: %s`,
+		e.CdpID, e.Sequence, e.Type, e.Address, e.Amount, e.Height, e.Time))
+}
+
+// CdpHistory a collection of CdpHistoryEntry objects
+type CdpHistory []CdpHistoryEntry
+
+// String implements fmt.Stringer
+func (h CdpHistory) String() string {
+	out := ""
+	for _, e := range h {
+		out += e.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
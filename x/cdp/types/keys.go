@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -25,6 +26,9 @@ const (
 
 	// LiquidatorMacc module account for liquidator
 	LiquidatorMacc = "liquidator"
+
+	// SavingsRateMacc module account holding savings deposits
+	SavingsRateMacc = "savings"
 )
 
 var sep = []byte(":")
@@ -43,6 +47,23 @@ var sep = []byte(":")
 // - 0x06<denom>:totalPrincipal
 // - 0x07<denom>:feeRate
 // - 0x08:previousBlockTime
+// - 0x09<collateralDenomPrefix>:<cdpID_Bytes>: cdpID
+//    - index of cdps holding collateral other than their collateral type, which can't be ordered by collateral ratio
+// - 0x0A<debtDenom>:<depositorAddr_bytes>: SavingsDeposit
+// - 0x0B<debtDenom>: savingsRateIndex
+// - 0x0C<debtDenom>: totalSavings
+// - 0x0D<debtDenom>: savingsDistributed
+// - 0x0E<debtDenom>: surplusCollected
+// - 0x0F: Settlement
+// - 0x10<cdpID_Bytes>:<sequence_Bytes>: CdpHistoryEntry
+// - 0x11<time_Bytes>:<sequence_Bytes>: cdpID
+//    - index of cdp history entries by time, used to prune old entries
+// - 0x12: nextCdpHistorySequence
+// - 0x13<denom>: cdpID
+//    - the last basket cdp checked for liquidation, basket cdps are checked in rotation from the next one
+// - 0x14<debtDenom>: recoveredDebt
+// - 0x15<debtDenom>: realizedLoss
+// - 0x16<auctionID_Bytes>: AuctionDebt
 
 // KVStore key prefixes
 var (
@@ -55,6 +76,20 @@ var (
 	DepositKeyPrefix           = []byte{0x06}
 	PrincipalKeyPrefix         = []byte{0x07}
 	PreviousBlockTimeKey       = []byte{0x08}
+	BasketCdpIndexPrefix       = []byte{0x09}
+	SavingsDepositKeyPrefix    = []byte{0x0A}
+	SavingsRateIndexKeyPrefix  = []byte{0x0B}
+	TotalSavingsKeyPrefix      = []byte{0x0C}
+	SavingsDistributedPrefix   = []byte{0x0D}
+	SurplusCollectedPrefix     = []byte{0x0E}
+	SettlementKey              = []byte{0x0F}
+	CdpHistoryKeyPrefix        = []byte{0x10}
+	CdpHistoryTimeIndexPrefix  = []byte{0x11}
+	CdpHistorySequenceKey      = []byte{0x12}
+	BasketCdpCursorPrefix      = []byte{0x13}
+	RecoveredDebtPrefix        = []byte{0x14}
+	RealizedLossPrefix         = []byte{0x15}
+	AuctionDebtKeyPrefix       = []byte{0x16}
 )
 
 var lenPositiveDec = len(SortableDecBytes(sdk.OneDec()))
@@ -116,6 +151,26 @@ func SplitDepositIterKey(key []byte) (cdpID uint64) {
 	return GetCdpIDFromBytes(key)
 }
 
+// SavingsDepositKey key of a specific savings deposit in the store
+func SavingsDepositKey(denom string, depositor sdk.AccAddress) []byte {
+	return createKey([]byte(denom), sep, depositor)
+}
+
+// CdpHistoryKey key of an entry in the history of a cdp
+func CdpHistoryKey(cdpID uint64, sequence uint64) []byte {
+	return createKey(GetCdpIDBytes(cdpID), sep, sdk.Uint64ToBigEndian(sequence))
+}
+
+// CdpHistoryTimeKey key of a cdp history entry in the index of entries by time
+func CdpHistoryTimeKey(entryTime time.Time, sequence uint64) []byte {
+	return createKey(sdk.FormatTimeBytes(entryTime), sep, sdk.Uint64ToBigEndian(sequence))
+}
+
+// SplitCdpHistoryTimeKey returns the sequence of the entry indexed by a cdp history time key
+func SplitCdpHistoryTimeKey(key []byte) (sequence uint64) {
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
 // CollateralRatioBytes returns the liquidation ratio as sortable bytes
 func CollateralRatioBytes(ratio sdk.Dec) []byte {
 	ok := ValidSortableDec(ratio)
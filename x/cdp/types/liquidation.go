@@ -0,0 +1,86 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	auctiontypes "github.com/kava-labs/kava/x/auction/types"
+)
+
+// LiquidationSimulation the outcome of liquidating a collateral type at a hypothetical price and liquidation ratio
+type LiquidationSimulation struct {
+	CollateralDenom  string            `json:"collateral_denom" yaml:"collateral_denom"`
+	Price            sdk.Dec           `json:"price" yaml:"price"`
+	LiquidationRatio sdk.Dec           `json:"liquidation_ratio" yaml:"liquidation_ratio"`
+	CDPs             CDPs              `json:"cdps" yaml:"cdps"`                           // cdps that would be liquidated, with their fees updated
+	CollateralSeized sdk.Coins         `json:"collateral_seized" yaml:"collateral_seized"` // collateral sent to auction
+	DebtSeized       sdk.Coins         `json:"debt_seized" yaml:"debt_seized"`             // principal and fees of the liquidated cdps
+	Auctions         SimulatedAuctions `json:"auctions" yaml:"auctions"`                   // collateral auctions that would be started
+}
+
+// NewLiquidationSimulation returns a new LiquidationSimulation
+func NewLiquidationSimulation(denom string, price, liquidationRatio sdk.Dec, cdps CDPs, collateralSeized, debtSeized sdk.Coins, auctions SimulatedAuctions) LiquidationSimulation {
+	return LiquidationSimulation{
+		CollateralDenom:  denom,
+		Price:            price,
+		LiquidationRatio: liquidationRatio,
+		CDPs:             cdps,
+		CollateralSeized: collateralSeized,
+		DebtSeized:       debtSeized,
+		Auctions:         auctions,
+	}
+}
+
+// String implements fmt.Stringer
+func (ls LiquidationSimulation) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Liquidation Simulation:
+	Collateral Denom: %s
+	Price: %s
+	Liquidation Ratio: %s
+	CDPs Liquidated: %d
+	Collateral Seized: %s
+	Debt Seized: %s
+	Auctions: %s`,
+		ls.CollateralDenom, ls.Price, ls.LiquidationRatio, len(ls.CDPs), ls.CollateralSeized, ls.DebtSeized, ls.Auctions))
+}
+
+// SimulatedAuction a collateral auction that a liquidation would start
+type SimulatedAuction struct {
+	Lot               sdk.Coin                       `json:"lot" yaml:"lot"`
+	MaxBid            sdk.Coin                       `json:"max_bid" yaml:"max_bid"`
+	CorrespondingDebt sdk.Coin                       `json:"corresponding_debt" yaml:"corresponding_debt"`
+	LotReturns        auctiontypes.WeightedAddresses `json:"lot_returns" yaml:"lot_returns"`
+}
+
+// NewSimulatedAuction returns a new SimulatedAuction
+func NewSimulatedAuction(lot, maxBid, debt sdk.Coin, lotReturns auctiontypes.WeightedAddresses) SimulatedAuction {
+	return SimulatedAuction{
+		Lot:               lot,
+		MaxBid:            maxBid,
+		CorrespondingDebt: debt,
+		LotReturns:        lotReturns,
+	}
+}
+
+// String implements fmt.Stringer
+func (sa SimulatedAuction) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Simulated Auction:
+	Lot: %s
+	Max Bid: %s
+	Corresponding Debt: %s
+	Lot Returns: %s`,
+		sa.Lot, sa.MaxBid, sa.CorrespondingDebt, sa.LotReturns))
+}
+
+// SimulatedAuctions a collection of SimulatedAuction objects
+type SimulatedAuctions []SimulatedAuction
+
+// String implements fmt.Stringer
+func (sas SimulatedAuctions) String() string {
+	out := ""
+	for _, sa := range sas {
+		out += sa.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
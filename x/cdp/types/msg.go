@@ -13,6 +13,11 @@ var (
 	_ sdk.Msg = &MsgWithdraw{}
 	_ sdk.Msg = &MsgDrawDebt{}
 	_ sdk.Msg = &MsgRepayDebt{}
+	_ sdk.Msg = &MsgLiquidate{}
+	_ sdk.Msg = &MsgDepositSavings{}
+	_ sdk.Msg = &MsgWithdrawSavings{}
+	_ sdk.Msg = &MsgRedeemDebt{}
+	_ sdk.Msg = &MsgReclaimCollateral{}
 )
 
 // MsgCreateCDP creates a cdp
@@ -85,14 +90,16 @@ type MsgDeposit struct {
 	Depositor  sdk.AccAddress `json:"depositor" yaml:"depositor"`
 	Owner      sdk.AccAddress `json:"owner" yaml:"owner"`
 	Collateral sdk.Coins      `json:"collateral" yaml:"collateral"`
+	CdpDenom   string         `json:"cdp_denom" yaml:"cdp_denom"` // collateral type of the cdp, may be omitted when it matches the single collateral denom
 }
 
 // NewMsgDeposit returns a new MsgDeposit
-func NewMsgDeposit(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coins) MsgDeposit {
+func NewMsgDeposit(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coins, cdpDenom string) MsgDeposit {
 	return MsgDeposit{
 		Owner:      owner,
 		Depositor:  depositor,
 		Collateral: collateral,
+		CdpDenom:   cdpDenom,
 	}
 }
 
@@ -110,8 +117,8 @@ func (msg MsgDeposit) ValidateBasic() sdk.Error {
 	if msg.Depositor.Empty() {
 		return sdk.ErrInternal("invalid (empty) owner address")
 	}
-	if len(msg.Collateral) != 1 {
-		return sdk.ErrInvalidCoins(fmt.Sprintf("cdps do not support multiple collateral types: received %s", msg.Collateral))
+	if msg.CdpDenom == "" && len(msg.Collateral) != 1 {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("cdp denom must be specified for multiple collateral types: received %s", msg.Collateral))
 	}
 	if !msg.Collateral.IsValid() {
 		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid collateral amount: %s", msg.Collateral))
@@ -122,6 +129,14 @@ func (msg MsgDeposit) ValidateBasic() sdk.Error {
 	return nil
 }
 
+// GetCdpDenom returns the collateral type of the cdp, defaulting to the denom of the collateral
+func (msg MsgDeposit) GetCdpDenom() string {
+	if msg.CdpDenom == "" && len(msg.Collateral) > 0 {
+		return msg.Collateral[0].Denom
+	}
+	return msg.CdpDenom
+}
+
 // GetSignBytes gets the canonical byte representation of the Msg.
 func (msg MsgDeposit) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(msg)
@@ -138,23 +153,35 @@ func (msg MsgDeposit) String() string {
 	return fmt.Sprintf(`Deposit to CDP Message:
 	Sender:         %s
 	Owner: %s
+	CDP Denom: %s
 	Collateral: %s
-`, msg.Owner, msg.Owner, msg.Collateral)
+`, msg.Owner, msg.Owner, msg.GetCdpDenom(), msg.Collateral)
 }
 
+// Withdrawal modes of MsgWithdraw
+const (
+	WithdrawModeExact = ""      // withdraws exactly the collateral, failing if it would put the cdp below its liquidation ratio
+	WithdrawModeFloor = "floor" // lowers the withdrawal of each collateral denom to the most that leaves the cdp at its liquidation ratio
+	WithdrawModeAll   = "all"   // lowers the withdrawal of each collateral denom to the deposit, so the whole deposit is withdrawn if the collateral covers it
+)
+
 // MsgWithdraw withdraw collateral from an existing cdp.
 type MsgWithdraw struct {
 	Depositor  sdk.AccAddress `json:"depositor" yaml:"depositor"`
 	Owner      sdk.AccAddress `json:"owner" yaml:"owner"`
 	Collateral sdk.Coins      `json:"collateral" yaml:"collateral"`
+	CdpDenom   string         `json:"cdp_denom" yaml:"cdp_denom"` // collateral type of the cdp, may be omitted when it matches the single collateral denom
+	Mode       string         `json:"mode,omitempty" yaml:"mode,omitempty"`
 }
 
 // NewMsgWithdraw returns a new MsgDeposit
-func NewMsgWithdraw(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coins) MsgWithdraw {
+func NewMsgWithdraw(owner sdk.AccAddress, depositor sdk.AccAddress, collateral sdk.Coins, cdpDenom string, mode string) MsgWithdraw {
 	return MsgWithdraw{
 		Owner:      owner,
 		Depositor:  depositor,
 		Collateral: collateral,
+		CdpDenom:   cdpDenom,
+		Mode:       mode,
 	}
 }
 
@@ -172,8 +199,8 @@ func (msg MsgWithdraw) ValidateBasic() sdk.Error {
 	if msg.Depositor.Empty() {
 		return sdk.ErrInternal("invalid (empty) owner address")
 	}
-	if len(msg.Collateral) != 1 {
-		return sdk.ErrInvalidCoins(fmt.Sprintf("cdps do not support multiple collateral types: received %s", msg.Collateral))
+	if msg.CdpDenom == "" && len(msg.Collateral) != 1 {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("cdp denom must be specified for multiple collateral types: received %s", msg.Collateral))
 	}
 	if !msg.Collateral.IsValid() {
 		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid collateral amount: %s", msg.Collateral))
@@ -181,9 +208,22 @@ func (msg MsgWithdraw) ValidateBasic() sdk.Error {
 	if !msg.Collateral.IsAllPositive() {
 		return sdk.ErrInvalidCoins(fmt.Sprintf("negative collateral amount: %s", msg.Collateral))
 	}
+	switch msg.Mode {
+	case WithdrawModeExact, WithdrawModeFloor, WithdrawModeAll:
+	default:
+		return sdk.ErrUnknownRequest(fmt.Sprintf("invalid withdraw mode: %s", msg.Mode))
+	}
 	return nil
 }
 
+// GetCdpDenom returns the collateral type of the cdp, defaulting to the denom of the collateral
+func (msg MsgWithdraw) GetCdpDenom() string {
+	if msg.CdpDenom == "" && len(msg.Collateral) > 0 {
+		return msg.Collateral[0].Denom
+	}
+	return msg.CdpDenom
+}
+
 // GetSignBytes gets the canonical byte representation of the Msg.
 func (msg MsgWithdraw) GetSignBytes() []byte {
 	bz := ModuleCdc.MustMarshalJSON(msg)
@@ -200,8 +240,10 @@ func (msg MsgWithdraw) String() string {
 	return fmt.Sprintf(`Withdraw from CDP Message:
 	Owner:         %s
 	Depositor: %s
+	CDP Denom: %s
 	Collateral: %s
-`, msg.Owner, msg.Depositor, msg.Collateral)
+	Mode: %s
+`, msg.Owner, msg.Depositor, msg.GetCdpDenom(), msg.Collateral, msg.Mode)
 }
 
 // MsgDrawDebt draw coins off of collateral in cdp
@@ -263,19 +305,28 @@ func (msg MsgDrawDebt) String() string {
 `, msg.Sender, msg.CdpDenom, msg.Principal)
 }
 
+// Repayment modes of MsgRepayDebt
+const (
+	RepayModeExact = ""      // repays exactly the payment, failing if it would leave debt below the debt floor
+	RepayModeFloor = "floor" // lowers the payment of any debt asset that would be left below the debt floor so that the debt floor remains
+	RepayModeAll   = "all"   // repays all the debt, including fees, closing the cdp and returning its collateral. The payment, if set, is the maximum paid
+)
+
 // MsgRepayDebt repay debt drawn off the collateral in a CDP
 type MsgRepayDebt struct {
 	Sender   sdk.AccAddress `json:"sender" yaml:"sender"`
 	CdpDenom string         `json:"cdp_denom" yaml:"cdp_denom"`
 	Payment  sdk.Coins      `json:"payment" yaml:"payment"`
+	Mode     string         `json:"mode,omitempty" yaml:"mode,omitempty"`
 }
 
 // NewMsgRepayDebt returns a new MsgRepayDebt
-func NewMsgRepayDebt(sender sdk.AccAddress, denom string, payment sdk.Coins) MsgRepayDebt {
+func NewMsgRepayDebt(sender sdk.AccAddress, denom string, payment sdk.Coins, mode string) MsgRepayDebt {
 	return MsgRepayDebt{
 		Sender:   sender,
 		CdpDenom: denom,
 		Payment:  payment,
+		Mode:     mode,
 	}
 }
 
@@ -293,6 +344,15 @@ func (msg MsgRepayDebt) ValidateBasic() sdk.Error {
 	if msg.CdpDenom == "" {
 		return sdk.ErrInternal("invalid (empty) cdp denom")
 	}
+	switch msg.Mode {
+	case RepayModeExact, RepayModeFloor, RepayModeAll:
+	default:
+		return sdk.ErrUnknownRequest(fmt.Sprintf("invalid repay mode: %s", msg.Mode))
+	}
+	// the payment may only be omitted when repaying all the debt
+	if msg.Mode == RepayModeAll && len(msg.Payment) == 0 {
+		return nil
+	}
 	if !msg.Payment.IsValid() {
 		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid payment amount: %s", msg.Payment))
 	}
@@ -319,5 +379,268 @@ func (msg MsgRepayDebt) String() string {
 	Sender:         %s
 	CDP Denom: %s
 	Payment: %s
-`, msg.Sender, msg.CdpDenom, msg.Payment)
+	Mode: %s
+`, msg.Sender, msg.CdpDenom, msg.Payment, msg.Mode)
+}
+
+// MsgLiquidate attempts to liquidate a borrower's cdp
+type MsgLiquidate struct {
+	Keeper          sdk.AccAddress `json:"keeper" yaml:"keeper"`
+	Borrower        sdk.AccAddress `json:"borrower" yaml:"borrower"`
+	CollateralDenom string         `json:"collateral_denom" yaml:"collateral_denom"`
+}
+
+// NewMsgLiquidate returns a new MsgLiquidate
+func NewMsgLiquidate(keeper sdk.AccAddress, borrower sdk.AccAddress, denom string) MsgLiquidate {
+	return MsgLiquidate{
+		Keeper:          keeper,
+		Borrower:        borrower,
+		CollateralDenom: denom,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgLiquidate) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgLiquidate) Type() string { return "liquidate" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgLiquidate) ValidateBasic() sdk.Error {
+	if msg.Keeper.Empty() {
+		return sdk.ErrInternal("invalid (empty) keeper address")
+	}
+	if msg.Borrower.Empty() {
+		return sdk.ErrInternal("invalid (empty) borrower address")
+	}
+	if msg.CollateralDenom == "" {
+		return sdk.ErrInternal("invalid (empty) collateral denom")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgLiquidate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgLiquidate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Keeper}
+}
+
+// String implements the Stringer interface
+func (msg MsgLiquidate) String() string {
+	return fmt.Sprintf(`Liquidate CDP Message:
+	Keeper:           %s
+	Borrower:         %s
+	Collateral Denom: %s
+`, msg.Keeper, msg.Borrower, msg.CollateralDenom)
+}
+
+// MsgDepositSavings deposits a debt asset to earn the savings rate
+type MsgDepositSavings struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgDepositSavings returns a new MsgDepositSavings
+func NewMsgDepositSavings(depositor sdk.AccAddress, amount sdk.Coin) MsgDepositSavings {
+	return MsgDepositSavings{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgDepositSavings) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgDepositSavings) Type() string { return "deposit_savings" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgDepositSavings) ValidateBasic() sdk.Error {
+	if msg.Depositor.Empty() {
+		return sdk.ErrInternal("invalid (empty) depositor address")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid savings amount: %s", msg.Amount))
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgDepositSavings) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgDepositSavings) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// String implements the Stringer interface
+func (msg MsgDepositSavings) String() string {
+	return fmt.Sprintf(`Deposit Savings Message:
+	Depositor: %s
+	Amount:    %s
+`, msg.Depositor, msg.Amount)
+}
+
+// MsgWithdrawSavings withdraws a debt asset, and the savings earned on it, from a savings deposit
+type MsgWithdrawSavings struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgWithdrawSavings returns a new MsgWithdrawSavings
+func NewMsgWithdrawSavings(depositor sdk.AccAddress, amount sdk.Coin) MsgWithdrawSavings {
+	return MsgWithdrawSavings{
+		Depositor: depositor,
+		Amount:    amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgWithdrawSavings) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgWithdrawSavings) Type() string { return "withdraw_savings" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgWithdrawSavings) ValidateBasic() sdk.Error {
+	if msg.Depositor.Empty() {
+		return sdk.ErrInternal("invalid (empty) depositor address")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid savings amount: %s", msg.Amount))
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgWithdrawSavings) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgWithdrawSavings) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// String implements the Stringer interface
+func (msg MsgWithdrawSavings) String() string {
+	return fmt.Sprintf(`Withdraw Savings Message:
+	Depositor: %s
+	Amount:    %s
+`, msg.Depositor, msg.Amount)
+}
+
+// MsgRedeemDebt redeems a debt asset for a pro-rata share of the collateral backing all cdps after a global settlement
+type MsgRedeemDebt struct {
+	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
+	Amount sdk.Coin       `json:"amount" yaml:"amount"`
+}
+
+// NewMsgRedeemDebt returns a new MsgRedeemDebt
+func NewMsgRedeemDebt(sender sdk.AccAddress, amount sdk.Coin) MsgRedeemDebt {
+	return MsgRedeemDebt{
+		Sender: sender,
+		Amount: amount,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgRedeemDebt) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgRedeemDebt) Type() string { return "redeem_debt" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgRedeemDebt) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInternal("invalid (empty) sender address")
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return sdk.ErrInvalidCoins(fmt.Sprintf("invalid redemption amount: %s", msg.Amount))
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgRedeemDebt) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgRedeemDebt) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// String implements the Stringer interface
+func (msg MsgRedeemDebt) String() string {
+	return fmt.Sprintf(`Redeem Debt Message:
+	Sender: %s
+	Amount: %s
+`, msg.Sender, msg.Amount)
+}
+
+// MsgReclaimCollateral returns the collateral of a deposit that is not needed to cover the debt of its cdp after a global settlement
+type MsgReclaimCollateral struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Owner     sdk.AccAddress `json:"owner" yaml:"owner"`
+	CdpDenom  string         `json:"cdp_denom" yaml:"cdp_denom"`
+}
+
+// NewMsgReclaimCollateral returns a new MsgReclaimCollateral
+func NewMsgReclaimCollateral(owner sdk.AccAddress, depositor sdk.AccAddress, cdpDenom string) MsgReclaimCollateral {
+	return MsgReclaimCollateral{
+		Depositor: depositor,
+		Owner:     owner,
+		CdpDenom:  cdpDenom,
+	}
+}
+
+// Route return the message type used for routing the message.
+func (msg MsgReclaimCollateral) Route() string { return RouterKey }
+
+// Type returns a human-readable string for the message, intended for utilization within tags.
+func (msg MsgReclaimCollateral) Type() string { return "reclaim_collateral" }
+
+// ValidateBasic does a simple validation check that doesn't require access to any other information.
+func (msg MsgReclaimCollateral) ValidateBasic() sdk.Error {
+	if msg.Owner.Empty() {
+		return sdk.ErrInternal("invalid (empty) owner address")
+	}
+	if msg.Depositor.Empty() {
+		return sdk.ErrInternal("invalid (empty) depositor address")
+	}
+	if msg.CdpDenom == "" {
+		return sdk.ErrInternal("invalid (empty) cdp denom")
+	}
+	return nil
+}
+
+// GetSignBytes gets the canonical byte representation of the Msg.
+func (msg MsgReclaimCollateral) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// GetSigners returns the addresses of signers that must sign.
+func (msg MsgReclaimCollateral) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Depositor}
+}
+
+// String implements the Stringer interface
+func (msg MsgReclaimCollateral) String() string {
+	return fmt.Sprintf(`Reclaim Collateral Message:
+	Owner:     %s
+	Depositor: %s
+	CDP Denom: %s
+`, msg.Owner, msg.Depositor, msg.CdpDenom)
 }
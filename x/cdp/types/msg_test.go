@@ -53,14 +53,17 @@ func TestMsgDeposit(t *testing.T) {
 		sender      sdk.AccAddress
 		depositor   sdk.AccAddress
 		collateral  sdk.Coins
+		cdpDenom    string
 		expectPass  bool
 	}{
-		{"deposit", addrs[0], addrs[1], coinsSingle, true},
-		{"deposit", addrs[0], addrs[0], coinsSingle, true},
-		{"deposit no collateral", addrs[0], addrs[1], coinsZero, false},
-		{"deposit multi collateral", addrs[0], addrs[1], coinsMulti, false},
-		{"deposit empty owner", sdk.AccAddress{}, addrs[1], coinsSingle, false},
-		{"deposit empty depositor", addrs[0], sdk.AccAddress{}, coinsSingle, false},
+		{"deposit", addrs[0], addrs[1], coinsSingle, "", true},
+		{"deposit", addrs[0], addrs[0], coinsSingle, "", true},
+		{"deposit to cdp denom", addrs[0], addrs[1], coinsSingle, "foo", true},
+		{"deposit no collateral", addrs[0], addrs[1], coinsZero, "", false},
+		{"deposit multi collateral", addrs[0], addrs[1], coinsMulti, "", false},
+		{"deposit multi collateral to cdp denom", addrs[0], addrs[1], coinsMulti, "foo", true},
+		{"deposit empty owner", sdk.AccAddress{}, addrs[1], coinsSingle, "", false},
+		{"deposit empty depositor", addrs[0], sdk.AccAddress{}, coinsSingle, "", false},
 	}
 
 	for i, tc := range tests {
@@ -68,6 +71,7 @@ func TestMsgDeposit(t *testing.T) {
 			tc.sender,
 			tc.depositor,
 			tc.collateral,
+			tc.cdpDenom,
 		)
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
@@ -83,14 +87,21 @@ func TestMsgWithdraw(t *testing.T) {
 		sender      sdk.AccAddress
 		depositor   sdk.AccAddress
 		collateral  sdk.Coins
+		cdpDenom    string
+		mode        string
 		expectPass  bool
 	}{
-		{"withdraw", addrs[0], addrs[1], coinsSingle, true},
-		{"withdraw", addrs[0], addrs[0], coinsSingle, true},
-		{"withdraw no collateral", addrs[0], addrs[1], coinsZero, false},
-		{"withdraw multi collateral", addrs[0], addrs[1], coinsMulti, false},
-		{"withdraw empty owner", sdk.AccAddress{}, addrs[1], coinsSingle, false},
-		{"withdraw empty depositor", addrs[0], sdk.AccAddress{}, coinsSingle, false},
+		{"withdraw", addrs[0], addrs[1], coinsSingle, "", "", true},
+		{"withdraw", addrs[0], addrs[0], coinsSingle, "", "", true},
+		{"withdraw to cdp denom", addrs[0], addrs[1], coinsSingle, "foo", "", true},
+		{"withdraw no collateral", addrs[0], addrs[1], coinsZero, "", "", false},
+		{"withdraw multi collateral", addrs[0], addrs[1], coinsMulti, "", "", false},
+		{"withdraw multi collateral to cdp denom", addrs[0], addrs[1], coinsMulti, "foo", "", true},
+		{"withdraw empty owner", sdk.AccAddress{}, addrs[1], coinsSingle, "", "", false},
+		{"withdraw empty depositor", addrs[0], sdk.AccAddress{}, coinsSingle, "", "", false},
+		{"withdraw to floor", addrs[0], addrs[1], coinsSingle, "", "floor", true},
+		{"withdraw all", addrs[0], addrs[1], coinsSingle, "", "all", true},
+		{"withdraw invalid mode", addrs[0], addrs[1], coinsSingle, "", "most", false},
 	}
 
 	for i, tc := range tests {
@@ -98,6 +109,8 @@ func TestMsgWithdraw(t *testing.T) {
 			tc.sender,
 			tc.depositor,
 			tc.collateral,
+			tc.cdpDenom,
+			tc.mode,
 		)
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
@@ -142,13 +155,19 @@ func TestMsgRepayDebt(t *testing.T) {
 		sender      sdk.AccAddress
 		denom       string
 		payment     sdk.Coins
+		mode        string
 		expectPass  bool
 	}{
-		{"repay debt", addrs[0], sdk.DefaultBondDenom, coinsSingle, true},
-		{"repay debt no payment", addrs[0], sdk.DefaultBondDenom, coinsZero, false},
-		{"repay debt multi payment", addrs[0], sdk.DefaultBondDenom, coinsMulti, true},
-		{"repay debt empty owner", sdk.AccAddress{}, sdk.DefaultBondDenom, coinsSingle, false},
-		{"repay debt empty denom", sdk.AccAddress{}, "", coinsSingle, false},
+		{"repay debt", addrs[0], sdk.DefaultBondDenom, coinsSingle, RepayModeExact, true},
+		{"repay debt no payment", addrs[0], sdk.DefaultBondDenom, coinsZero, RepayModeExact, false},
+		{"repay debt multi payment", addrs[0], sdk.DefaultBondDenom, coinsMulti, RepayModeExact, true},
+		{"repay debt empty owner", sdk.AccAddress{}, sdk.DefaultBondDenom, coinsSingle, RepayModeExact, false},
+		{"repay debt empty denom", sdk.AccAddress{}, "", coinsSingle, RepayModeExact, false},
+		{"repay debt to floor", addrs[0], sdk.DefaultBondDenom, coinsSingle, RepayModeFloor, true},
+		{"repay debt to floor no payment", addrs[0], sdk.DefaultBondDenom, coinsZero, RepayModeFloor, false},
+		{"repay all debt", addrs[0], sdk.DefaultBondDenom, coinsSingle, RepayModeAll, true},
+		{"repay all debt no payment", addrs[0], sdk.DefaultBondDenom, coinsZero, RepayModeAll, true},
+		{"repay debt invalid mode", addrs[0], sdk.DefaultBondDenom, coinsSingle, "most", false},
 	}
 
 	for i, tc := range tests {
@@ -156,6 +175,7 @@ func TestMsgRepayDebt(t *testing.T) {
 			tc.sender,
 			tc.denom,
 			tc.payment,
+			tc.mode,
 		)
 		if tc.expectPass {
 			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
@@ -164,3 +184,103 @@ func TestMsgRepayDebt(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgLiquidate(t *testing.T) {
+	tests := []struct {
+		description string
+		keeper      sdk.AccAddress
+		borrower    sdk.AccAddress
+		denom       string
+		expectPass  bool
+	}{
+		{"liquidate", addrs[0], addrs[1], sdk.DefaultBondDenom, true},
+		{"liquidate own cdp", addrs[0], addrs[0], sdk.DefaultBondDenom, true},
+		{"liquidate empty keeper", sdk.AccAddress{}, addrs[1], sdk.DefaultBondDenom, false},
+		{"liquidate empty borrower", addrs[0], sdk.AccAddress{}, sdk.DefaultBondDenom, false},
+		{"liquidate empty denom", addrs[0], addrs[1], "", false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgLiquidate(
+			tc.keeper,
+			tc.borrower,
+			tc.denom,
+		)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgSavings(t *testing.T) {
+	tests := []struct {
+		description string
+		depositor   sdk.AccAddress
+		amount      sdk.Coin
+		expectPass  bool
+	}{
+		{"savings", addrs[0], sdk.NewInt64Coin("usdx", 1000), true},
+		{"savings empty depositor", sdk.AccAddress{}, sdk.NewInt64Coin("usdx", 1000), false},
+		{"savings zero amount", addrs[0], sdk.NewInt64Coin("usdx", 0), false},
+	}
+
+	for i, tc := range tests {
+		msgs := []sdk.Msg{
+			NewMsgDepositSavings(tc.depositor, tc.amount),
+			NewMsgWithdrawSavings(tc.depositor, tc.amount),
+		}
+		for _, msg := range msgs {
+			if tc.expectPass {
+				require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			} else {
+				require.Error(t, msg.ValidateBasic(), "test: %v", i)
+			}
+		}
+	}
+}
+
+func TestMsgSettlement(t *testing.T) {
+	redeemTests := []struct {
+		description string
+		sender      sdk.AccAddress
+		amount      sdk.Coin
+		expectPass  bool
+	}{
+		{"redeem", addrs[0], sdk.NewInt64Coin("usdx", 1000), true},
+		{"redeem empty sender", sdk.AccAddress{}, sdk.NewInt64Coin("usdx", 1000), false},
+		{"redeem zero amount", addrs[0], sdk.NewInt64Coin("usdx", 0), false},
+	}
+
+	for i, tc := range redeemTests {
+		msg := NewMsgRedeemDebt(tc.sender, tc.amount)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	reclaimTests := []struct {
+		description string
+		owner       sdk.AccAddress
+		depositor   sdk.AccAddress
+		denom       string
+		expectPass  bool
+	}{
+		{"reclaim", addrs[0], addrs[1], "xrp", true},
+		{"reclaim empty owner", sdk.AccAddress{}, addrs[1], "xrp", false},
+		{"reclaim empty depositor", addrs[0], sdk.AccAddress{}, "xrp", false},
+		{"reclaim empty denom", addrs[0], addrs[1], "", false},
+	}
+
+	for i, tc := range reclaimTests {
+		msg := NewMsgReclaimCollateral(tc.owner, tc.depositor, tc.denom)
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
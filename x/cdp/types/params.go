@@ -17,28 +17,52 @@ var (
 	KeyCircuitBreaker        = []byte("CircuitBreaker")
 	KeyDebtThreshold         = []byte("DebtThreshold")
 	KeySurplusThreshold      = []byte("SurplusThreshold")
+	KeySurplusBuffer         = []byte("SurplusBuffer")
+	KeyDebtAuctionParam      = []byte("DebtAuctionParam")
+	KeyGlobalSettlement      = []byte("GlobalSettlement")
+	KeyHistoryRetention      = []byte("HistoryRetention")
+	KeyDustSweep             = []byte("DustSweep")
+	KeySurplusReserve        = []byte("SurplusReserveDiscount")
 	DefaultGlobalDebt        = sdk.Coins{}
 	DefaultCircuitBreaker    = false
+	DefaultGlobalSettlement  = false
+	DefaultHistoryRetention  = time.Duration(0)
+	DefaultDustSweep         = false
+	DefaultSurplusReserve    = sdk.ZeroDec()
 	DefaultCollateralParams  = CollateralParams{}
 	DefaultDebtParams        = DebtParams{}
 	DefaultCdpStartingID     = uint64(1)
 	DefaultDebtDenom         = "debt"
 	DefaultGovDenom          = "ukava"
 	DefaultSurplusThreshold  = sdk.NewInt(1000000000)
+	DefaultSurplusBuffer     = sdk.Coins{}
 	DefaultDebtThreshold     = sdk.NewInt(1000000000)
+	DefaultDebtAuctionParam  = DebtAuctionParam{}
 	DefaultPreviousBlockTime = tmtime.Canonical(time.Unix(0, 0))
 	minCollateralPrefix      = 0
 	maxCollateralPrefix      = 255
 )
 
+// Auction types that seized collateral can be sold with
+const (
+	AuctionTypeCollateral = "collateral" // two phase collateral auction, used when no auction type is set
+	AuctionTypeDutch      = "dutch"      // descending price auction
+)
+
 // Params governance parameters for cdp module
 type Params struct {
 	CollateralParams        CollateralParams `json:"collateral_params" yaml:"collateral_params"`
 	DebtParams              DebtParams       `json:"debt_params" yaml:"debt_params"`
 	GlobalDebtLimit         sdk.Coins        `json:"global_debt_limit" yaml:"global_debt_limit"`
 	SurplusAuctionThreshold sdk.Int          `json:"surplus_auction_threshold" yaml:"surplus_auction_threshold"`
+	SurplusBuffer           sdk.Coins        `json:"surplus_buffer" yaml:"surplus_buffer"` // surplus retained by the liquidator module account before surplus auctions start
 	DebtAuctionThreshold    sdk.Int          `json:"debt_auction_threshold" yaml:"debt_auction_threshold"`
+	DebtAuctionParam        DebtAuctionParam `json:"debt_auction_param" yaml:"debt_auction_param"`
 	CircuitBreaker          bool             `json:"circuit_breaker" yaml:"circuit_breaker"`
+	GlobalSettlement        bool             `json:"global_settlement" yaml:"global_settlement"`               // settles the cdp system at the next block, can not be undone
+	HistoryRetention        time.Duration    `json:"history_retention" yaml:"history_retention"`               // how long cdp history entries are kept, 0 to disable the cdp history
+	DustSweep               bool             `json:"dust_sweep" yaml:"dust_sweep"`                             // closes cdps with debt below the debt floor in every block while set
+	SurplusReserveDiscount  sdk.Dec          `json:"surplus_reserve_discount" yaml:"surplus_reserve_discount"` // discount (between [0, 1)) from the governance token market price that surplus auctions are reserved at, 0 for no reserve price
 }
 
 // String implements fmt.Stringer
@@ -48,40 +72,66 @@ func (p Params) String() string {
 	Collateral Params: %s
 	Debt Params: %s
 	Surplus Auction Threshold: %s
+	Surplus Buffer: %s
 	Debt Auction Threshold: %s
-	Circuit Breaker: %t`,
-		p.GlobalDebtLimit, p.CollateralParams, p.DebtParams, p.SurplusAuctionThreshold, p.DebtAuctionThreshold, p.CircuitBreaker,
+	Debt Auction Param: %s
+	Circuit Breaker: %t
+	Global Settlement: %t
+	History Retention: %s
+	Dust Sweep: %t
+	Surplus Reserve Discount: %s`,
+		p.GlobalDebtLimit, p.CollateralParams, p.DebtParams, p.SurplusAuctionThreshold, p.SurplusBuffer, p.DebtAuctionThreshold, p.DebtAuctionParam, p.CircuitBreaker, p.GlobalSettlement,
+		p.HistoryRetention, p.DustSweep, p.SurplusReserveDiscount,
 	)
 }
 
+// HasSurplusReserve returns true if surplus auctions have a reserve price
+func (p Params) HasSurplusReserve() bool {
+	return !p.SurplusReserveDiscount.IsNil() && !p.SurplusReserveDiscount.IsZero()
+}
+
 // NewParams returns a new params object
-func NewParams(debtLimit sdk.Coins, collateralParams CollateralParams, debtParams DebtParams, surplusThreshold sdk.Int, debtThreshold sdk.Int, breaker bool) Params {
+func NewParams(debtLimit sdk.Coins, collateralParams CollateralParams, debtParams DebtParams, surplusThreshold sdk.Int, surplusBuffer sdk.Coins, debtThreshold sdk.Int, debtAuctionParam DebtAuctionParam, breaker bool, settlement bool, historyRetention time.Duration, dustSweep bool, surplusReserveDiscount sdk.Dec) Params {
 	return Params{
 		GlobalDebtLimit:         debtLimit,
 		CollateralParams:        collateralParams,
 		DebtParams:              debtParams,
 		DebtAuctionThreshold:    debtThreshold,
 		SurplusAuctionThreshold: surplusThreshold,
+		SurplusBuffer:           surplusBuffer,
+		DebtAuctionParam:        debtAuctionParam,
 		CircuitBreaker:          breaker,
+		GlobalSettlement:        settlement,
+		HistoryRetention:        historyRetention,
+		DustSweep:               dustSweep,
+		SurplusReserveDiscount:  surplusReserveDiscount,
 	}
 }
 
 // DefaultParams returns default params for cdp module
 func DefaultParams() Params {
-	return NewParams(DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParams, DefaultSurplusThreshold, DefaultDebtThreshold, DefaultCircuitBreaker)
+	return NewParams(DefaultGlobalDebt, DefaultCollateralParams, DefaultDebtParams, DefaultSurplusThreshold, DefaultSurplusBuffer, DefaultDebtThreshold, DefaultDebtAuctionParam, DefaultCircuitBreaker, DefaultGlobalSettlement, DefaultHistoryRetention, DefaultDustSweep, DefaultSurplusReserve)
 }
 
 // CollateralParam governance parameters for each collateral type within the cdp module
 type CollateralParam struct {
-	Denom              string    `json:"denom" yaml:"denom"`                             // Coin name of collateral type
-	LiquidationRatio   sdk.Dec   `json:"liquidation_ratio" yaml:"liquidation_ratio"`     // The ratio (Collateral (priced in stable coin) / Debt) under which a CDP will be liquidated
-	DebtLimit          sdk.Coins `json:"debt_limit" yaml:"debt_limit"`                   // Maximum amount of debt allowed to be drawn from this collateral type
-	StabilityFee       sdk.Dec   `json:"stability_fee" yaml:"stability_fee"`             // per second stability fee for loans opened using this collateral
-	AuctionSize        sdk.Int   `json:"auction_size" yaml:"auction_size"`               // Max amount of collateral to sell off in any one auction.
-	LiquidationPenalty sdk.Dec   `json:"liquidation_penalty" yaml:"liquidation_penalty"` // percentage penalty (between [0, 1]) applied to a cdp if it is liquidated
-	Prefix             byte      `json:"prefix" yaml:"prefix"`
-	MarketID           string    `json:"market_id" yaml:"market_id"`                 // marketID for fetching price of the asset from the pricefeed
-	ConversionFactor   sdk.Int   `json:"conversion_factor" yaml:"conversion_factor"` // factor for converting internal units to one base unit of collateral
+	Denom                   string           `json:"denom" yaml:"denom"`                             // Coin name of collateral type
+	LiquidationRatio        sdk.Dec          `json:"liquidation_ratio" yaml:"liquidation_ratio"`     // The ratio (Collateral (priced in stable coin) / Debt) under which a CDP will be liquidated
+	DebtLimit               sdk.Coins        `json:"debt_limit" yaml:"debt_limit"`                   // Maximum amount of debt allowed to be drawn from this collateral type
+	StabilityFee            sdk.Dec          `json:"stability_fee" yaml:"stability_fee"`             // per second stability fee for loans opened using this collateral
+	AuctionSize             sdk.Int          `json:"auction_size" yaml:"auction_size"`               // Max amount of collateral to sell off in any one auction.
+	LiquidationPenalty      sdk.Dec          `json:"liquidation_penalty" yaml:"liquidation_penalty"` // percentage penalty (between [0, 1]) applied to a cdp if it is liquidated
+	Prefix                  byte             `json:"prefix" yaml:"prefix"`
+	MarketID                string           `json:"market_id" yaml:"market_id"`                                     // marketID for fetching price of the asset from the pricefeed
+	ConversionFactor        sdk.Int          `json:"conversion_factor" yaml:"conversion_factor"`                     // factor for converting internal units to one base unit of collateral
+	KeeperRewardPercentage  sdk.Dec          `json:"keeper_reward_percentage" yaml:"keeper_reward_percentage"`       // percentage (between [0, 1]) of the liquidation penalty paid to accounts that liquidate a cdp with MsgLiquidate
+	MaxLiquidationsPerBlock uint64           `json:"max_liquidations_per_block" yaml:"max_liquidations_per_block"`   // maximum number of cdps liquidated in the begin blocker each block, 0 for no limit
+	MaxBasketChecksPerBlock uint64           `json:"max_basket_checks_per_block" yaml:"max_basket_checks_per_block"` // maximum number of basket cdps priced in the begin blocker each block, in rotation, 0 for no limit
+	Schedule                ScheduledChanges `json:"schedule" yaml:"schedule"`                                       // future changes to the stability fee and debt limit, ordered by time
+	UtilizationKink         sdk.Dec          `json:"utilization_kink" yaml:"utilization_kink"`                       // fraction (between [0, 1)) of the debt limit above which the stability fee rises
+	MaxStabilityFee         sdk.Dec          `json:"max_stability_fee" yaml:"max_stability_fee"`                     // per second stability fee charged when the debt limit is fully used, 0 to disable the utilization curve
+	AuctionType             string           `json:"auction_type" yaml:"auction_type"`                               // type of auction that seized collateral is sold with, collateral if empty
+	ReserveDiscount         sdk.Dec          `json:"reserve_discount" yaml:"reserve_discount"`                       // discount (between [0, 1)) from the market price that collateral auctions are reserved at, 0 for no reserve price
 }
 
 // String implements fmt.Stringer
@@ -95,8 +145,27 @@ func (cp CollateralParam) String() string {
 	Auction Size: %s
 	Prefix: %b
 	Market ID: %s
-	Conversion Factor: %s`,
-		cp.Denom, cp.LiquidationRatio, cp.StabilityFee, cp.LiquidationPenalty, cp.DebtLimit, cp.AuctionSize, cp.Prefix, cp.MarketID, cp.ConversionFactor)
+	Conversion Factor: %s
+	Keeper Reward Percentage: %s
+	Max Liquidations Per Block: %d
+	Max Basket Checks Per Block: %d
+	Schedule: %s
+	Utilization Kink: %s
+	Max Stability Fee: %s
+	Auction Type: %s
+	Reserve Discount: %s`,
+		cp.Denom, cp.LiquidationRatio, cp.StabilityFee, cp.LiquidationPenalty, cp.DebtLimit, cp.AuctionSize, cp.Prefix, cp.MarketID, cp.ConversionFactor, cp.KeeperRewardPercentage, cp.MaxLiquidationsPerBlock, cp.MaxBasketChecksPerBlock,
+		cp.Schedule, cp.UtilizationKink, cp.MaxStabilityFee, cp.AuctionType, cp.ReserveDiscount)
+}
+
+// HasUtilizationCurve returns true if the stability fee rises with utilization of the debt limit
+func (cp CollateralParam) HasUtilizationCurve() bool {
+	return !cp.MaxStabilityFee.IsNil() && !cp.MaxStabilityFee.IsZero()
+}
+
+// HasReserve returns true if collateral auctions of the collateral have a reserve price
+func (cp CollateralParam) HasReserve() bool {
+	return !cp.ReserveDiscount.IsNil() && !cp.ReserveDiscount.IsZero()
 }
 
 // CollateralParams array of CollateralParam
@@ -111,12 +180,102 @@ func (cps CollateralParams) String() string {
 	return out
 }
 
+// ScheduledChange a change to the stability fee or debt limit of a collateral type that takes effect at a future time
+type ScheduledChange struct {
+	Time         time.Time `json:"time" yaml:"time"`                   // time from which the change is in effect
+	StabilityFee sdk.Dec   `json:"stability_fee" yaml:"stability_fee"` // per second stability fee, 0 to leave the fee unchanged
+	DebtLimit    sdk.Coins `json:"debt_limit" yaml:"debt_limit"`       // debt limit, empty to leave the limit unchanged
+}
+
+// NewScheduledChange returns a new ScheduledChange
+func NewScheduledChange(t time.Time, stabilityFee sdk.Dec, debtLimit sdk.Coins) ScheduledChange {
+	return ScheduledChange{
+		Time:         t,
+		StabilityFee: stabilityFee,
+		DebtLimit:    debtLimit,
+	}
+}
+
+// ChangesStabilityFee returns true if the change sets a new stability fee
+func (sc ScheduledChange) ChangesStabilityFee() bool {
+	return !sc.StabilityFee.IsNil() && !sc.StabilityFee.IsZero()
+}
+
+// String implements fmt.Stringer
+func (sc ScheduledChange) String() string {
+	return fmt.Sprintf(`Scheduled Change:
+		Time: %s
+		Stability Fee: %s
+		Debt Limit: %s`, sc.Time, sc.StabilityFee, sc.DebtLimit)
+}
+
+// ScheduledChanges array of ScheduledChange
+type ScheduledChanges []ScheduledChange
+
+// String implements fmt.Stringer
+func (scs ScheduledChanges) String() string {
+	out := ""
+	for _, sc := range scs {
+		out += fmt.Sprintf("\n%s", sc)
+	}
+	return out
+}
+
+// DebtAuctionParam governance params for sizing the governance token lot of debt auctions
+type DebtAuctionParam struct {
+	MarketID         string  `json:"market_id" yaml:"market_id"`                 // marketID for fetching the price of the governance token from the pricefeed, empty to use a fixed initial lot
+	ConversionFactor sdk.Int `json:"conversion_factor" yaml:"conversion_factor"` // factor for converting internal units to one base unit of the governance token
+	LotMultiplier    sdk.Dec `json:"lot_multiplier" yaml:"lot_multiplier"`       // safety multiplier applied to the value of the debt when sizing the initial lot, 0 for 1
+	MaxLot           sdk.Int `json:"max_lot" yaml:"max_lot"`                     // maximum amount of governance tokens any one debt auction can mint, 0 for no limit
+}
+
+// NewDebtAuctionParam returns a new DebtAuctionParam
+func NewDebtAuctionParam(marketID string, conversionFactor sdk.Int, lotMultiplier sdk.Dec, maxLot sdk.Int) DebtAuctionParam {
+	return DebtAuctionParam{
+		MarketID:         marketID,
+		ConversionFactor: conversionFactor,
+		LotMultiplier:    lotMultiplier,
+		MaxLot:           maxLot,
+	}
+}
+
+// String implements fmt.Stringer
+func (dap DebtAuctionParam) String() string {
+	return fmt.Sprintf(`Debt Auction:
+		Market ID: %s
+		Conversion Factor: %s
+		Lot Multiplier: %s
+		Max Lot: %s`, dap.MarketID, dap.ConversionFactor, dap.LotMultiplier, dap.MaxLot)
+}
+
+// GetLotMultiplier returns the lot multiplier, treating an unset multiplier as 1
+func (dap DebtAuctionParam) GetLotMultiplier() sdk.Dec {
+	if dap.LotMultiplier.IsNil() || dap.LotMultiplier.IsZero() {
+		return sdk.OneDec()
+	}
+	return dap.LotMultiplier
+}
+
+// HasMaxLot returns true if the amount of governance tokens a debt auction can mint is capped
+func (dap DebtAuctionParam) HasMaxLot() bool {
+	return !isNilInt(dap.MaxLot) && !dap.MaxLot.IsZero()
+}
+
 // DebtParam governance params for debt assets
 type DebtParam struct {
 	Denom            string  `json:"denom" yaml:"denom"`
 	ReferenceAsset   string  `json:"reference_asset" yaml:"reference_asset"`
 	ConversionFactor sdk.Int `json:"conversion_factor" yaml:"conversion_factor"`
-	DebtFloor        sdk.Int `json:"debt_floor" yaml:"debt_floor"` // minimum active loan size, used to prevent dust
+	DebtFloor        sdk.Int `json:"debt_floor" yaml:"debt_floor"`       // minimum active loan size, used to prevent dust
+	DebtDenom        string  `json:"debt_denom" yaml:"debt_denom"`       // denom of the internal debt coin tracking this asset, empty to use the system debt denom
+	StabilityFee     sdk.Dec `json:"stability_fee" yaml:"stability_fee"` // per second stability fee applied on top of the collateral stability fee, 0 for none
+	SavingsRate      sdk.Dec `json:"savings_rate" yaml:"savings_rate"`   // per second rate paid to savings deposits of this asset from the liquidator surplus, 0 for none
+	MarketID         string  `json:"market_id" yaml:"market_id"`         // marketID for fetching the price of the asset from the pricefeed, empty to value it at 1
+}
+
+// HasSavingsRate returns true if savings deposits of the debt asset earn a savings rate
+func (dp DebtParam) HasSavingsRate() bool {
+	return !dp.SavingsRate.IsNil() && dp.SavingsRate.GT(sdk.OneDec())
 }
 
 func (dp DebtParam) String() string {
@@ -124,7 +283,11 @@ func (dp DebtParam) String() string {
 	Denom: %s
 	Reference Asset: %s
 	Conversion Factor: %s
-	Debt Floor %s`, dp.Denom, dp.ReferenceAsset, dp.ConversionFactor, dp.DebtFloor)
+	Debt Floor %s
+	Debt Denom: %s
+	Stability Fee: %s
+	Savings Rate: %s
+	Market ID: %s`, dp.Denom, dp.ReferenceAsset, dp.ConversionFactor, dp.DebtFloor, dp.DebtDenom, dp.StabilityFee, dp.SavingsRate, dp.MarketID)
 }
 
 // DebtParams array of DebtParam
@@ -154,12 +317,22 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		{Key: KeyDebtParams, Value: &p.DebtParams},
 		{Key: KeyCircuitBreaker, Value: &p.CircuitBreaker},
 		{Key: KeySurplusThreshold, Value: &p.SurplusAuctionThreshold},
+		{Key: KeySurplusBuffer, Value: &p.SurplusBuffer},
 		{Key: KeyDebtThreshold, Value: &p.DebtAuctionThreshold},
+		{Key: KeyDebtAuctionParam, Value: &p.DebtAuctionParam},
+		{Key: KeyGlobalSettlement, Value: &p.GlobalSettlement},
+		{Key: KeyHistoryRetention, Value: &p.HistoryRetention},
+		{Key: KeyDustSweep, Value: &p.DustSweep},
+		{Key: KeySurplusReserve, Value: &p.SurplusReserveDiscount},
 	}
 }
 
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
+	if p.HistoryRetention < 0 {
+		return fmt.Errorf("history retention must be non-negative, is %s", p.HistoryRetention)
+	}
+
 	// validate debt params
 	debtDenoms := make(map[string]int)
 	for _, dp := range p.DebtParams {
@@ -168,7 +341,42 @@ func (p Params) Validate() error {
 			return fmt.Errorf("duplicate debt denom: %s", dp.Denom)
 		}
 		debtDenoms[dp.Denom] = 1
-
+		if !dp.StabilityFee.IsNil() && !dp.StabilityFee.IsZero() && dp.StabilityFee.LT(sdk.OneDec()) {
+			return fmt.Errorf("stability fee must be 0 or ≥ 1.0, is %s for %s", dp.StabilityFee, dp.Denom)
+		}
+		if !dp.SavingsRate.IsNil() && !dp.SavingsRate.IsZero() && dp.SavingsRate.LT(sdk.OneDec()) {
+			return fmt.Errorf("savings rate must be 0 or ≥ 1.0, is %s for %s", dp.SavingsRate, dp.Denom)
+		}
+	}
+	collateralDenoms := make(map[string]bool)
+	for _, cp := range p.CollateralParams {
+		collateralDenoms[cp.Denom] = true
+	}
+	// each debt asset needs its own internal debt denom, so that its debt is netted and auctioned separately
+	internalDebtDenoms := make(map[string]bool)
+	systemDebtDenomUsed := false
+	for _, dp := range p.DebtParams {
+		if dp.DebtDenom == "" {
+			if systemDebtDenomUsed {
+				return fmt.Errorf("debt denom for %s not set, only one debt asset can use the system debt denom", dp.Denom)
+			}
+			systemDebtDenomUsed = true
+			continue
+		}
+		if !(sdk.Coin{Denom: dp.DebtDenom, Amount: sdk.ZeroInt()}).IsValid() {
+			return fmt.Errorf("invalid debt denom for %s: %s", dp.Denom, dp.DebtDenom)
+		}
+		_, found := debtDenoms[dp.DebtDenom]
+		if found {
+			return fmt.Errorf("debt denom for %s can not be a debt asset: %s", dp.Denom, dp.DebtDenom)
+		}
+		if collateralDenoms[dp.DebtDenom] {
+			return fmt.Errorf("debt denom for %s can not be a collateral denom: %s", dp.Denom, dp.DebtDenom)
+		}
+		if internalDebtDenoms[dp.DebtDenom] {
+			return fmt.Errorf("duplicate debt denom for %s: %s", dp.Denom, dp.DebtDenom)
+		}
+		internalDebtDenoms[dp.DebtDenom] = true
 	}
 
 	// validate collateral params
@@ -211,12 +419,51 @@ func (p Params) Validate() error {
 		if cp.LiquidationPenalty.LT(sdk.ZeroDec()) || cp.LiquidationPenalty.GT(sdk.OneDec()) {
 			return fmt.Errorf("liquidation penalty should be between 0 and 1, is %s for %s", cp.LiquidationPenalty, cp.Denom)
 		}
+		if !cp.KeeperRewardPercentage.IsNil() && (cp.KeeperRewardPercentage.IsNegative() || cp.KeeperRewardPercentage.GT(sdk.OneDec())) {
+			return fmt.Errorf("keeper reward percentage should be between 0 and 1, is %s for %s", cp.KeeperRewardPercentage, cp.Denom)
+		}
 		if !cp.AuctionSize.IsPositive() {
 			return fmt.Errorf("auction size should be positive, is %s for %s", cp.AuctionSize, cp.Denom)
 		}
+		switch cp.AuctionType {
+		case "", AuctionTypeCollateral, AuctionTypeDutch:
+		default:
+			return fmt.Errorf("invalid auction type %s for %s", cp.AuctionType, cp.Denom)
+		}
+		if cp.HasReserve() && (cp.ReserveDiscount.IsNegative() || cp.ReserveDiscount.GTE(sdk.OneDec())) {
+			return fmt.Errorf("reserve discount should be between 0 and 1, is %s for %s", cp.ReserveDiscount, cp.Denom)
+		}
 		if cp.StabilityFee.LT(sdk.OneDec()) {
 			return fmt.Errorf("stability fee must be ≥ 1.0, is %s for %s", cp.StabilityFee, cp.Denom)
 		}
+		for i, sc := range cp.Schedule {
+			if i > 0 && !sc.Time.After(cp.Schedule[i-1].Time) {
+				return fmt.Errorf("scheduled changes for %s must be in increasing time order, %s is not after %s", cp.Denom, sc.Time, cp.Schedule[i-1].Time)
+			}
+			if sc.ChangesStabilityFee() && sc.StabilityFee.LT(sdk.OneDec()) {
+				return fmt.Errorf("scheduled stability fee must be 0 or ≥ 1.0, is %s for %s", sc.StabilityFee, cp.Denom)
+			}
+			if sc.DebtLimit.IsAnyNegative() {
+				return fmt.Errorf("scheduled debt limit should be positive, is %s for %s", sc.DebtLimit, cp.Denom)
+			}
+			for _, dc := range sc.DebtLimit {
+				if _, found := debtDenoms[dc.Denom]; !found {
+					return fmt.Errorf("scheduled debt limit for collateral %s contains invalid debt denom %s", cp.Denom, dc.Denom)
+				}
+			}
+			if sc.DebtLimit.IsAnyGT(p.GlobalDebtLimit) {
+				return fmt.Errorf("scheduled debt limit for %s exceeds global debt limit: \n\tglobal debt limit: %s\n\tscheduled debt limit: %s",
+					cp.Denom, p.GlobalDebtLimit, sc.DebtLimit)
+			}
+		}
+		if cp.HasUtilizationCurve() {
+			if cp.MaxStabilityFee.LT(cp.StabilityFee) {
+				return fmt.Errorf("max stability fee must be 0 or ≥ stability fee, is %s for %s", cp.MaxStabilityFee, cp.Denom)
+			}
+			if cp.UtilizationKink.IsNil() || cp.UtilizationKink.IsNegative() || cp.UtilizationKink.GTE(sdk.OneDec()) {
+				return fmt.Errorf("utilization kink should be between 0 and 1, is %s for %s", cp.UtilizationKink, cp.Denom)
+			}
+		}
 	}
 	if collateralParamsDebtLimit.IsAnyGT(p.GlobalDebtLimit) {
 		return fmt.Errorf("collateral debt limit exceeds global debt limit:\n\tglobal debt limit: %s\n\tcollateral debt limits: %s",
@@ -230,8 +477,56 @@ func (p Params) Validate() error {
 	if !p.SurplusAuctionThreshold.IsPositive() {
 		return fmt.Errorf("surplus auction threshold should be positive, is %s", p.SurplusAuctionThreshold)
 	}
+	if !p.SurplusBuffer.IsValid() {
+		return fmt.Errorf("invalid surplus buffer: %s", p.SurplusBuffer)
+	}
+	for _, coin := range p.SurplusBuffer {
+		_, found := debtDenoms[coin.Denom]
+		if !found {
+			return fmt.Errorf("surplus buffer denom %s is not a debt asset", coin.Denom)
+		}
+	}
 	if !p.DebtAuctionThreshold.IsPositive() {
 		return fmt.Errorf("debt auction threshold should be positive, is %s", p.DebtAuctionThreshold)
 	}
+	dap := p.DebtAuctionParam
+	if !dap.LotMultiplier.IsNil() && dap.LotMultiplier.IsNegative() {
+		return fmt.Errorf("debt auction lot multiplier should not be negative, is %s", dap.LotMultiplier)
+	}
+	if dap.HasMaxLot() && dap.MaxLot.IsNegative() {
+		return fmt.Errorf("debt auction max lot should not be negative, is %s", dap.MaxLot)
+	}
+	if dap.MarketID != "" && (isNilInt(dap.ConversionFactor) || dap.ConversionFactor.IsNegative() || dap.ConversionFactor.GT(sdk.NewInt(MaxConversionFactor))) {
+		return fmt.Errorf("debt auction conversion factor should be between 0 and %d, is %s", MaxConversionFactor, dap.ConversionFactor)
+	}
+	if p.HasSurplusReserve() {
+		if p.SurplusReserveDiscount.IsNegative() || p.SurplusReserveDiscount.GTE(sdk.OneDec()) {
+			return fmt.Errorf("surplus reserve discount should be between 0 and 1, is %s", p.SurplusReserveDiscount)
+		}
+		if dap.MarketID == "" {
+			return fmt.Errorf("surplus reserve discount requires a debt auction market id")
+		}
+	}
+	return nil
+}
+
+// ValidateDenoms checks the debt params against the system debt denom and the governance denom, which are set in genesis:
+// no debt asset can use the system debt denom as its own debt denom while another debt asset uses it by default,
+// and no internal debt denom can be the governance denom.
+func (p Params) ValidateDenoms(debtDenom, govDenom string) error {
+	internalDebtDenoms := make(map[string]string)
+	for _, dp := range p.DebtParams {
+		internalDebtDenom := dp.DebtDenom
+		if internalDebtDenom == "" {
+			internalDebtDenom = debtDenom
+		}
+		if internalDebtDenom == govDenom {
+			return fmt.Errorf("debt denom for %s can not be the governance denom: %s", dp.Denom, internalDebtDenom)
+		}
+		if other, found := internalDebtDenoms[internalDebtDenom]; found {
+			return fmt.Errorf("debt denom %s is used by both %s and %s", internalDebtDenom, other, dp.Denom)
+		}
+		internalDebtDenoms[internalDebtDenom] = dp.Denom
+	}
 	return nil
 }
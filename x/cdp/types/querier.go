@@ -8,23 +8,60 @@ import (
 const (
 	QueryGetCdp                     = "cdp"
 	QueryGetCdpDeposits             = "deposits"
+	QueryGetCdpHealth               = "cdp-health"
+	QueryGetCdpHistory              = "cdp-history"
 	QueryGetCdps                    = "cdps"
 	QueryGetCdpsByCollateralization = "ratio"
 	QueryGetParams                  = "params"
+	QueryGetDebtAssets              = "debt-assets"
+	QueryGetFeeRates                = "fee-rates"
+	QueryGetSystemSurplus           = "system-surplus"
+	QueryGetSavingsDeposit          = "savings-deposit"
+	QueryGetSavingsPools            = "savings-pools"
+	QueryGetSettlement              = "settlement"
+	QueryGetStats                   = "stats"
+	QueryGetLiquidationSimulation   = "liquidation-simulation"
 	RestOwner                       = "owner"
 	RestCollateralDenom             = "collateral-denom"
+	RestCdpID                       = "cdp-id"
 	RestRatio                       = "ratio"
+	RestDenom                       = "denom"
+	RestMinID                       = "min-id"
+	RestMaxID                       = "max-id"
+	RestSortBy                      = "sort"
+	RestPrice                       = "price"
+	RestLiquidationRatio            = "liquidation-ratio"
+)
+
+// Sort orders of the cdp listing queries
+const (
+	SortByID         = "id"         // ascending cdp id
+	SortByRatio      = "ratio"      // ascending collateralization ratio, riskiest first
+	SortByDebt       = "debt"       // descending debt value, largest first
+	SortByCollateral = "collateral" // descending collateral value, largest first
 )
 
 // QueryCdpsParams params for query /cdp/cdps
 type QueryCdpsParams struct {
-	CollateralDenom string // get CDPs with this collateral denom
+	CollateralDenom string         // get CDPs with this collateral denom
+	Owner           sdk.AccAddress // get CDPs belonging to this owner, if set
+	MinID           uint64         // get CDPs with an ID of at least MinID
+	MaxID           uint64         // get CDPs with an ID of at most MaxID, if non-zero
+	SortBy          string         // sort CDPs by id (default), ratio, debt or collateral
+	Page            int            // page of results to return, starting at 1
+	Limit           int            // maximum number of results per page, 0 returns all
 }
 
 // NewQueryCdpsParams returns QueryCdpsParams
-func NewQueryCdpsParams(denom string) QueryCdpsParams {
+func NewQueryCdpsParams(denom string, owner sdk.AccAddress, minID, maxID uint64, sortBy string, page, limit int) QueryCdpsParams {
 	return QueryCdpsParams{
 		CollateralDenom: denom,
+		Owner:           owner,
+		MinID:           minID,
+		MaxID:           maxID,
+		SortBy:          sortBy,
+		Page:            page,
+		Limit:           limit,
 	}
 }
 
@@ -42,6 +79,34 @@ func NewQueryCdpParams(owner sdk.AccAddress, denom string) QueryCdpParams {
 	}
 }
 
+// QueryCdpHistoryParams params for query /cdp/cdps/history
+type QueryCdpHistoryParams struct {
+	CdpID uint64 `json:"cdp_id" yaml:"cdp_id"` // get the history of the cdp with this id, which may have been closed or liquidated
+}
+
+// NewQueryCdpHistoryParams returns QueryCdpHistoryParams
+func NewQueryCdpHistoryParams(cdpID uint64) QueryCdpHistoryParams {
+	return QueryCdpHistoryParams{
+		CdpID: cdpID,
+	}
+}
+
+// QuerySimulateParams params for query /cdp/liquidation-simulation
+type QuerySimulateParams struct {
+	CollateralDenom  string  `json:"collateral_denom" yaml:"collateral_denom"`
+	Price            sdk.Dec `json:"price" yaml:"price"`                         // hypothetical price of the collateral
+	LiquidationRatio sdk.Dec `json:"liquidation_ratio" yaml:"liquidation_ratio"` // hypothetical liquidation ratio, zero for the current liquidation ratio
+}
+
+// NewQuerySimulateParams returns QuerySimulateParams
+func NewQuerySimulateParams(denom string, price sdk.Dec, liquidationRatio sdk.Dec) QuerySimulateParams {
+	return QuerySimulateParams{
+		CollateralDenom:  denom,
+		Price:            price,
+		LiquidationRatio: liquidationRatio,
+	}
+}
+
 // QueryCdpDeposits params for query /cdp/deposits
 type QueryCdpDeposits struct {
 	CollateralDenom string         // get CDPs with this collateral denom
@@ -58,14 +123,52 @@ func NewQueryCdpDeposits(owner sdk.AccAddress, denom string) QueryCdpDeposits {
 
 // QueryCdpsByRatioParams params for query /cdp/cdps/ratio
 type QueryCdpsByRatioParams struct {
-	CollateralDenom string  // get CDPs with this collateral denom
-	Ratio           sdk.Dec // get CDPs below this collateral:debt ratio
+	CollateralDenom string         // get CDPs with this collateral denom
+	Ratio           sdk.Dec        // get CDPs below this collateral:debt ratio
+	Owner           sdk.AccAddress // get CDPs belonging to this owner, if set
+	MinID           uint64         // get CDPs with an ID of at least MinID
+	MaxID           uint64         // get CDPs with an ID of at most MaxID, if non-zero
+	SortBy          string         // sort CDPs by ratio (default), id, debt or collateral
+	Page            int            // page of results to return, starting at 1
+	Limit           int            // maximum number of results per page, 0 returns all
 }
 
 // NewQueryCdpsByRatioParams returns QueryCdpsByRatioParams
-func NewQueryCdpsByRatioParams(denom string, ratio sdk.Dec) QueryCdpsByRatioParams {
+func NewQueryCdpsByRatioParams(denom string, ratio sdk.Dec, owner sdk.AccAddress, minID, maxID uint64, sortBy string, page, limit int) QueryCdpsByRatioParams {
 	return QueryCdpsByRatioParams{
 		CollateralDenom: denom,
 		Ratio:           ratio,
+		Owner:           owner,
+		MinID:           minID,
+		MaxID:           maxID,
+		SortBy:          sortBy,
+		Page:            page,
+		Limit:           limit,
+	}
+}
+
+// QueryFeeRatesParams params for query /cdp/fee-rates
+type QueryFeeRatesParams struct {
+	CollateralDenom string // get fee rates for this collateral denom
+}
+
+// NewQueryFeeRatesParams returns QueryFeeRatesParams
+func NewQueryFeeRatesParams(denom string) QueryFeeRatesParams {
+	return QueryFeeRatesParams{
+		CollateralDenom: denom,
+	}
+}
+
+// QuerySavingsParams params for query /cdp/savings-deposit
+type QuerySavingsParams struct {
+	Depositor sdk.AccAddress // get the savings deposit of this depositor
+	Denom     string         // get the savings deposit of this debt asset
+}
+
+// NewQuerySavingsParams returns QuerySavingsParams
+func NewQuerySavingsParams(depositor sdk.AccAddress, denom string) QuerySavingsParams {
+	return QuerySavingsParams{
+		Depositor: depositor,
+		Denom:     denom,
 	}
 }
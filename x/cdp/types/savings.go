@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SavingsDeposit defines an amount of a debt asset locked by an account to earn the savings rate
+type SavingsDeposit struct {
+	Depositor sdk.AccAddress `json:"depositor" yaml:"depositor"`
+	Amount    sdk.Coin       `json:"amount" yaml:"amount"` // deposit amount, including savings earned up to the last update
+	Index     sdk.Dec        `json:"index" yaml:"index"`   // savings rate index of the debt asset at the last update
+}
+
+// NewSavingsDeposit returns a new SavingsDeposit
+func NewSavingsDeposit(depositor sdk.AccAddress, amount sdk.Coin, index sdk.Dec) SavingsDeposit {
+	return SavingsDeposit{
+		Depositor: depositor,
+		Amount:    amount,
+		Index:     index,
+	}
+}
+
+// String implements fmt.Stringer
+func (sd SavingsDeposit) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Savings Deposit:
+	Depositor: %s
+	Amount: %s
+	Index: %s`,
+		sd.Depositor, sd.Amount, sd.Index))
+}
+
+// SavingsDeposits a collection of SavingsDeposit objects
+type SavingsDeposits []SavingsDeposit
+
+// String implements fmt.Stringer
+func (sds SavingsDeposits) String() string {
+	out := ""
+	for _, sd := range sds {
+		out += sd.String() + "\n"
+	}
+	return out
+}
+
+// SavingsPool summarizes the savings deposits of a debt asset and the surplus paid to them
+type SavingsPool struct {
+	Denom            string  `json:"denom" yaml:"denom"`
+	SavingsRate      sdk.Dec `json:"savings_rate" yaml:"savings_rate"`           // per second rate paid to savings deposits
+	Index            sdk.Dec `json:"index" yaml:"index"`                         // accumulated savings rate since the first deposit
+	TotalSavings     sdk.Int `json:"total_savings" yaml:"total_savings"`         // deposits plus savings paid to them
+	Distributed      sdk.Int `json:"distributed" yaml:"distributed"`             // total savings paid from the liquidator surplus
+	SurplusCollected sdk.Int `json:"surplus_collected" yaml:"surplus_collected"` // total stability fees collected by the liquidator
+}
+
+// NewSavingsPool returns a new SavingsPool
+func NewSavingsPool(denom string, savingsRate, index sdk.Dec, totalSavings, distributed, surplusCollected sdk.Int) SavingsPool {
+	return SavingsPool{
+		Denom:            denom,
+		SavingsRate:      savingsRate,
+		Index:            index,
+		TotalSavings:     totalSavings,
+		Distributed:      distributed,
+		SurplusCollected: surplusCollected,
+	}
+}
+
+// String implements fmt.Stringer
+func (sp SavingsPool) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Savings Pool:
+	Denom: %s
+	Savings Rate: %s
+	Index: %s
+	Total Savings: %s
+	Distributed: %s
+	Surplus Collected: %s`,
+		sp.Denom, sp.SavingsRate, sp.Index, sp.TotalSavings, sp.Distributed, sp.SurplusCollected))
+}
+
+// SavingsPools a collection of SavingsPool objects
+type SavingsPools []SavingsPool
+
+// String implements fmt.Stringer
+func (sps SavingsPools) String() string {
+	out := ""
+	for _, sp := range sps {
+		out += sp.String() + "\n"
+	}
+	return out
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Settlement is the state of the cdp system frozen by a global settlement
+type Settlement struct {
+	Time               time.Time        `json:"time" yaml:"time"`                               // block time the settlement started
+	Prices             SettlementPrices `json:"prices" yaml:"prices"`                           // collateral prices frozen at the settlement
+	DebtPrices         SettlementPrices `json:"debt_prices" yaml:"debt_prices"`                 // prices of debt assets with a market frozen at the settlement, other debt assets are valued at 1
+	DebtValue          sdk.Dec          `json:"debt_value" yaml:"debt_value"`                   // value in base units of the debt assets that can be redeemed for collateral
+	Collateral         sdk.Coins        `json:"collateral" yaml:"collateral"`                   // collateral backing the debt of all cdps at the settlement prices
+	CollateralRedeemed sdk.Coins        `json:"collateral_redeemed" yaml:"collateral_redeemed"` // collateral paid out for redeemed debt assets
+}
+
+// NewSettlement returns a new Settlement
+func NewSettlement(settlementTime time.Time, prices, debtPrices SettlementPrices, debtValue sdk.Dec, collateral, collateralRedeemed sdk.Coins) Settlement {
+	return Settlement{
+		Time:               settlementTime,
+		Prices:             prices,
+		DebtPrices:         debtPrices,
+		DebtValue:          debtValue,
+		Collateral:         collateral,
+		CollateralRedeemed: collateralRedeemed,
+	}
+}
+
+// String implements fmt.Stringer
+func (s Settlement) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Settlement:
+	Time: %s
+	Prices: %s
+	Debt Prices: %s
+	Debt Value: %s
+	Collateral: %s
+	Collateral Redeemed: %s`,
+		s.Time, s.Prices, s.DebtPrices, s.DebtValue, s.Collateral, s.CollateralRedeemed))
+}
+
+// IsActive returns true if the cdp system has been settled
+func (s Settlement) IsActive() bool {
+	return !s.Time.IsZero()
+}
+
+// Validate performs basic validation of a settlement
+func (s Settlement) Validate() error {
+	if !s.IsActive() {
+		return nil
+	}
+	if s.DebtValue.IsNil() || s.DebtValue.IsNegative() {
+		return fmt.Errorf("invalid settlement debt value: %s", s.DebtValue)
+	}
+	if !s.Collateral.IsValid() || !s.CollateralRedeemed.IsValid() {
+		return fmt.Errorf("invalid settlement collateral: %s, redeemed %s", s.Collateral, s.CollateralRedeemed)
+	}
+	if !s.CollateralRedeemed.IsZero() && !s.Collateral.IsAllGTE(s.CollateralRedeemed) {
+		return fmt.Errorf("settlement collateral redeemed %s exceeds collateral %s", s.CollateralRedeemed, s.Collateral)
+	}
+	for _, sp := range append(s.Prices, s.DebtPrices...) {
+		if sp.Price.IsNil() || !sp.Price.IsPositive() {
+			return fmt.Errorf("invalid settlement price for %s: %s", sp.Denom, sp.Price)
+		}
+	}
+	return nil
+}
+
+// PriceOf returns the settlement price of the input collateral denom
+func (s Settlement) PriceOf(denom string) (sdk.Dec, bool) {
+	for _, sp := range s.Prices {
+		if sp.Denom == denom {
+			return sp.Price, true
+		}
+	}
+	return sdk.Dec{}, false
+}
+
+// DebtPriceOf returns the settlement price of the input debt asset, or 1 if it was valued at par
+func (s Settlement) DebtPriceOf(denom string) sdk.Dec {
+	for _, sp := range s.DebtPrices {
+		if sp.Denom == denom {
+			return sp.Price
+		}
+	}
+	return sdk.OneDec()
+}
+
+// SettlementPrice is the price of a collateral type or debt asset frozen at a global settlement
+type SettlementPrice struct {
+	Denom string  `json:"denom" yaml:"denom"`
+	Price sdk.Dec `json:"price" yaml:"price"`
+}
+
+// NewSettlementPrice returns a new SettlementPrice
+func NewSettlementPrice(denom string, price sdk.Dec) SettlementPrice {
+	return SettlementPrice{
+		Denom: denom,
+		Price: price,
+	}
+}
+
+// String implements fmt.Stringer
+func (sp SettlementPrice) String() string {
+	return fmt.Sprintf("%s: %s", sp.Denom, sp.Price)
+}
+
+// SettlementPrices a collection of SettlementPrice objects
+type SettlementPrices []SettlementPrice
+
+// String implements fmt.Stringer
+func (sps SettlementPrices) String() string {
+	out := ""
+	for _, sp := range sps {
+		out += sp.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
@@ -0,0 +1,176 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RatioBucketBounds are the lower bounds of the buckets cdps are counted in by their collateralization ratio,
+// as multiples of their liquidation ratio. Cdps in the first bucket can be liquidated.
+var RatioBucketBounds = []sdk.Dec{
+	sdk.ZeroDec(),
+	sdk.OneDec(),
+	sdk.MustNewDecFromStr("1.1"),
+	sdk.MustNewDecFromStr("1.25"),
+	sdk.MustNewDecFromStr("1.5"),
+	sdk.NewDec(2),
+}
+
+// Stats summarizes the aggregate risk of the cdp system
+type Stats struct {
+	CdpCount          uint64          `json:"cdp_count" yaml:"cdp_count"`
+	TotalPrincipal    sdk.Coins       `json:"total_principal" yaml:"total_principal"` // principal plus fees drawn across all collateral types
+	GlobalDebtLimit   sdk.Coins       `json:"global_debt_limit" yaml:"global_debt_limit"`
+	GlobalUtilization sdk.DecCoins    `json:"global_utilization" yaml:"global_utilization"` // fraction of the global debt limit of each debt asset that has been drawn
+	Surplus           sdk.Coins       `json:"surplus" yaml:"surplus"`                       // debt assets held by the liquidator module account
+	BadDebt           sdk.Coins       `json:"bad_debt" yaml:"bad_debt"`                     // internal debt coins held by the liquidator module account
+	RatioBuckets      RatioBuckets    `json:"ratio_buckets" yaml:"ratio_buckets"`           // cdps of all collateral types counted by collateralization ratio
+	UnpricedCount     uint64          `json:"unpriced_count" yaml:"unpriced_count"`         // cdps of all collateral types whose collateral or debt could not be priced
+	Backlog           uint64          `json:"backlog" yaml:"backlog"`                       // cdps of all collateral types below their liquidation ratio waiting to be liquidated
+	Collateral        CollateralStats `json:"collateral" yaml:"collateral"`
+}
+
+// NewStats returns a new Stats
+func NewStats(cdpCount uint64, totalPrincipal, globalDebtLimit sdk.Coins, globalUtilization sdk.DecCoins,
+	surplus, badDebt sdk.Coins, ratioBuckets RatioBuckets, unpricedCount, backlog uint64, collateral CollateralStats) Stats {
+	return Stats{
+		CdpCount:          cdpCount,
+		TotalPrincipal:    totalPrincipal,
+		GlobalDebtLimit:   globalDebtLimit,
+		GlobalUtilization: globalUtilization,
+		Surplus:           surplus,
+		BadDebt:           badDebt,
+		RatioBuckets:      ratioBuckets,
+		UnpricedCount:     unpricedCount,
+		Backlog:           backlog,
+		Collateral:        collateral,
+	}
+}
+
+// String implements fmt.Stringer
+func (s Stats) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Stats:
+	CDP Count: %d
+	Total Principal: %s
+	Global Debt Limit: %s
+	Global Utilization: %s
+	Surplus: %s
+	Bad Debt: %s
+	Ratio Buckets:
+%s
+	Unpriced: %d
+	Backlog: %d
+	Collateral:
+%s`,
+		s.CdpCount, s.TotalPrincipal, s.GlobalDebtLimit, s.GlobalUtilization, s.Surplus, s.BadDebt, s.RatioBuckets, s.UnpricedCount, s.Backlog, s.Collateral))
+}
+
+// CollateralStat summarizes the cdps of a collateral type
+type CollateralStat struct {
+	Denom           string       `json:"denom" yaml:"denom"`
+	CdpCount        uint64       `json:"cdp_count" yaml:"cdp_count"`
+	TotalCollateral sdk.Coins    `json:"total_collateral" yaml:"total_collateral"` // collateral deposited in cdps of this type, including other denoms in basket cdps
+	TotalPrincipal  sdk.Coins    `json:"total_principal" yaml:"total_principal"`   // principal plus fees drawn against this type
+	DebtLimit       sdk.Coins    `json:"debt_limit" yaml:"debt_limit"`             // debt limit in effect at the current block time
+	Utilization     sdk.DecCoins `json:"utilization" yaml:"utilization"`           // fraction of the debt limit of each debt asset that has been drawn
+	RatioBuckets    RatioBuckets `json:"ratio_buckets" yaml:"ratio_buckets"`
+	UnpricedCount   uint64       `json:"unpriced_count" yaml:"unpriced_count"` // cdps whose collateral or debt could not be priced, which are not counted in the ratio buckets
+	Backlog         uint64       `json:"backlog" yaml:"backlog"`               // cdps below their liquidation ratio waiting to be liquidated
+}
+
+// NewCollateralStat returns a new CollateralStat
+func NewCollateralStat(denom string, cdpCount uint64, totalCollateral, totalPrincipal, debtLimit sdk.Coins,
+	utilization sdk.DecCoins, ratioBuckets RatioBuckets, unpricedCount, backlog uint64) CollateralStat {
+	return CollateralStat{
+		Denom:           denom,
+		CdpCount:        cdpCount,
+		TotalCollateral: totalCollateral,
+		TotalPrincipal:  totalPrincipal,
+		DebtLimit:       debtLimit,
+		Utilization:     utilization,
+		RatioBuckets:    ratioBuckets,
+		UnpricedCount:   unpricedCount,
+		Backlog:         backlog,
+	}
+}
+
+// String implements fmt.Stringer
+func (cs CollateralStat) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Collateral Stat:
+	Denom: %s
+	CDP Count: %d
+	Total Collateral: %s
+	Total Principal: %s
+	Debt Limit: %s
+	Utilization: %s
+	Ratio Buckets:
+%s
+	Unpriced: %d
+	Backlog: %d`,
+		cs.Denom, cs.CdpCount, cs.TotalCollateral, cs.TotalPrincipal, cs.DebtLimit, cs.Utilization, cs.RatioBuckets, cs.UnpricedCount, cs.Backlog))
+}
+
+// CollateralStats a collection of CollateralStat objects
+type CollateralStats []CollateralStat
+
+// String implements fmt.Stringer
+func (css CollateralStats) String() string {
+	out := ""
+	for _, cs := range css {
+		out += cs.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// RatioBucket the number of cdps with a collateralization ratio of at least MinRatio times their liquidation ratio,
+// and below the MinRatio of the next bucket
+type RatioBucket struct {
+	MinRatio sdk.Dec `json:"min_ratio" yaml:"min_ratio"`
+	Count    uint64  `json:"count" yaml:"count"`
+}
+
+// NewRatioBucket returns a new RatioBucket
+func NewRatioBucket(minRatio sdk.Dec, count uint64) RatioBucket {
+	return RatioBucket{
+		MinRatio: minRatio,
+		Count:    count,
+	}
+}
+
+// String implements fmt.Stringer
+func (rb RatioBucket) String() string {
+	return fmt.Sprintf("\t\t>= %s: %d", rb.MinRatio, rb.Count)
+}
+
+// RatioBuckets a collection of RatioBucket objects
+type RatioBuckets []RatioBucket
+
+// NewRatioBuckets returns empty buckets for each of the RatioBucketBounds
+func NewRatioBuckets() RatioBuckets {
+	buckets := make(RatioBuckets, len(RatioBucketBounds))
+	for i, bound := range RatioBucketBounds {
+		buckets[i] = NewRatioBucket(bound, 0)
+	}
+	return buckets
+}
+
+// Add counts a cdp in the highest bucket whose lower bound is at most the input ratio, a multiple of the liquidation ratio
+func (rbs RatioBuckets) Add(ratio sdk.Dec) {
+	for i := len(rbs) - 1; i >= 0; i-- {
+		if ratio.GTE(rbs[i].MinRatio) {
+			rbs[i].Count++
+			return
+		}
+	}
+}
+
+// String implements fmt.Stringer
+func (rbs RatioBuckets) String() string {
+	out := ""
+	for _, rb := range rbs {
+		out += rb.String() + "\n"
+	}
+	return strings.TrimRight(out, "\n")
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SystemSurplus summarizes the surplus and bad debt held by the liquidator module account.
+// Bad debt being covered by open auctions is held by the auction module account, and is reported separately as auction debt.
+type SystemSurplus struct {
+	SurplusBuffer             sdk.Coins `json:"surplus_buffer" yaml:"surplus_buffer"`                           // surplus retained before surplus auctions start
+	Surplus                   sdk.Coins `json:"surplus" yaml:"surplus"`                                         // debt assets held by the liquidator module account
+	BadDebt                   sdk.Coins `json:"bad_debt" yaml:"bad_debt"`                                       // internal debt coins held by the liquidator module account
+	AuctionDebt               sdk.Coins `json:"auction_debt" yaml:"auction_debt"`                               // internal debt coins escrowed by open auctions started by the liquidator module account
+	RecoveredDebt             sdk.Coins `json:"recovered_debt" yaml:"recovered_debt"`                           // internal debt coins recovered by the bids on closed and open collateral auctions
+	RealizedLoss              sdk.Coins `json:"realized_loss" yaml:"realized_loss"`                             // internal debt coins left unrecovered by closed collateral auctions
+	PendingSurplusAuctions    uint64    `json:"pending_surplus_auctions" yaml:"pending_surplus_auctions"`       // open surplus auctions started by the liquidator module account
+	PendingDebtAuctions       uint64    `json:"pending_debt_auctions" yaml:"pending_debt_auctions"`             // open debt auctions started by the liquidator module account
+	PendingCollateralAuctions uint64    `json:"pending_collateral_auctions" yaml:"pending_collateral_auctions"` // open collateral auctions started by the liquidator module account
+}
+
+// NewSystemSurplus returns a new SystemSurplus
+func NewSystemSurplus(surplusBuffer, surplus, badDebt, auctionDebt, recoveredDebt, realizedLoss sdk.Coins, surplusAuctions, debtAuctions, collateralAuctions uint64) SystemSurplus {
+	return SystemSurplus{
+		SurplusBuffer:             surplusBuffer,
+		Surplus:                   surplus,
+		BadDebt:                   badDebt,
+		AuctionDebt:               auctionDebt,
+		RecoveredDebt:             recoveredDebt,
+		RealizedLoss:              realizedLoss,
+		PendingSurplusAuctions:    surplusAuctions,
+		PendingDebtAuctions:       debtAuctions,
+		PendingCollateralAuctions: collateralAuctions,
+	}
+}
+
+// String implements fmt.Stringer
+func (ss SystemSurplus) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`System Surplus:
+	Surplus Buffer: %s
+	Surplus: %s
+	Bad Debt: %s
+	Auction Debt: %s
+	Recovered Debt: %s
+	Realized Loss: %s
+	Pending Surplus Auctions: %d
+	Pending Debt Auctions: %d
+	Pending Collateral Auctions: %d`,
+		ss.SurplusBuffer, ss.Surplus, ss.BadDebt, ss.AuctionDebt, ss.RecoveredDebt, ss.RealizedLoss, ss.PendingSurplusAuctions, ss.PendingDebtAuctions, ss.PendingCollateralAuctions))
+}
@@ -8,6 +8,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// MaxConversionFactor largest conversion factor between the internal and base units of an asset
+const MaxConversionFactor = 18
+
 // MaxSortableDec largest sortable sdk.Dec
 var MaxSortableDec = sdk.OneDec().Quo(sdk.SmallestDec())
 
@@ -97,3 +100,8 @@ func RelativePow(x sdk.Int, n sdk.Int, b sdk.Int) (z sdk.Int) {
 	}
 	return
 }
+
+// isNilInt returns true if the input sdk.Int has not been set
+func isNilInt(i sdk.Int) bool {
+	return i == (sdk.Int{})
+}